@@ -4,6 +4,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -129,7 +130,16 @@ type AgentPoolSpec struct {
 	//+kubebuilder:validation:MinLength:=1
 	Organization string `json:"organization"`
 	// API Token to be used for API calls.
-	Token Token `json:"token"`
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
 
 	// List of the agent tokens to generate.
 	//
@@ -157,6 +167,16 @@ type AgentPoolSpec struct {
 	//+kubebuilder:default=retain
 	//+optional
 	DeletionPolicy AgentPoolDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Management mode of the object.
+	// - `enforce`: The Operator creates, updates, and deletes the Agent Pool to match the spec.
+	// - `observe`: The Operator only reads the Agent Pool and reports the differences from the spec in `status.observation` and events.
+	//   It does not make any changes in HCP Terraform, agent tokens and the agent deployment are not managed.
+	// Default: `enforce`.
+	//
+	//+kubebuilder:validation:Enum:=enforce;observe
+	//+kubebuilder:default:=enforce
+	//+optional
+	ManagementMode ManagementMode `json:"managementMode,omitempty"`
 }
 
 // AgentDeploymentAutoscalingStatus
@@ -188,11 +208,24 @@ type AgentPoolStatus struct {
 	//
 	//+optional
 	AgentDeploymentAutoscalingStatus *AgentDeploymentAutoscalingStatus `json:"autoscaling,omitempty"`
+	// Result of the last observation of the Agent Pool in the `observe` management mode.
+	//
+	//+optional
+	Observation *ObservationStatus `json:"observation,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
-//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.4.0"
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
 
 // AgentPool manages HCP Terraform Agent Pools, HCP Terraform Agent Tokens and can perform HCP Terraform Agent scaling.
 // More infromation:
@@ -14,6 +14,7 @@ import (
 func (ap *AgentPool) ValidateSpec() error {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateToken(ap.Spec.Token, ap.Spec.ConnectionRef, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ap.validateSpecAgentToken()...)
 
 	// Validate labels
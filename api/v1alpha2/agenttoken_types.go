@@ -4,6 +4,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -37,7 +38,16 @@ type AgentTokenSpec struct {
 	//+kubebuilder:validation:MinLength:=1
 	Organization string `json:"organization"`
 	// API Token to be used for API calls.
-	Token Token `json:"token"`
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
 	// The Deletion Policy defines how managed tokens and Kubernetes Secrets should be handled when the custom resource is deleted.
 	// - `retain`: When the custom resource is deleted, the operator will remove only the resource itself.
 	//   The managed HCP Terraform Agent tokens will remain active on the HCP Terraform side, and the corresponding Kubernetes Secret will not be modified.
@@ -80,13 +90,22 @@ type AgentTokenStatus struct {
 	//
 	//+optional
 	AgentTokens []*AgentAPIToken `json:"agentTokens,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Pool Name",type=string,JSONPath=`.status.agentPool.name`
 //+kubebuilder:printcolumn:name="Pool ID",type=string,JSONPath=`.status.agentPool.id`
-//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.11.0"
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
 
 // AgentToken manages HCP Terraform Agent Tokens.
 // More information:
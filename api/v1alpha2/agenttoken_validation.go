@@ -14,6 +14,7 @@ import (
 func (t *AgentToken) ValidateSpec() error {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateToken(t.Spec.Token, t.Spec.ConnectionRef, field.NewPath("spec"))...)
 	allErrs = append(allErrs, t.validateSpecAgentTokens()...)
 
 	if len(allErrs) == 0 {
@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+// Condition types that the controllers set on custom resources.
+const (
+	// ConditionTypeReady indicates that the resource is in sync with HCP Terraform and is ready for use.
+	ConditionTypeReady = "Ready"
+	// ConditionTypeSynced indicates whether the latest reconciliation with HCP Terraform succeeded.
+	ConditionTypeSynced = "Synced"
+	// ConditionTypeSpecValid indicates whether the resource spec passed validation.
+	ConditionTypeSpecValid = "SpecValid"
+	// ConditionTypeRunSucceeded indicates the outcome of the latest HCP Terraform run.
+	ConditionTypeRunSucceeded = "RunSucceeded"
+)
+
+// Condition reasons that the controllers set on custom resources.
+const (
+	ConditionReasonReconcileSuccess = "ReconcileSuccess"
+	ConditionReasonReconcileFailed  = "ReconcileFailed"
+	ConditionReasonSpecValid        = "SpecValid"
+	ConditionReasonSpecInvalid      = "SpecInvalid"
+	ConditionReasonTerraformClient  = "TerraformClientFailed"
+	ConditionReasonRunInProgress    = "RunInProgress"
+	ConditionReasonRunSucceeded     = "RunSucceeded"
+	ConditionReasonRunFailed        = "RunFailed"
+	ConditionReasonObserved         = "Observed"
+	ConditionReasonObservedDiff     = "ObservedDiff"
+)
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConnectionTLS defines the TLS settings used to communicate with the HCP Terraform or Terraform Enterprise API.
+type ConnectionTLS struct {
+	// Skip TLS certificate verification of the API endpoint.
+	// This setting is not recommended for production use.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	SkipVerify bool `json:"skipVerify,omitempty"`
+	// PEM-encoded CA bundle used to verify the API endpoint certificate.
+	// Certificates from the bundle are added to the system certificate pool.
+	//
+	//+optional
+	CABundle *ValueFrom `json:"caBundle,omitempty"`
+	// Kubernetes Secret of type `kubernetes.io/tls` with the client certificate and key for mutual TLS authentication.
+	// The Secret must be in the same namespace as the Connection and contain the keys `tls.crt` and `tls.key`.
+	//
+	//+optional
+	ClientCertificateSecretRef *corev1.LocalObjectReference `json:"clientCertificateSecretRef,omitempty"`
+}
+
+// ConnectionSpec defines the desired state of Connection.
+type ConnectionSpec struct {
+	// The API address of HCP Terraform or Terraform Enterprise.
+	// When not set, the value of the `TFE_ADDRESS` environment variable is used.
+	// If the environment variable is not set either, the default address `https://app.terraform.io` is used.
+	//
+	//+kubebuilder:validation:Pattern:="^https?://"
+	//+optional
+	Address string `json:"address,omitempty"`
+	// API Token to be used for API calls.
+	// Custom resources that refer to this Connection use this token unless they set their own `spec.token`.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// TLS settings of the API endpoint.
+	//
+	//+optional
+	TLS *ConnectionTLS `json:"tls,omitempty"`
+	// The URL of an HTTP proxy to use for API calls.
+	// When not set, the proxy is configured by the `HTTPS_PROXY`, `HTTP_PROXY`, and `NO_PROXY` environment variables.
+	//
+	//+kubebuilder:validation:Pattern:="^(https?|socks5)://"
+	//+optional
+	ProxyURL string `json:"proxyURL,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Address",type=string,JSONPath=`.spec.address`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
+
+// Connection defines how to connect to HCP Terraform or Terraform Enterprise.
+// Other custom resources in the same namespace can refer to a Connection via `spec.connectionRef`
+// instead of configuring the API token and address on each object.
+type Connection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ConnectionSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ConnectionList contains a list of Connection.
+type ConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Connection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Connection{}, &ConnectionList{})
+}
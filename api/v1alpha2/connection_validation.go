@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"net/url"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (c *Connection) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, c.validateSpecAddress()...)
+	allErrs = append(allErrs, c.validateSpecTLS()...)
+	allErrs = append(allErrs, c.validateSpecProxyURL()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "Connection"},
+		c.Name,
+		allErrs,
+	)
+}
+
+func (c *Connection) validateSpecAddress() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.Spec.Address == "" {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("address")
+	u, err := url.Parse(c.Spec.Address)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(f, c.Spec.Address, err.Error()))
+		return allErrs
+	}
+	if u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(f, c.Spec.Address, "address must contain a host"))
+	}
+
+	return allErrs
+}
+
+func (c *Connection) validateSpecTLS() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.Spec.TLS == nil {
+		return allErrs
+	}
+
+	if r := c.Spec.TLS.ClientCertificateSecretRef; r != nil && r.Name == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("tls").Child("clientCertificateSecretRef").Child("name"), "name must not be empty"))
+	}
+
+	if c.Spec.TLS.CABundle == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("tls").Child("caBundle")
+	b := c.Spec.TLS.CABundle
+	if b.ConfigMapKeyRef == nil && b.SecretKeyRef == nil {
+		allErrs = append(allErrs, field.Invalid(f, "", "one of the field configMapKeyRef or secretKeyRef must be set"))
+	}
+	if b.ConfigMapKeyRef != nil && b.SecretKeyRef != nil {
+		allErrs = append(allErrs, field.Invalid(f, "", "only one of the field configMapKeyRef or secretKeyRef is allowed"))
+	}
+
+	return allErrs
+}
+
+func (c *Connection) validateSpecProxyURL() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.Spec.ProxyURL == "" {
+		return allErrs
+	}
+
+	if _, err := url.Parse(c.Spec.ProxyURL); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("proxyURL"), c.Spec.ProxyURL, err.Error()))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateConnectionSpecAddress(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Connection{
+		"AddressIsNotSet": {
+			Spec: ConnectionSpec{},
+		},
+		"AddressIsValid": {
+			Spec: ConnectionSpec{
+				Address: "https://tfe.example.com",
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAddress()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Connection{
+		"AddressWithoutHost": {
+			Spec: ConnectionSpec{
+				Address: "https://",
+			},
+		},
+		"AddressIsInvalid": {
+			Spec: ConnectionSpec{
+				Address: "https://tfe example com:port",
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecAddress()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateConnectionSpecTLS(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Connection{
+		"TLSIsNotSet": {
+			Spec: ConnectionSpec{},
+		},
+		"CABundleFromConfigMap": {
+			Spec: ConnectionSpec{
+				TLS: &ConnectionTLS{
+					CABundle: &ValueFrom{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+							Key:                  "ca.crt",
+						},
+					},
+				},
+			},
+		},
+		"CABundleFromSecret": {
+			Spec: ConnectionSpec{
+				TLS: &ConnectionTLS{
+					CABundle: &ValueFrom{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+							Key:                  "ca.crt",
+						},
+					},
+				},
+			},
+		},
+		"ClientCertificateSecretRef": {
+			Spec: ConnectionSpec{
+				TLS: &ConnectionTLS{
+					ClientCertificateSecretRef: &corev1.LocalObjectReference{Name: "this"},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTLS()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Connection{
+		"CABundleIsEmpty": {
+			Spec: ConnectionSpec{
+				TLS: &ConnectionTLS{
+					CABundle: &ValueFrom{},
+				},
+			},
+		},
+		"CABundleFromConfigMapAndSecret": {
+			Spec: ConnectionSpec{
+				TLS: &ConnectionTLS{
+					CABundle: &ValueFrom{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+							Key:                  "ca.crt",
+						},
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+							Key:                  "ca.crt",
+						},
+					},
+				},
+			},
+		},
+		"ClientCertificateSecretRefWithoutName": {
+			Spec: ConnectionSpec{
+				TLS: &ConnectionTLS{
+					ClientCertificateSecretRef: &corev1.LocalObjectReference{},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecTLS()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
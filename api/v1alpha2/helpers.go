@@ -4,7 +4,15 @@
 package v1alpha2
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+
 	tfc "github.com/hashicorp/go-tfe"
+	"github.com/robfig/cron/v3"
 )
 
 func (rs *RunStatus) RunCompleted() bool {
@@ -33,6 +41,88 @@ func runCompleted(status string) bool {
 	return false
 }
 
+func (ps *PlanSummary) PlanCompleted() bool {
+	return planCompleted(ps.Status)
+}
+
+// planCompleted returns true if the plan does not progress anymore
+func planCompleted(status string) bool {
+	switch status {
+	case string(tfc.PlanFinished):
+		return true
+	case string(tfc.PlanErrored):
+		return true
+	case string(tfc.PlanCanceled):
+		return true
+	case string(tfc.PlanUnreachable):
+		return true
+	}
+
+	return false
+}
+
+func (ds *DriftDetectionStatus) RunCompleted() bool {
+	return runCompleted(ds.RunStatus)
+}
+
+// NextScheduleTime returns the first time after a given time when a drift detection Run is triggered.
+func (d *DriftDetection) NextScheduleTime(t time.Time) (time.Time, error) {
+	return nextScheduleTime(d.Schedule, d.TimeZone, t)
+}
+
+// NextScheduleTime returns the first time after a given time when the schedule triggers a Run.
+func (s *WorkspaceSchedule) NextScheduleTime(t time.Time) (time.Time, error) {
+	return nextScheduleTime(s.Schedule, s.TimeZone, t)
+}
+
+// IsSuspended returns true if the schedule does not trigger Runs at a given time.
+func (s *WorkspaceSchedule) IsSuspended(t time.Time) bool {
+	if s.Suspend {
+		return true
+	}
+
+	for _, w := range s.SuspensionWindows {
+		if !t.Before(w.Start.Time) && t.Before(w.End.Time) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseSchedule parses a given cron schedule in the standard format.
+// Unlike the cron parser, it rejects the `CRON_TZ=` and `TZ=` prefixes, since the time zone is a separate field,
+// and the `@every` descriptor, since its intervals do not match the standard format.
+func parseSchedule(schedule string) (cron.Schedule, error) {
+	if strings.HasPrefix(schedule, "CRON_TZ=") || strings.HasPrefix(schedule, "TZ=") {
+		return nil, errors.New("time zone prefixes are not supported, use the time zone field instead")
+	}
+	if strings.HasPrefix(schedule, "@every") {
+		return nil, errors.New("the @every descriptor is not supported")
+	}
+
+	return cron.ParseStandard(schedule)
+}
+
+// nextScheduleTime returns the first time after a given time that matches a given cron schedule in a given time zone.
+// The time zone defaults to UTC.
+func nextScheduleTime(schedule, timeZone string, t time.Time) (time.Time, error) {
+	s, err := parseSchedule(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc := time.UTC
+	if timeZone != "" {
+		loc, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return s.Next(t.In(loc)), nil
+}
+
 func (rs *RunStatus) RunApplied() bool {
 	return runApplied(rs.Status)
 }
@@ -49,3 +139,28 @@ func runApplied(status string) bool {
 
 	return false
 }
+
+// outputTemplateFuncs contains the functions available in the output target templates in addition to the built-in ones.
+var outputTemplateFuncs = template.FuncMap{
+	"toJson": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"b64enc": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+}
+
+// Templates parses the data templates of the output target and returns them by the data key.
+func (t *OutputTarget) Templates() (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(t.Data))
+	for k, v := range t.Data {
+		tmpl, err := template.New(k).Funcs(outputTemplateFuncs).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		templates[k] = tmpl
+	}
+
+	return templates, nil
+}
@@ -5,8 +5,11 @@ package v1alpha2
 
 import (
 	"testing"
+	"time"
 
 	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var runStatuses = map[tfc.RunStatus]struct{}{
@@ -82,3 +85,76 @@ func TestRunApplied(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanCompleted(t *testing.T) {
+	t.Parallel()
+
+	planStatuses := []tfc.PlanStatus{
+		tfc.PlanCanceled,
+		tfc.PlanCreated,
+		tfc.PlanErrored,
+		tfc.PlanFinished,
+		tfc.PlanMFAWaiting,
+		tfc.PlanPending,
+		tfc.PlanQueued,
+		tfc.PlanRunning,
+		tfc.PlanUnreachable,
+	}
+
+	trueCases := map[tfc.PlanStatus]struct{}{
+		tfc.PlanCanceled:    {},
+		tfc.PlanErrored:     {},
+		tfc.PlanFinished:    {},
+		tfc.PlanUnreachable: {},
+	}
+
+	for _, n := range planStatuses {
+		t.Run(string(n), func(t *testing.T) {
+			_, ok := trueCases[n]
+			if planCompleted(string(n)) != ok {
+				t.Fatalf("Expected result to be %t for status %#v", ok, n)
+			}
+		})
+	}
+}
+
+func TestNextScheduleTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 1, 10, 15, 0, 0, time.UTC)
+
+	t.Run("UTC", func(t *testing.T) {
+		next, err := nextScheduleTime("0 */6 * * *", "", now)
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC).Equal(next))
+	})
+
+	t.Run("TimeZone", func(t *testing.T) {
+		// 11:15 in Amsterdam, the next 12:00 in Amsterdam is 11:00 UTC.
+		next, err := nextScheduleTime("0 12 * * *", "Europe/Amsterdam", now)
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC).Equal(next))
+	})
+
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		_, err := nextScheduleTime("every day", "", now)
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidTimeZone", func(t *testing.T) {
+		_, err := nextScheduleTime("0 12 * * *", "Mars/Olympus_Mons", now)
+		assert.Error(t, err)
+	})
+
+	t.Run("TimeZonePrefix", func(t *testing.T) {
+		_, err := nextScheduleTime("CRON_TZ=Europe/Amsterdam 0 12 * * *", "", now)
+		assert.Error(t, err)
+		_, err = nextScheduleTime("TZ=Europe/Amsterdam 0 12 * * *", "", now)
+		assert.Error(t, err)
+	})
+
+	t.Run("Every", func(t *testing.T) {
+		_, err := nextScheduleTime("@every 6h", "", now)
+		assert.Error(t, err)
+	})
+}
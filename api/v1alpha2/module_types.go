@@ -4,6 +4,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -101,7 +102,16 @@ type ModuleSpec struct {
 	//+kubebuilder:validation:MinLength:=1
 	Organization string `json:"organization"`
 	// API Token to be used for API calls.
-	Token Token `json:"token"`
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
 	// Module source and version to execute.
 	Module *ModuleSource `json:"module"`
 	// Workspace to execute the module.
@@ -149,6 +159,23 @@ type ModuleSpec struct {
 	//+kubebuilder:default:=retain
 	//+optional
 	DeletionPolicy ModuleDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Management mode of the object.
+	// - `enforce`: The Operator uploads the Module to the Workspace and triggers runs to match the spec.
+	// - `observe`: The Operator only reads the Workspace and reports the differences from the spec in `status.observation` and events.
+	//   It does not make any changes in HCP Terraform, the Module is not uploaded and runs are not triggered.
+	// Default: `enforce`.
+	//
+	//+kubebuilder:validation:Enum:=enforce;observe
+	//+kubebuilder:default:=enforce
+	//+optional
+	ManagementMode ManagementMode `json:"managementMode,omitempty"`
+	// ConfigMaps and Secrets to render the Module outputs into once a run is applied.
+	// They are created in addition to the `<metadata.name>-module-outputs` ConfigMap and Secret.
+	// Only the outputs listed in `spec.outputs` are available.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	OutputTargets []OutputTarget `json:"outputTargets,omitempty"`
 }
 
 // ModuleStatus defines the observed state of Module.
@@ -172,13 +199,26 @@ type ModuleStatus struct {
 	//
 	//+optional
 	DestroyRunID string `json:"destroyRunID,omitempty"`
+	// Result of the last observation of the Workspace in the `observe` management mode.
+	//
+	//+optional
+	Observation *ObservationStatus `json:"observation,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="CV Status",type=string,JSONPath=`.status.configurationVersion.status`
 //+kubebuilder:printcolumn:name="Run Status",type=string,JSONPath=`.status.run.status`
-//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.4.0"
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
 
 // Module implements API-driven Run Workflows.
 // More information:
@@ -12,7 +12,9 @@ import (
 func (m *Module) ValidateSpec() error {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateToken(m.Spec.Token, m.Spec.ConnectionRef, field.NewPath("spec"))...)
 	allErrs = append(allErrs, m.validateSpecWorkspace()...)
+	allErrs = append(allErrs, validateOutputTargets(m.Spec.OutputTargets, m.Name+"-module-outputs", field.NewPath("spec").Child("outputTargets"))...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a run task, either manually or by a system event.
+//
+// You must use one of the following values:
+// - `retain`: When the custom resource is deleted, the operator will not delete the associated run task.
+// - `destroy`: Removes the run task. Workspaces that use the run task lose it.
+type OrganizationRunTaskDeletionPolicy string
+
+const (
+	OrganizationRunTaskDeletionPolicyRetain  OrganizationRunTaskDeletionPolicy = "retain"
+	OrganizationRunTaskDeletionPolicyDestroy OrganizationRunTaskDeletionPolicy = "destroy"
+)
+
+// OrganizationRunTaskSpec defines the desired state of OrganizationRunTask.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/settings/run-tasks
+type OrganizationRunTaskSpec struct {
+	// Organization name where the Run Task will be created.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
+	// Name of the Run Task.
+	// Workspaces refer to the Run Task by this name via `spec.runTasks`.
+	// Can only contain letters, numbers, dashes, and underscores.
+	//
+	//+kubebuilder:validation:Pattern:="^[a-zA-Z0-9_-]+$"
+	Name string `json:"name"`
+	// URL to send the Run Task payload to.
+	// Must match pattern: `^https?://`
+	//
+	//+kubebuilder:validation:Pattern:="^https?://"
+	URL string `json:"url"`
+	// Description of the Run Task.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Description string `json:"description,omitempty"`
+	// Category of the Run Task.
+	// Must be one of the following values: `task`.
+	// Default: `task`.
+	//
+	//+kubebuilder:validation:Enum:=task
+	//+kubebuilder:default:=task
+	//+optional
+	Category string `json:"category,omitempty"`
+	// HMAC key to verify the Run Task requests.
+	// The key is never returned by the API, the operator updates the Run Task when the value of the key changes.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/integrations/run-tasks#securing-your-run-task
+	//
+	//+optional
+	HMACKey *Token `json:"hmacKey,omitempty"`
+	// Whether the Run Task runs on workspaces it is attached to.
+	// Default: `true`.
+	//
+	//+kubebuilder:default:=true
+	//+optional
+	Enabled bool `json:"enabled"`
+	// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a run task, either manually or by a system event.
+	//
+	// You must use one of the following values:
+	// - `retain`: When the custom resource is deleted, the operator will not delete the associated run task.
+	// - `destroy`: Removes the run task. Workspaces that use the run task lose it.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy OrganizationRunTaskDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// OrganizationRunTaskStatus defines the observed state of OrganizationRunTask.
+type OrganizationRunTaskStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Run Task ID.
+	ID string `json:"id"`
+	// Run Task name.
+	Name string `json:"name"`
+	// HMACKeyID is a hash of the HMAC key on the CRD end.
+	//
+	//+optional
+	HMACKeyID string `json:"hmacKeyID,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Run Task Name",type=string,JSONPath=`.status.name`
+//+kubebuilder:printcolumn:name="Run Task ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
+
+// OrganizationRunTask manages HCP Terraform organization Run Tasks.
+// Workspaces attach Run Tasks via `spec.runTasks`.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/settings/run-tasks
+type OrganizationRunTask struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationRunTaskSpec   `json:"spec"`
+	Status OrganizationRunTaskStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OrganizationRunTaskList contains a list of OrganizationRunTask
+type OrganizationRunTaskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationRunTask `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OrganizationRunTask{}, &OrganizationRunTaskList{})
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (rt *OrganizationRunTask) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateToken(rt.Spec.Token, rt.Spec.ConnectionRef, field.NewPath("spec"))...)
+	allErrs = append(allErrs, rt.validateSpecHMACKey()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "OrganizationRunTask"},
+		rt.Name,
+		allErrs,
+	)
+}
+
+// validateSpecHMACKey validates that the HMAC key refers to a key of a Secret.
+func (rt *OrganizationRunTask) validateSpecHMACKey() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rt.Spec.HMACKey == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("hmacKey").Child("secretKeyRef")
+	if rt.Spec.HMACKey.SecretKeyRef == nil {
+		allErrs = append(allErrs, field.Required(f, "secretKeyRef must be set"))
+		return allErrs
+	}
+
+	if rt.Spec.HMACKey.SecretKeyRef.Name == "" {
+		allErrs = append(allErrs, field.Required(f.Child("name"), "name must not be empty"))
+	}
+
+	if rt.Spec.HMACKey.SecretKeyRef.Key == "" {
+		allErrs = append(allErrs, field.Required(f.Child("key"), "key must not be empty"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateOrganizationRunTaskSpecHMACKey(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]OrganizationRunTask{
+		"HasSecretKeyRef": {
+			Spec: OrganizationRunTaskSpec{
+				HMACKey: &Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+						Key:                  "hmac",
+					},
+				},
+			},
+		},
+		"HasNoHMACKey": {
+			Spec: OrganizationRunTaskSpec{},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecHMACKey()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]OrganizationRunTask{
+		"HasNoSecretKeyRef": {
+			Spec: OrganizationRunTaskSpec{
+				HMACKey: &Token{},
+			},
+		},
+		"HasEmptySecretName": {
+			Spec: OrganizationRunTaskSpec{
+				HMACKey: &Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key: "hmac",
+					},
+				},
+			},
+		},
+		"HasEmptySecretKey": {
+			Spec: OrganizationRunTaskSpec{
+				HMACKey: &Token{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecHMACKey()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
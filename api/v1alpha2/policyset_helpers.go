@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"github.com/hashicorp/hcp-terraform-operator/internal/slice"
+)
+
+func (p *PolicySet) IsCreationCandidate() bool {
+	return p.Status.ID == ""
+}
+
+// ParametersAsVariables returns the policy set parameters as variables,
+// so they can be validated and resolved the same way as workspace variables.
+func (s *PolicySetSpec) ParametersAsVariables() []Variable {
+	if len(s.Parameters) == 0 {
+		return nil
+	}
+
+	v := make([]Variable, 0, len(s.Parameters))
+	for _, p := range s.Parameters {
+		v = append(v, Variable{
+			Name:      p.Name,
+			Value:     p.Value,
+			ValueFrom: p.ValueFrom,
+			Sensitive: p.Sensitive,
+		})
+	}
+
+	return v
+}
+
+// AddOrUpdatePolicyStatus adds a given policy to the status if it does not exist there; otherwise, it updates it.
+func (s *PolicySetStatus) AddOrUpdatePolicyStatus(policy PolicySetPolicyStatus) {
+	for i, p := range s.Policies {
+		if p.Name == policy.Name {
+			s.Policies[i] = policy
+			return
+		}
+	}
+
+	s.Policies = append(s.Policies, policy)
+}
+
+// GetPolicyStatus returns a given policy from the status if it exists there; otherwise, nil.
+func (s *PolicySetStatus) GetPolicyStatus(name string) *PolicySetPolicyStatus {
+	for _, p := range s.Policies {
+		if p.Name == name {
+			return &p
+		}
+	}
+
+	return nil
+}
+
+// DeletePolicyStatus deletes a given policy from the status.
+func (s *PolicySetStatus) DeletePolicyStatus(name string) {
+	for i, p := range s.Policies {
+		if p.Name == name {
+			s.Policies = slice.RemoveFromSlice(s.Policies, i)
+			return
+		}
+	}
+}
+
+// AddOrUpdateParameterStatus adds a given parameter to the status if it does not exist there; otherwise, it updates it.
+func (s *PolicySetStatus) AddOrUpdateParameterStatus(parameter PolicySetParameterStatus) {
+	for i, p := range s.Parameters {
+		if p.Name == parameter.Name {
+			s.Parameters[i] = parameter
+			return
+		}
+	}
+
+	s.Parameters = append(s.Parameters, parameter)
+}
+
+// GetParameterStatus returns a given parameter from the status if it exists there; otherwise, nil.
+func (s *PolicySetStatus) GetParameterStatus(name string) *PolicySetParameterStatus {
+	for _, p := range s.Parameters {
+		if p.Name == name {
+			return &p
+		}
+	}
+
+	return nil
+}
+
+// DeleteParameterStatus deletes a given parameter from the status.
+func (s *PolicySetStatus) DeleteParameterStatus(name string) {
+	for i, p := range s.Parameters {
+		if p.Name == name {
+			s.Parameters = slice.RemoveFromSlice(s.Parameters, i)
+			return
+		}
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicySetPolicy is a policy of the policy set.
+// The policy code comes either from the field `Content` or from a Kubernetes ConfigMap or Secret via `ContentFrom`.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/manage-policy-sets
+type PolicySetPolicy struct {
+	// Name of the policy.
+	// Policy names must be unique within the organization.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Description of the policy.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Description string `json:"description,omitempty"`
+	// Enforcement level of the policy.
+	// Sentinel policies must use one of the following values: `advisory`, `soft-mandatory`, `hard-mandatory`.
+	// OPA policies must use one of the following values: `advisory`, `mandatory`.
+	// Default: `advisory`.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/manage-policy-sets#policy-enforcement-levels
+	//
+	//+kubebuilder:validation:Enum:=advisory;soft-mandatory;hard-mandatory;mandatory
+	//+kubebuilder:default:=advisory
+	//+optional
+	EnforcementLevel string `json:"enforcementLevel,omitempty"`
+	// OPA query that determines whether the policy passes.
+	// Must be set for OPA policies and cannot be used for Sentinel policies.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/define-policies/opa#query
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Query string `json:"query,omitempty"`
+	// Policy code.
+	// Only one of the fields `Content` or `ContentFrom` is allowed.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Content string `json:"content,omitempty"`
+	// Source for the policy code.
+	// Only one of the fields `Content` or `ContentFrom` is allowed.
+	//
+	//+optional
+	ContentFrom *ValueFrom `json:"contentFrom,omitempty"`
+}
+
+// PolicySetVersionControl defines the VCS repository the policies of the policy set come from.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/manage-policy-sets#managing-policy-sets-with-vcs
+type PolicySetVersionControl struct {
+	// The VCS Connection (OAuth Connection + Token) to use.
+	// Must match pattern: `^ot-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^ot-[a-zA-Z0-9]+$"
+	OAuthTokenID string `json:"oAuthTokenID"`
+	// A reference to your VCS repository in the format `<organization>/<repository>` where `<organization>` and `<repository>` refer to the organization and repository in your VCS provider.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Repository string `json:"repository"`
+	// The repository branch to fetch the policies from. This defaults to the repository's default branch (e.g. main).
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Branch string `json:"branch,omitempty"`
+	// The subdirectory of the repository that contains the policies.
+	// Defaults to the root of the repository.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	PoliciesPath string `json:"policiesPath,omitempty"`
+	// Whether to fetch the Git submodules of the repository.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	IngressSubmodules bool `json:"ingressSubmodules,omitempty"`
+}
+
+// PolicySetParameter is a key/value pair that is passed to Sentinel policies as a parameter.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/manage-policy-sets#parameters
+type PolicySetParameter struct {
+	// Name of the parameter.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Value of the parameter.
+	//
+	//+optional
+	Value string `json:"value,omitempty"`
+	// Source for the parameter's value.
+	// Cannot be used if value is not empty.
+	//
+	//+optional
+	ValueFrom *ValueFrom `json:"valueFrom,omitempty"`
+	// Sensitive parameters are never shown in the UI or API.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// PolicySetWorkspace is a workspace to apply the policy set to.
+// Only one of the fields `ID` or `Name` is allowed.
+// At least one of the fields `ID` or `Name` is mandatory.
+type PolicySetWorkspace struct {
+	// Workspace ID.
+	// Must match pattern: `^ws-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^ws-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Workspace name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Name string `json:"name,omitempty"`
+}
+
+// PolicySetProject is a project to apply the policy set to.
+// The policy set applies to all current and future workspaces in the project.
+// Only one of the fields `ID` or `Name` is allowed.
+// At least one of the fields `ID` or `Name` is mandatory.
+type PolicySetProject struct {
+	// Project ID.
+	// Must match pattern: `^prj-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^prj-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Project name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Name string `json:"name,omitempty"`
+}
+
+// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a policy set, either manually or by a system event.
+//
+// You must use one of the following values:
+// - `retain`: When the custom resource is deleted, the operator will not delete the associated policy set and its policies.
+// - `destroy`: Removes the policy set and the policies the operator created for it.
+type PolicySetDeletionPolicy string
+
+const (
+	PolicySetDeletionPolicyRetain  PolicySetDeletionPolicy = "retain"
+	PolicySetDeletionPolicyDestroy PolicySetDeletionPolicy = "destroy"
+)
+
+// Policy frameworks of a policy set.
+const (
+	PolicySetKindSentinel = "sentinel"
+	PolicySetKindOPA      = "opa"
+)
+
+// PolicySetSpec defines the desired state of PolicySet.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/manage-policy-sets
+type PolicySetSpec struct {
+	// Organization name where the Policy Set will be created.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
+	// Name of the Policy Set.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Description of the Policy Set.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Description string `json:"description,omitempty"`
+	// Policy framework of the Policy Set.
+	// Cannot be changed after the Policy Set is created.
+	// Must be one of the following values: `sentinel`, `opa`.
+	// Default: `sentinel`.
+	//
+	//+kubebuilder:validation:Enum:=sentinel;opa
+	//+kubebuilder:default:=sentinel
+	//+optional
+	Kind string `json:"kind,omitempty"`
+	// Whether users can override failed mandatory policies of the Policy Set.
+	// Can only be used with OPA Policy Sets.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Overridable bool `json:"overridable,omitempty"`
+	// Global Policy Sets apply to all current and future workspaces in the organization.
+	// Cannot be used together with `spec.workspaces` or `spec.projects`.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Global bool `json:"global,omitempty"`
+	// Policies of the Policy Set.
+	// Cannot be used together with `spec.versionControl`.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Policies []PolicySetPolicy `json:"policies,omitempty"`
+	// VCS repository to fetch the policies from.
+	// Cannot be used together with `spec.policies`.
+	//
+	//+optional
+	VersionControl *PolicySetVersionControl `json:"versionControl,omitempty"`
+	// Parameters passed to the Sentinel policies of the Policy Set.
+	// Can only be used with Sentinel Policy Sets.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Parameters []PolicySetParameter `json:"parameters,omitempty"`
+	// Workspaces to apply the Policy Set to.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Workspaces []PolicySetWorkspace `json:"workspaces,omitempty"`
+	// Projects to apply the Policy Set to.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Projects []PolicySetProject `json:"projects,omitempty"`
+	// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a policy set, either manually or by a system event.
+	//
+	// You must use one of the following values:
+	// - `retain`: When the custom resource is deleted, the operator will not delete the associated policy set and its policies.
+	// - `destroy`: Removes the policy set and the policies the operator created for it.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy PolicySetDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// PolicySetPolicyStatus is a policy the operator created for the policy set.
+type PolicySetPolicyStatus struct {
+	// Policy name.
+	Name string `json:"name"`
+	// Policy ID.
+	ID string `json:"id"`
+	// Hash of the uploaded policy code.
+	ContentID string `json:"contentID"`
+}
+
+// PolicySetParameterStatus is a parameter of the policy set.
+type PolicySetParameterStatus struct {
+	// Parameter name.
+	Name string `json:"name"`
+	// Parameter ID.
+	ID string `json:"id"`
+	// Hash of the parameter value.
+	ValueID string `json:"valueID"`
+}
+
+// PolicySetStatus defines the observed state of PolicySet.
+type PolicySetStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Policy Set ID.
+	ID string `json:"id"`
+	// Policy Set name.
+	Name string `json:"name"`
+	// Policies the operator created for the Policy Set.
+	//
+	//+optional
+	Policies []PolicySetPolicyStatus `json:"policies,omitempty"`
+	// Policy Set parameters.
+	//
+	//+optional
+	Parameters []PolicySetParameterStatus `json:"parameters,omitempty"`
+	// IDs of workspaces the operator applied the Policy Set to.
+	//
+	//+optional
+	Workspaces []string `json:"workspaces,omitempty"`
+	// IDs of projects the operator applied the Policy Set to.
+	//
+	//+optional
+	Projects []string `json:"projects,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Policy Set Name",type=string,JSONPath=`.status.name`
+//+kubebuilder:printcolumn:name="Policy Set ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
+
+// PolicySet manages HCP Terraform Policy Sets.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/policy-enforcement/manage-policy-sets
+type PolicySet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySetSpec   `json:"spec"`
+	Status PolicySetStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PolicySetList contains a list of PolicySet
+type PolicySetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicySet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PolicySet{}, &PolicySetList{})
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (p *PolicySet) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateToken(p.Spec.Token, p.Spec.ConnectionRef, field.NewPath("spec"))...)
+	allErrs = append(allErrs, p.validateSpecKind()...)
+	allErrs = append(allErrs, p.validateSpecGlobal()...)
+	allErrs = append(allErrs, p.validateSpecPolicies()...)
+	allErrs = append(allErrs, p.validateSpecParameters()...)
+	allErrs = append(allErrs, p.validateSpecWorkspaces()...)
+	allErrs = append(allErrs, p.validateSpecProjects()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "PolicySet"},
+		p.Name,
+		allErrs,
+	)
+}
+
+// kind returns the policy framework of the policy set. An empty kind means Sentinel.
+func (p *PolicySet) kind() string {
+	if p.Spec.Kind == "" {
+		return PolicySetKindSentinel
+	}
+	return p.Spec.Kind
+}
+
+// validateSpecKind validates that fields specific to one policy framework are not used with the other one.
+func (p *PolicySet) validateSpecKind() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	f := field.NewPath("spec")
+	if p.Spec.Overridable && p.kind() != PolicySetKindOPA {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("overridable"),
+			"",
+			"'spec.overridable' can only be used when 'spec.kind' is set to 'opa'"),
+		)
+	}
+	if len(p.Spec.Parameters) > 0 && p.kind() != PolicySetKindSentinel {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("parameters"),
+			"",
+			"'spec.parameters' can only be used when 'spec.kind' is set to 'sentinel'"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecGlobal validates that a global policy set is not applied to specific workspaces or projects.
+func (p *PolicySet) validateSpecGlobal() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !p.Spec.Global {
+		return allErrs
+	}
+
+	f := field.NewPath("spec")
+	if len(p.Spec.Workspaces) > 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("workspaces"),
+			"",
+			"'spec.workspaces' cannot be used when 'spec.global' is set to 'true'"),
+		)
+	}
+	if len(p.Spec.Projects) > 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("projects"),
+			"",
+			"'spec.projects' cannot be used when 'spec.global' is set to 'true'"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecPolicies validates that inlined policies are not used together with a VCS repository,
+// that policy names are unique, and that each policy matches the policy framework of the policy set.
+func (p *PolicySet) validateSpecPolicies() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fp := field.NewPath("spec").Child("policies")
+	if len(p.Spec.Policies) > 0 && p.Spec.VersionControl != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fp,
+			"",
+			"'spec.policies' cannot be used together with 'spec.versionControl'"),
+		)
+	}
+
+	d := make(map[string]struct{})
+	for i, policy := range p.Spec.Policies {
+		f := fp.Child(fmt.Sprintf("[%d]", i))
+
+		if _, ok := d[policy.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("Name"), policy.Name))
+		}
+		d[policy.Name] = struct{}{}
+
+		if policy.Content == "" && policy.ContentFrom == nil {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				"one of the field Content or ContentFrom must be set"),
+			)
+		}
+
+		if policy.Content != "" && policy.ContentFrom != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				"only one of the field Content or ContentFrom is allowed"),
+			)
+		}
+
+		if policy.ContentFrom != nil {
+			if policy.ContentFrom.ConfigMapKeyRef == nil && policy.ContentFrom.SecretKeyRef == nil {
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("ContentFrom"),
+					"",
+					"at least one of ConfigMapKeyRef or SecretKeyRef must be set"),
+				)
+			}
+			if policy.ContentFrom.ConfigMapKeyRef != nil && policy.ContentFrom.SecretKeyRef != nil {
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("ContentFrom"),
+					"",
+					"only one of ConfigMapKeyRef or SecretKeyRef is allowed"),
+				)
+			}
+		}
+
+		switch p.kind() {
+		case PolicySetKindOPA:
+			if policy.Query == "" {
+				allErrs = append(allErrs, field.Required(f.Child("Query"), "OPA policies must have a query"))
+			}
+			switch policy.EnforcementLevel {
+			case "soft-mandatory", "hard-mandatory":
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("EnforcementLevel"),
+					policy.EnforcementLevel,
+					"OPA policies support only 'advisory' and 'mandatory' enforcement levels"),
+				)
+			}
+		case PolicySetKindSentinel:
+			if policy.Query != "" {
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("Query"),
+					policy.Query,
+					"Query can only be used with OPA policies"),
+				)
+			}
+			if policy.EnforcementLevel == "mandatory" {
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("EnforcementLevel"),
+					policy.EnforcementLevel,
+					"Sentinel policies support only 'advisory', 'soft-mandatory', and 'hard-mandatory' enforcement levels"),
+				)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (p *PolicySet) validateSpecParameters() field.ErrorList {
+	return validateSpecVariables(field.NewPath("spec").Child("parameters"), p.Spec.ParametersAsVariables())
+}
+
+func (p *PolicySet) validateSpecWorkspaces() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	wi := make(map[string]int)
+	wn := make(map[string]int)
+
+	for i, ws := range p.Spec.Workspaces {
+		f := field.NewPath("spec").Child("workspaces").Child(fmt.Sprintf("[%d]", i))
+		allErrs = append(allErrs, validateSpecIDOrName(f, ws.ID, ws.Name, wi, wn, i)...)
+	}
+
+	return allErrs
+}
+
+func (p *PolicySet) validateSpecProjects() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	pi := make(map[string]int)
+	pn := make(map[string]int)
+
+	for i, prj := range p.Spec.Projects {
+		f := field.NewPath("spec").Child("projects").Child(fmt.Sprintf("[%d]", i))
+		allErrs = append(allErrs, validateSpecIDOrName(f, prj.ID, prj.Name, pi, pn, i)...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidatePolicySetSpecKind(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]PolicySet{
+		"OverridableOPA": {
+			Spec: PolicySetSpec{
+				Kind:        PolicySetKindOPA,
+				Overridable: true,
+			},
+		},
+		"ParametersSentinel": {
+			Spec: PolicySetSpec{
+				Kind:       PolicySetKindSentinel,
+				Parameters: []PolicySetParameter{{Name: "this", Value: "this"}},
+			},
+		},
+		"ParametersDefaultKind": {
+			Spec: PolicySetSpec{
+				Parameters: []PolicySetParameter{{Name: "this", Value: "this"}},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecKind()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]PolicySet{
+		"OverridableSentinel": {
+			Spec: PolicySetSpec{
+				Kind:        PolicySetKindSentinel,
+				Overridable: true,
+			},
+		},
+		"ParametersOPA": {
+			Spec: PolicySetSpec{
+				Kind:       PolicySetKindOPA,
+				Parameters: []PolicySetParameter{{Name: "this", Value: "this"}},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecKind()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidatePolicySetSpecGlobal(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]PolicySet{
+		"Global": {
+			Spec: PolicySetSpec{
+				Global: true,
+			},
+		},
+		"NotGlobalWithWorkspaces": {
+			Spec: PolicySetSpec{
+				Workspaces: []PolicySetWorkspace{{Name: "this"}},
+				Projects:   []PolicySetProject{{Name: "this"}},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecGlobal()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]PolicySet{
+		"GlobalWithWorkspaces": {
+			Spec: PolicySetSpec{
+				Global:     true,
+				Workspaces: []PolicySetWorkspace{{Name: "this"}},
+			},
+		},
+		"GlobalWithProjects": {
+			Spec: PolicySetSpec{
+				Global:   true,
+				Projects: []PolicySetProject{{Name: "this"}},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecGlobal()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidatePolicySetSpecPolicies(t *testing.T) {
+	t.Parallel()
+
+	contentFrom := &ValueFrom{
+		ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+			Key:                  "this",
+		},
+	}
+
+	successCases := map[string]PolicySet{
+		"SentinelWithContent": {
+			Spec: PolicySetSpec{
+				Kind: PolicySetKindSentinel,
+				Policies: []PolicySetPolicy{
+					{Name: "this", Content: "main = true", EnforcementLevel: "hard-mandatory"},
+				},
+			},
+		},
+		"SentinelWithContentFrom": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{
+					{Name: "this", ContentFrom: contentFrom},
+				},
+			},
+		},
+		"OPAWithQuery": {
+			Spec: PolicySetSpec{
+				Kind: PolicySetKindOPA,
+				Policies: []PolicySetPolicy{
+					{Name: "this", Content: "package this", Query: "data.this.deny", EnforcementLevel: "mandatory"},
+				},
+			},
+		},
+		"VersionControl": {
+			Spec: PolicySetSpec{
+				VersionControl: &PolicySetVersionControl{OAuthTokenID: "ot-this", Repository: "this/this"},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecPolicies()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]PolicySet{
+		"PoliciesWithVersionControl": {
+			Spec: PolicySetSpec{
+				Policies:       []PolicySetPolicy{{Name: "this", Content: "main = true"}},
+				VersionControl: &PolicySetVersionControl{OAuthTokenID: "ot-this", Repository: "this/this"},
+			},
+		},
+		"DuplicateName": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{
+					{Name: "this", Content: "main = true"},
+					{Name: "this", Content: "main = false"},
+				},
+			},
+		},
+		"NoContent": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{{Name: "this"}},
+			},
+		},
+		"ContentAndContentFrom": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{{Name: "this", Content: "main = true", ContentFrom: contentFrom}},
+			},
+		},
+		"EmptyContentFrom": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{{Name: "this", ContentFrom: &ValueFrom{}}},
+			},
+		},
+		"OPAWithoutQuery": {
+			Spec: PolicySetSpec{
+				Kind:     PolicySetKindOPA,
+				Policies: []PolicySetPolicy{{Name: "this", Content: "package this"}},
+			},
+		},
+		"OPAWithSentinelEnforcementLevel": {
+			Spec: PolicySetSpec{
+				Kind:     PolicySetKindOPA,
+				Policies: []PolicySetPolicy{{Name: "this", Content: "package this", Query: "data.this.deny", EnforcementLevel: "soft-mandatory"}},
+			},
+		},
+		"SentinelWithQuery": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{{Name: "this", Content: "main = true", Query: "data.this.deny"}},
+			},
+		},
+		"SentinelWithOPAEnforcementLevel": {
+			Spec: PolicySetSpec{
+				Policies: []PolicySetPolicy{{Name: "this", Content: "main = true", EnforcementLevel: "mandatory"}},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecPolicies()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -5,6 +5,7 @@ package v1alpha2
 
 import (
 	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -106,7 +107,7 @@ type ProjectTeamAccess struct {
 	// Team to grant access.
 	// More information:
 	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/teams
-	Team Team `json:"team"`
+	Team TeamRef `json:"team"`
 	// There are two ways to choose which permissions a given team has on a project: fixed permission sets, and custom permissions.
 	// Must be one of the following values: `admin`, `custom`, `maintain`, `read`, `write`.
 	// More information:
@@ -146,7 +147,16 @@ type ProjectSpec struct {
 	//+kubebuilder:validation:MinLength:=1
 	Organization string `json:"organization"`
 	// API Token to be used for API calls.
-	Token Token `json:"token"`
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
 	// Name of the Project.
 	//
 	//+kubebuilder:validation:MinLength:=1
@@ -173,6 +183,16 @@ type ProjectSpec struct {
 	//+kubebuilder:default=retain
 	//+optional
 	DeletionPolicy ProjectDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Management mode of the object.
+	// - `enforce`: The Operator creates, updates, and deletes the Project to match the spec.
+	// - `observe`: The Operator only reads the Project and reports the differences from the spec in `status.observation` and events.
+	//   It does not make any changes in HCP Terraform.
+	// Default: `enforce`.
+	//
+	//+kubebuilder:validation:Enum:=enforce;observe
+	//+kubebuilder:default:=enforce
+	//+optional
+	ManagementMode ManagementMode `json:"managementMode,omitempty"`
 }
 
 // ProjectStatus defines the observed state of Project.
@@ -183,13 +203,26 @@ type ProjectStatus struct {
 	ID string `json:"id"`
 	// Project name.
 	Name string `json:"name"`
+	// Result of the last observation of the Project in the `observe` management mode.
+	//
+	//+optional
+	Observation *ObservationStatus `json:"observation,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Project Name",type=string,JSONPath=`.status.name`
 //+kubebuilder:printcolumn:name="Project ID",type=string,JSONPath=`.status.id`
-//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.4.0"
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
 
 // Project manages HCP Terraform Projects.
 // More information:
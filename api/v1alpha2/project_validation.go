@@ -15,6 +15,7 @@ import (
 func (p *Project) ValidateSpec() error {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateToken(p.Spec.Token, p.Spec.ConnectionRef, field.NewPath("spec"))...)
 	allErrs = append(allErrs, p.validateSpecTeamAccess()...)
 
 	if len(allErrs) == 0 {
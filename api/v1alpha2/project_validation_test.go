@@ -86,12 +86,12 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{
+						Team: TeamRef{
 							ID: "this",
 						},
 					},
 					{
-						Team: Team{
+						Team: TeamRef{
 							ID: "self",
 						},
 					},
@@ -102,12 +102,12 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{
+						Team: TeamRef{
 							Name: "this",
 						},
 					},
 					{
-						Team: Team{
+						Team: TeamRef{
 							Name: "self",
 						},
 					},
@@ -118,12 +118,12 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{
+						Team: TeamRef{
 							ID: "this",
 						},
 					},
 					{
-						Team: Team{
+						Team: TeamRef{
 							Name: "self",
 						},
 					},
@@ -144,12 +144,12 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{
+						Team: TeamRef{
 							ID: "this",
 						},
 					},
 					{
-						Team: Team{
+						Team: TeamRef{
 							ID: "this",
 						},
 					},
@@ -160,12 +160,12 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{
+						Team: TeamRef{
 							Name: "this",
 						},
 					},
 					{
-						Team: Team{
+						Team: TeamRef{
 							Name: "this",
 						},
 					},
@@ -176,7 +176,7 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{
+						Team: TeamRef{
 							ID:   "this",
 							Name: "this",
 						},
@@ -188,7 +188,7 @@ func TestValidateSpecTeamAccessTeam(t *testing.T) {
 			Spec: ProjectSpec{
 				TeamAccess: []*ProjectTeamAccess{
 					{
-						Team: Team{},
+						Team: TeamRef{},
 					},
 				},
 			},
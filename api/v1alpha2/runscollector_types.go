@@ -4,6 +4,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,7 +16,16 @@ type RunsCollectorSpec struct {
 	//+kubebuilder:validation:MinLength:=1
 	Organization string `json:"organization"`
 	// API Token to be used for API calls.
-	Token Token `json:"token"`
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
 
 	// The Agent Pool name or ID from which the controller will collect runs.
 	// More information:
@@ -28,13 +38,22 @@ type RunsCollectorStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration"`
 	// The Agent Pool name or ID from which the controller will collect runs.
 	AgentPool *AgentPoolRef `json:"agentPool,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Pool ID",type=string,JSONPath=`.status.agentPool.id`
 //+kubebuilder:printcolumn:name="Pool Name",type=string,JSONPath=`.status.agentPool.name`
-//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.11.0"
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
 
 // RunsCollector scraptes HCP Terraform Run statuses from a given Agent Pool and exposes them as Prometheus-compatible metrics.
 // More information:
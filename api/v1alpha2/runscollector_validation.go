@@ -12,6 +12,7 @@ import (
 func (rc *RunsCollector) ValidateSpec() error {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateToken(rc.Spec.Token, rc.Spec.ConnectionRef, field.NewPath("spec"))...)
 	allErrs = append(allErrs, rc.validateSpecAgentPool()...)
 
 	if len(allErrs) == 0 {
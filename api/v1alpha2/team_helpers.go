@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+func (t *Team) IsCreationCandidate() bool {
+	return t.Status.ID == ""
+}
@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TeamOrganizationAccess defines organization-level permissions of the team.
+// Permissions that are not set are disabled.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/permissions#organization-permissions
+type TeamOrganizationAccess struct {
+	// Allows members to create, edit, and delete the organization's Sentinel and OPA policies.
+	//
+	//+optional
+	ManagePolicies bool `json:"managePolicies,omitempty"`
+	// Allows members to override soft-mandatory policy checks.
+	//
+	//+optional
+	ManagePolicyOverrides bool `json:"managePolicyOverrides,omitempty"`
+	// Allows members to delegate policy overrides to other teams.
+	//
+	//+optional
+	DelegatePolicyOverrides bool `json:"delegatePolicyOverrides,omitempty"`
+	// Allows members to create and administrate all workspaces within the organization.
+	//
+	//+optional
+	ManageWorkspaces bool `json:"manageWorkspaces,omitempty"`
+	// Allows members to manage the organization's VCS providers and SSH keys.
+	//
+	//+optional
+	ManageVCSSettings bool `json:"manageVCSSettings,omitempty"`
+	// Allows members to publish and delete providers in the organization's private registry.
+	//
+	//+optional
+	ManageProviders bool `json:"manageProviders,omitempty"`
+	// Allows members to publish and delete modules in the organization's private registry.
+	//
+	//+optional
+	ManageModules bool `json:"manageModules,omitempty"`
+	// Allows members to create, edit, and delete the organization's run tasks.
+	//
+	//+optional
+	ManageRunTasks bool `json:"manageRunTasks,omitempty"`
+	// Allows members to create and administrate all projects within the organization.
+	//
+	//+optional
+	ManageProjects bool `json:"manageProjects,omitempty"`
+	// Allows members to view all workspaces within the organization.
+	//
+	//+optional
+	ReadWorkspaces bool `json:"readWorkspaces,omitempty"`
+	// Allows members to view all projects within the organization.
+	//
+	//+optional
+	ReadProjects bool `json:"readProjects,omitempty"`
+	// Allows members to invite users to the organization and manage team membership.
+	//
+	//+optional
+	ManageMembership bool `json:"manageMembership,omitempty"`
+	// Allows members to create, update, and delete teams.
+	//
+	//+optional
+	ManageTeams bool `json:"manageTeams,omitempty"`
+	// Allows members to update the organization access settings of teams.
+	//
+	//+optional
+	ManageOrganizationAccess bool `json:"manageOrganizationAccess,omitempty"`
+	// Allows members to view secret teams.
+	//
+	//+optional
+	AccessSecretTeams bool `json:"accessSecretTeams,omitempty"`
+	// Allows members to create, edit, and delete agent pools.
+	//
+	//+optional
+	ManageAgentPools bool `json:"manageAgentPools,omitempty"`
+}
+
+// TeamMember is a user to add to the team.
+// Users are looked up among the organization members.
+// A user with a given email address who is not a member of the organization yet gets invited to it.
+// Only one of the fields `Username` or `Email` is allowed.
+// At least one of the fields `Username` or `Email` is mandatory.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/teams/manage#managing-team-membership
+type TeamMember struct {
+	// Username of the user.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Username string `json:"username,omitempty"`
+	// Email address of the user.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Email string `json:"email,omitempty"`
+}
+
+// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a team, either manually or by a system event.
+//
+// You must use one of the following values:
+// - `retain`: When the custom resource is deleted, the operator will not delete the associated team.
+// - `destroy`: Removes the team. Team members lose all permissions granted to the team.
+type TeamDeletionPolicy string
+
+const (
+	TeamDeletionPolicyRetain  TeamDeletionPolicy = "retain"
+	TeamDeletionPolicyDestroy TeamDeletionPolicy = "destroy"
+)
+
+// TeamSpec defines the desired state of Team.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/teams
+type TeamSpec struct {
+	// Organization name where the Team will be created.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
+	// Name of the Team.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Visibility of the Team.
+	// Secret teams are only visible to their members and to organization owners.
+	// Must be one of the following values: `secret`, `organization`.
+	// Default: `secret`.
+	//
+	//+kubebuilder:validation:Enum:=secret;organization
+	//+kubebuilder:default:=secret
+	//+optional
+	Visibility string `json:"visibility,omitempty"`
+	// SSO Team ID links the Team to a team in the SAML identity provider.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/single-sign-on#team-names-and-sso-team-ids
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	SSOTeamID string `json:"ssoTeamID,omitempty"`
+	// Organization-level permissions of the Team.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/permissions#organization-permissions
+	//
+	//+optional
+	OrganizationAccess *TeamOrganizationAccess `json:"organizationAccess,omitempty"`
+	// Members of the Team.
+	// The operator only removes users it added to the Team before,
+	// users added to the Team outside of this object stay untouched.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Members []TeamMember `json:"members,omitempty"`
+	// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a team, either manually or by a system event.
+	//
+	// You must use one of the following values:
+	// - `retain`: When the custom resource is deleted, the operator will not delete the associated team.
+	// - `destroy`: Removes the team. Team members lose all permissions granted to the team.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy TeamDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// TeamMemberStatus is a user the operator added to the team.
+type TeamMemberStatus struct {
+	// Organization membership ID of the user.
+	ID string `json:"id"`
+	// Username of the user.
+	// Empty until the user accepts the invitation to the organization.
+	//
+	//+optional
+	Username string `json:"username,omitempty"`
+	// Email address of the user.
+	//
+	//+optional
+	Email string `json:"email,omitempty"`
+	// Organization membership status of the user, either `active` or `invited`.
+	//
+	//+optional
+	Status string `json:"status,omitempty"`
+}
+
+// TeamStatus defines the observed state of Team.
+type TeamStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Team ID.
+	ID string `json:"id"`
+	// Team name.
+	Name string `json:"name"`
+	// Users the operator added to the Team.
+	//
+	//+optional
+	Members []TeamMemberStatus `json:"members,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Team Name",type=string,JSONPath=`.status.name`
+//+kubebuilder:printcolumn:name="Team ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
+
+// Team manages HCP Terraform Teams.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/teams
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TeamList contains a list of Team
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+}
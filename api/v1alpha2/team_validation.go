@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (t *Team) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateToken(t.Spec.Token, t.Spec.ConnectionRef, field.NewPath("spec"))...)
+	allErrs = append(allErrs, t.validateSpecMembers()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "Team"},
+		t.Name,
+		allErrs,
+	)
+}
+
+// validateSpecMembers validates that each member has exactly one of the fields Username or Email set
+// and that members are not duplicated.
+func (t *Team) validateSpecMembers() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	mu := make(map[string]int)
+	me := make(map[string]int)
+
+	for i, m := range t.Spec.Members {
+		f := field.NewPath("spec").Child("members").Child(fmt.Sprintf("[%d]", i))
+		if m.Username == "" && m.Email == "" {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				"one of the field Username or Email must be set"),
+			)
+		}
+
+		if m.Username != "" && m.Email != "" {
+			allErrs = append(allErrs, field.Invalid(
+				f,
+				"",
+				"only one of the field Username or Email is allowed"),
+			)
+		}
+
+		if m.Username != "" {
+			if _, ok := mu[m.Username]; ok {
+				allErrs = append(allErrs, field.Duplicate(f.Child("Username"), m.Username))
+			}
+			mu[m.Username] = i
+		}
+
+		if m.Email != "" {
+			if _, ok := me[m.Email]; ok {
+				allErrs = append(allErrs, field.Duplicate(f.Child("Email"), m.Email))
+			}
+			me[m.Email] = i
+		}
+	}
+
+	return allErrs
+}
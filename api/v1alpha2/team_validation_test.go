@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTeamSpecMembers(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Team{
+		"HasOnlyUsername": {
+			Spec: TeamSpec{
+				Members: []TeamMember{{Username: "this"}},
+			},
+		},
+		"HasOnlyEmail": {
+			Spec: TeamSpec{
+				Members: []TeamMember{{Email: "this@example.com"}},
+			},
+		},
+		"HasMultipleMembers": {
+			Spec: TeamSpec{
+				Members: []TeamMember{
+					{Username: "this"},
+					{Username: "that"},
+					{Email: "this@example.com"},
+					{Email: "that@example.com"},
+				},
+			},
+		},
+		"HasNoMembers": {
+			Spec: TeamSpec{},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecMembers()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Team{
+		"HasUsernameAndEmail": {
+			Spec: TeamSpec{
+				Members: []TeamMember{{Username: "this", Email: "this@example.com"}},
+			},
+		},
+		"HasEmptyMember": {
+			Spec: TeamSpec{
+				Members: []TeamMember{{}},
+			},
+		},
+		"HasDuplicateUsername": {
+			Spec: TeamSpec{
+				Members: []TeamMember{
+					{Username: "this"},
+					{Username: "this"},
+				},
+			},
+		},
+		"HasDuplicateEmail": {
+			Spec: TeamSpec{
+				Members: []TeamMember{
+					{Email: "this@example.com"},
+					{Email: "this@example.com"},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecMembers()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -4,6 +4,11 @@
 package v1alpha2
 
 import (
+	"fmt"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -35,6 +40,88 @@ func validateDeploymentAnnotations(annotations map[string]string, fldPath *field
 	return allErrs
 }
 
+// validateToken ensures that either the API token or the Connection reference is set.
+// The token can be omitted when the referred Connection provides it, this is verified at reconciliation time.
+func validateToken(token *Token, connectionRef *corev1.LocalObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if token == nil && connectionRef == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("token"), "one of the field token or connectionRef must be set"))
+	}
+
+	if token != nil && token.SecretKeyRef == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("token").Child("secretKeyRef"), "secretKeyRef must be set"))
+	}
+
+	if connectionRef != nil && connectionRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("connectionRef").Child("name"), "name must not be empty"))
+	}
+
+	return allErrs
+}
+
 // TODO:
 // - Add annotation validation for all controllers.
 //   For example, 'app.terraform.io/paused' should only be set to 'true' or 'false'.
+
+// outputTargetSecretKeys contains the data keys that Secrets of a given type require.
+var outputTargetSecretKeys = map[corev1.SecretType][]string{
+	corev1.SecretTypeDockerConfigJson: {corev1.DockerConfigJsonKey},
+	corev1.SecretTypeTLS:              {corev1.TLSCertKey, corev1.TLSPrivateKeyKey},
+}
+
+// validateOutputTargets validates output targets:
+//   - kind and name must be unique.
+//   - name must not be the given name of the ConfigMap and Secret that store all outputs by default.
+//   - type is only allowed for Secrets.
+//   - data keys must be valid ConfigMap and Secret keys.
+//   - data values must be valid Go templates.
+//   - data must contain the keys that the Secret type requires.
+func validateOutputTargets(targets []OutputTarget, defaultName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := make(map[string]int)
+
+	for i, t := range targets {
+		f := fldPath.Index(i)
+		name := fmt.Sprintf("%s/%s", t.Kind, t.Name)
+		if _, ok := names[name]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("name"), t.Name))
+		}
+		names[name] = i
+
+		if t.Name == defaultName {
+			allErrs = append(allErrs, field.Invalid(f.Child("name"), t.Name, fmt.Sprintf("%s is reserved for the ConfigMap and Secret that store all outputs", defaultName)))
+		}
+
+		if t.Kind != OutputTargetKindSecret && t.Type != "" {
+			allErrs = append(allErrs, field.Forbidden(f.Child("type"), "type is only allowed when kind is Secret"))
+		}
+
+		for k, v := range t.Data {
+			for _, msg := range validation.IsConfigMapKey(k) {
+				allErrs = append(allErrs, field.Invalid(f.Child("data"), k, msg))
+			}
+			if _, err := template.New(k).Funcs(outputTemplateFuncs).Parse(v); err != nil {
+				allErrs = append(allErrs, field.Invalid(f.Child("data").Key(k), v, err.Error()))
+			}
+		}
+
+		if t.Kind != OutputTargetKindSecret {
+			continue
+		}
+		for _, k := range outputTargetSecretKeys[t.Type] {
+			if _, ok := t.Data[k]; !ok {
+				allErrs = append(allErrs, field.Required(f.Child("data").Key(k), fmt.Sprintf("Secret of type %s requires the key %s", t.Type, k)))
+			}
+		}
+		if t.Type == corev1.SecretTypeBasicAuth {
+			_, username := t.Data[corev1.BasicAuthUsernameKey]
+			_, password := t.Data[corev1.BasicAuthPasswordKey]
+			if !username && !password {
+				allErrs = append(allErrs, field.Required(f.Child("data"), fmt.Sprintf("Secret of type %s requires the key %s or %s", t.Type, corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey)))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -91,3 +92,127 @@ func TestValidateDeploymentAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateToken(t *testing.T) {
+	t.Parallel()
+
+	token := &Token{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "this"},
+			Key:                  "token",
+		},
+	}
+	connectionRef := &corev1.LocalObjectReference{Name: "this"}
+
+	successCases := map[string]struct {
+		token         *Token
+		connectionRef *corev1.LocalObjectReference
+	}{
+		"HasOnlyToken": {
+			token: token,
+		},
+		"HasOnlyConnectionRef": {
+			connectionRef: connectionRef,
+		},
+		"HasTokenAndConnectionRef": {
+			token:         token,
+			connectionRef: connectionRef,
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateToken(c.token, c.connectionRef, field.NewPath("spec"))
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]struct {
+		token         *Token
+		connectionRef *corev1.LocalObjectReference
+	}{
+		"HasNeitherTokenNorConnectionRef": {},
+		"HasTokenWithoutSecretKeyRef": {
+			token: &Token{},
+		},
+		"HasConnectionRefWithoutName": {
+			connectionRef: &corev1.LocalObjectReference{},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateToken(c.token, c.connectionRef, field.NewPath("spec"))
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateOutputTargets(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string][]OutputTarget{
+		"HasNoTargets": nil,
+		"HasConfigMap": {
+			{Kind: OutputTargetKindConfigMap, Name: "this", Data: map[string]string{"DB_HOST": "{{ .db.host }}"}},
+		},
+		"HasSecretAndConfigMapWithSameName": {
+			{Kind: OutputTargetKindConfigMap, Name: "this", Data: map[string]string{"host": "{{ .host }}"}},
+			{Kind: OutputTargetKindSecret, Name: "this", Data: map[string]string{"password": "{{ .password }}"}},
+		},
+		"HasBasicAuthSecret": {
+			{Kind: OutputTargetKindSecret, Name: "this", Type: corev1.SecretTypeBasicAuth, Data: map[string]string{"password": "{{ .password }}"}},
+		},
+		"HasTLSSecret": {
+			{Kind: OutputTargetKindSecret, Name: "this", Type: corev1.SecretTypeTLS, Data: map[string]string{"tls.crt": "{{ .cert }}", "tls.key": "{{ .key }}"}},
+		},
+		"HasDockerConfigJSONSecret": {
+			{Kind: OutputTargetKindSecret, Name: "this", Type: corev1.SecretTypeDockerConfigJson, Data: map[string]string{".dockerconfigjson": "{{ toJson .auths }}"}},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateOutputTargets(c, "this-outputs", field.NewPath("spec").Child("outputTargets"))
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string][]OutputTarget{
+		"HasDuplicateName": {
+			{Kind: OutputTargetKindSecret, Name: "this", Data: map[string]string{"a": "{{ .a }}"}},
+			{Kind: OutputTargetKindSecret, Name: "this", Data: map[string]string{"b": "{{ .b }}"}},
+		},
+		"HasDefaultConfigMapName": {
+			{Kind: OutputTargetKindConfigMap, Name: "this-outputs", Data: map[string]string{"a": "{{ .a }}"}},
+		},
+		"HasDefaultSecretName": {
+			{Kind: OutputTargetKindSecret, Name: "this-outputs", Data: map[string]string{"a": "{{ .a }}"}},
+		},
+		"HasConfigMapWithType": {
+			{Kind: OutputTargetKindConfigMap, Name: "this", Type: corev1.SecretTypeOpaque, Data: map[string]string{"a": "{{ .a }}"}},
+		},
+		"HasInvalidKey": {
+			{Kind: OutputTargetKindSecret, Name: "this", Data: map[string]string{"db/password": "{{ .password }}"}},
+		},
+		"HasInvalidTemplate": {
+			{Kind: OutputTargetKindSecret, Name: "this", Data: map[string]string{"password": "{{ .password"}},
+		},
+		"HasUnknownFunction": {
+			{Kind: OutputTargetKindSecret, Name: "this", Data: map[string]string{"password": "{{ b64dec .password }}"}},
+		},
+		"HasTLSSecretWithoutKey": {
+			{Kind: OutputTargetKindSecret, Name: "this", Type: corev1.SecretTypeTLS, Data: map[string]string{"tls.crt": "{{ .cert }}"}},
+		},
+		"HasBasicAuthSecretWithoutCredentials": {
+			{Kind: OutputTargetKindSecret, Name: "this", Type: corev1.SecretTypeBasicAuth, Data: map[string]string{"user": "{{ .user }}"}},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := validateOutputTargets(c, "this-outputs", field.NewPath("spec").Child("outputTargets"))
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"github.com/hashicorp/hcp-terraform-operator/internal/slice"
+)
+
+func (v *VariableSet) IsCreationCandidate() bool {
+	return v.Status.ID == ""
+}
+
+// AddOrUpdateVariableStatus adds a given variable to the status if it does not exist there; otherwise, it updates it.
+func (s *VariableSetStatus) AddOrUpdateVariableStatus(variable VariableStatus) {
+	for i, v := range s.Variables {
+		if v.Name == variable.Name && v.Category == variable.Category {
+			s.Variables[i] = variable
+			return
+		}
+	}
+
+	s.Variables = append(s.Variables, variable)
+}
+
+// GetVariableStatus returns a given variable from the status if it exists there; otherwise, nil.
+func (s *VariableSetStatus) GetVariableStatus(variable VariableStatus) *VariableStatus {
+	for _, v := range s.Variables {
+		if v.Name == variable.Name && v.Category == variable.Category {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+// DeleteVariableStatus deletes a given variable from the status.
+func (s *VariableSetStatus) DeleteVariableStatus(variable VariableStatus) {
+	for i, v := range s.Variables {
+		if v.Name == variable.Name && v.Category == variable.Category {
+			s.Variables = slice.RemoveFromSlice(s.Variables, i)
+			return
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VariableSetWorkspace is a workspace to apply the variable set to.
+// Only one of the fields `ID` or `Name` is allowed.
+// At least one of the fields `ID` or `Name` is mandatory.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/variables/managing-variables#variable-sets
+type VariableSetWorkspace struct {
+	// Workspace ID.
+	// Must match pattern: `^ws-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^ws-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Workspace name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Name string `json:"name,omitempty"`
+}
+
+// VariableSetProject is a project to apply the variable set to.
+// The variable set applies to all current and future workspaces in the project.
+// Only one of the fields `ID` or `Name` is allowed.
+// At least one of the fields `ID` or `Name` is mandatory.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/variables/managing-variables#variable-sets
+type VariableSetProject struct {
+	// Project ID.
+	// Must match pattern: `^prj-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^prj-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Project name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Name string `json:"name,omitempty"`
+}
+
+// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a variable set, either manually or by a system event.
+//
+// You must use one of the following values:
+// - `retain`: When the custom resource is deleted, the operator will not delete the associated variable set.
+// - `destroy`: Removes the variable set and all its variables. Workspaces and projects that use the variable set lose access to its variables.
+type VariableSetDeletionPolicy string
+
+const (
+	VariableSetDeletionPolicyRetain  VariableSetDeletionPolicy = "retain"
+	VariableSetDeletionPolicyDestroy VariableSetDeletionPolicy = "destroy"
+)
+
+// VariableSetSpec defines the desired state of VariableSet.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/variables/managing-variables#variable-sets
+type VariableSetSpec struct {
+	// Organization name where the Variable Set will be created.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
+	// Name of the Variable Set.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Description of the Variable Set.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Description string `json:"description,omitempty"`
+	// Global variable sets apply to all current and future workspaces in the organization.
+	// Cannot be used together with `spec.workspaces` or `spec.projects`.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Global bool `json:"global,omitempty"`
+	// Priority variable sets override variables with the same key set at more specific scopes, including workspace variables and values set on the command line.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Priority bool `json:"priority,omitempty"`
+	// Terraform Variables of the Variable Set.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/variables#terraform-variables
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	TerraformVariables []Variable `json:"terraformVariables,omitempty"`
+	// Environment Variables of the Variable Set.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/variables#environment-variables
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	EnvironmentVariables []Variable `json:"environmentVariables,omitempty"`
+	// Workspaces to apply the Variable Set to.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Workspaces []VariableSetWorkspace `json:"workspaces,omitempty"`
+	// Projects to apply the Variable Set to.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Projects []VariableSetProject `json:"projects,omitempty"`
+	// DeletionPolicy defines the strategy the Kubernetes operator uses when you delete a variable set, either manually or by a system event.
+	//
+	// You must use one of the following values:
+	// - `retain`: When the custom resource is deleted, the operator will not delete the associated variable set.
+	// - `destroy`: Removes the variable set and all its variables. Workspaces and projects that use the variable set lose access to its variables.
+	// Default: `retain`.
+	//
+	//+kubebuilder:validation:Enum:=retain;destroy
+	//+kubebuilder:default=retain
+	//+optional
+	DeletionPolicy VariableSetDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// VariableSetStatus defines the observed state of VariableSet.
+type VariableSetStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Variable Set ID.
+	ID string `json:"id"`
+	// Variable Set name.
+	Name string `json:"name"`
+	// Variable Set variables.
+	//
+	//+optional
+	Variables []VariableStatus `json:"variables,omitempty"`
+	// IDs of workspaces the operator applied the Variable Set to.
+	//
+	//+optional
+	Workspaces []string `json:"workspaces,omitempty"`
+	// IDs of projects the operator applied the Variable Set to.
+	//
+	//+optional
+	Projects []string `json:"projects,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Variable Set Name",type=string,JSONPath=`.status.name`
+//+kubebuilder:printcolumn:name="Variable Set ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
+
+// VariableSet manages HCP Terraform Variable Sets.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/variables/managing-variables#variable-sets
+type VariableSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VariableSetSpec   `json:"spec"`
+	Status VariableSetStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// VariableSetList contains a list of VariableSet
+type VariableSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VariableSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VariableSet{}, &VariableSetList{})
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (v *VariableSet) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateToken(v.Spec.Token, v.Spec.ConnectionRef, field.NewPath("spec"))...)
+	allErrs = append(allErrs, v.validateSpecGlobal()...)
+	allErrs = append(allErrs, validateSpecVariables(field.NewPath("spec").Child("terraformVariables"), v.Spec.TerraformVariables)...)
+	allErrs = append(allErrs, validateSpecVariables(field.NewPath("spec").Child("environmentVariables"), v.Spec.EnvironmentVariables)...)
+	allErrs = append(allErrs, v.validateSpecWorkspaces()...)
+	allErrs = append(allErrs, v.validateSpecProjects()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "VariableSet"},
+		v.Name,
+		allErrs,
+	)
+}
+
+// validateSpecGlobal validates that a global variable set is not applied to specific workspaces or projects.
+func (v *VariableSet) validateSpecGlobal() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !v.Spec.Global {
+		return allErrs
+	}
+
+	f := field.NewPath("spec")
+	if len(v.Spec.Workspaces) > 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("workspaces"),
+			"",
+			"'spec.workspaces' cannot be used when 'spec.global' is set to 'true'"),
+		)
+	}
+	if len(v.Spec.Projects) > 0 {
+		allErrs = append(allErrs, field.Invalid(
+			f.Child("projects"),
+			"",
+			"'spec.projects' cannot be used when 'spec.global' is set to 'true'"),
+		)
+	}
+
+	return allErrs
+}
+
+func (v *VariableSet) validateSpecWorkspaces() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	wi := make(map[string]int)
+	wn := make(map[string]int)
+
+	for i, ws := range v.Spec.Workspaces {
+		f := field.NewPath("spec").Child("workspaces").Child(fmt.Sprintf("[%d]", i))
+		allErrs = append(allErrs, validateSpecIDOrName(f, ws.ID, ws.Name, wi, wn, i)...)
+	}
+
+	return allErrs
+}
+
+func (v *VariableSet) validateSpecProjects() field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	pi := make(map[string]int)
+	pn := make(map[string]int)
+
+	for i, p := range v.Spec.Projects {
+		f := field.NewPath("spec").Child("projects").Child(fmt.Sprintf("[%d]", i))
+		allErrs = append(allErrs, validateSpecIDOrName(f, p.ID, p.Name, pi, pn, i)...)
+	}
+
+	return allErrs
+}
+
+// validateSpecIDOrName validates that exactly one of a given ID or name is set and that it is not a duplicate
+// of a value already seen in the same list. Seen IDs and names are recorded in given maps.
+func validateSpecIDOrName(f *field.Path, id, name string, ids, names map[string]int, i int) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if id == "" && name == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or Name must be set"),
+		)
+	}
+
+	if id != "" && name != "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or Name is allowed"),
+		)
+	}
+
+	if id != "" {
+		if _, ok := ids[id]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("ID"), id))
+		}
+		ids[id] = i
+	}
+
+	if name != "" {
+		if _, ok := names[name]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("Name"), name))
+		}
+		names[name] = i
+	}
+
+	return allErrs
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVariableSetSpecGlobal(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]VariableSet{
+		"GlobalWithoutScope": {
+			Spec: VariableSetSpec{
+				Global: true,
+			},
+		},
+		"NotGlobalWithWorkspaces": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{Name: "this"}},
+			},
+		},
+		"NotGlobalWithProjects": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{Name: "this"}},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecGlobal()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]VariableSet{
+		"GlobalWithWorkspaces": {
+			Spec: VariableSetSpec{
+				Global:     true,
+				Workspaces: []VariableSetWorkspace{{Name: "this"}},
+			},
+		},
+		"GlobalWithProjects": {
+			Spec: VariableSetSpec{
+				Global:   true,
+				Projects: []VariableSetProject{{Name: "this"}},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecGlobal()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateVariableSetSpecWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]VariableSet{
+		"HasOnlyID": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{ID: "ws-this"}},
+			},
+		},
+		"HasOnlyName": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{Name: "this"}},
+			},
+		},
+		"HasMultipleWorkspaces": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{
+					{ID: "ws-this"},
+					{ID: "ws-that"},
+					{Name: "this"},
+					{Name: "that"},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecWorkspaces()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]VariableSet{
+		"HasIDAndName": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{ID: "ws-this", Name: "this"}},
+			},
+		},
+		"HasEmptyIDAndName": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{}},
+			},
+		},
+		"HasDuplicateID": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{ID: "ws-this"}, {ID: "ws-this"}},
+			},
+		},
+		"HasDuplicateName": {
+			Spec: VariableSetSpec{
+				Workspaces: []VariableSetWorkspace{{Name: "this"}, {Name: "this"}},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecWorkspaces()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateVariableSetSpecProjects(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]VariableSet{
+		"HasOnlyID": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{ID: "prj-this"}},
+			},
+		},
+		"HasOnlyName": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{Name: "this"}},
+			},
+		},
+		"HasMultipleProjects": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{
+					{ID: "prj-this"},
+					{ID: "prj-that"},
+					{Name: "this"},
+					{Name: "that"},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecProjects()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]VariableSet{
+		"HasIDAndName": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{ID: "prj-this", Name: "this"}},
+			},
+		},
+		"HasEmptyIDAndName": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{}},
+			},
+		},
+		"HasDuplicateID": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{ID: "prj-this"}, {ID: "prj-this"}},
+			},
+		},
+		"HasDuplicateName": {
+			Spec: VariableSetSpec{
+				Projects: []VariableSetProject{{Name: "this"}, {Name: "this"}},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecProjects()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+// Annotations of the Workspace that request runs and run actions.
+// They are shared by the controller and the admission webhooks.
+const (
+	WorkspaceAnnotationRunNew              = "workspace.app.terraform.io/run-new"
+	WorkspaceAnnotationRunType             = "workspace.app.terraform.io/run-type"
+	WorkspaceAnnotationRunTerraformVersion = "workspace.app.terraform.io/run-terraform-version"
+	WorkspaceAnnotationRunTargetAddrs      = "workspace.app.terraform.io/run-target-addrs"
+	WorkspaceAnnotationRunReplaceAddrs     = "workspace.app.terraform.io/run-replace-addrs"
+	WorkspaceAnnotationRunAllowEmptyApply  = "workspace.app.terraform.io/run-allow-empty-apply"
+	WorkspaceAnnotationRunVariables        = "workspace.app.terraform.io/run-variables"
+	// WorkspaceAnnotationRunAction is set by users to confirm, discard, or cancel the current run.
+	WorkspaceAnnotationRunAction      = "workspace.app.terraform.io/run-action"
+	WorkspaceAnnotationRunActionRunID = "workspace.app.terraform.io/run-action-run-id"
+	// WorkspaceAnnotationRunActionRequestedBy is set by the mutating webhook to the user who requested the run action.
+	WorkspaceAnnotationRunActionRequestedBy = "workspace.app.terraform.io/run-action-requested-by"
+
+	RunTypePlan    = "plan"
+	RunTypeApply   = "apply"
+	RunTypeRefresh = "refresh"
+	RunTypeDefault = RunTypePlan
+
+	RunActionConfirm = "confirm"
+	RunActionDiscard = "discard"
+	RunActionCancel  = "cancel"
+	// RunActionForceCancel is only available once HCP Terraform allows it after a run was canceled.
+	RunActionForceCancel = "force-cancel"
+)
+
+// RunAddrs returns the resource addresses of a given comma-separated list.
+func RunAddrs(v string) []string {
+	var addrs []string
+	for a := range strings.SplitSeq(v, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addrs = append(addrs, a)
+		}
+	}
+
+	return addrs
+}
+
+// RunVariables returns the run-specific variables of a given JSON object.
+// The object maps variable names to their values expressed as HCL literals, for example: `{"region": "\"eu-west-1\""}`.
+func RunVariables(v string) ([]*tfc.RunVariable, error) {
+	vars := map[string]string{}
+	if err := json.Unmarshal([]byte(v), &vars); err != nil {
+		return nil, fmt.Errorf("failed to parse run variables: %w", err)
+	}
+
+	var variables []*tfc.RunVariable
+	for _, k := range slices.Sorted(maps.Keys(vars)) {
+		variables = append(variables, &tfc.RunVariable{Key: k, Value: vars[k]})
+	}
+
+	return variables, nil
+}
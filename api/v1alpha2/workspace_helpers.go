@@ -4,6 +4,8 @@
 package v1alpha2
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/hashicorp/hcp-terraform-operator/internal/slice"
 )
 
@@ -52,3 +54,32 @@ func (s *WorkspaceStatus) DeleteVariableStatus(variable VariableStatus) {
 		}
 	}
 }
+
+// GetScheduleStatus returns a given schedule from the status; if it does not exist there, it is added first.
+func (s *WorkspaceStatus) GetScheduleStatus(name string) *WorkspaceScheduleStatus {
+	for i, v := range s.Schedules {
+		if v.Name == name {
+			return &s.Schedules[i]
+		}
+	}
+
+	s.Schedules = append(s.Schedules, WorkspaceScheduleStatus{Name: name})
+
+	return &s.Schedules[len(s.Schedules)-1]
+}
+
+// NextScheduleTime returns the earliest time when drift detection or a schedule triggers a Run; otherwise, nil.
+func (s *WorkspaceStatus) NextScheduleTime() *metav1.Time {
+	var next *metav1.Time
+	if s.DriftDetection != nil {
+		next = s.DriftDetection.NextScheduleTime
+	}
+
+	for _, v := range s.Schedules {
+		if v.NextScheduleTime != nil && (next == nil || v.NextScheduleTime.Before(next)) {
+			next = v.NextScheduleTime
+		}
+	}
+
+	return next
+}
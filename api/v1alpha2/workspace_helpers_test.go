@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWorkspaceScheduleIsSuspended(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.December, 20, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	s := WorkspaceSchedule{
+		SuspensionWindows: []ScheduleSuspensionWindow{
+			{
+				Start: metav1.NewTime(start),
+				End:   metav1.NewTime(end),
+			},
+		},
+	}
+
+	assert.False(t, s.IsSuspended(start.Add(-time.Second)))
+	assert.True(t, s.IsSuspended(start))
+	assert.True(t, s.IsSuspended(end.Add(-time.Second)))
+	assert.False(t, s.IsSuspended(end))
+
+	s.Suspend = true
+	assert.True(t, s.IsSuspended(end))
+}
+
+func TestWorkspaceStatusSchedules(t *testing.T) {
+	t.Parallel()
+
+	s := WorkspaceStatus{}
+	assert.Nil(t, s.NextScheduleTime())
+
+	now := time.Now()
+	s.GetScheduleStatus("nightly").NextScheduleTime = &metav1.Time{Time: now.Add(2 * time.Hour)}
+	s.GetScheduleStatus("weekly").NextScheduleTime = &metav1.Time{Time: now.Add(48 * time.Hour)}
+	assert.Len(t, s.Schedules, 2)
+	assert.Equal(t, "nightly", s.GetScheduleStatus("nightly").Name)
+	assert.Len(t, s.Schedules, 2)
+	assert.True(t, now.Add(2*time.Hour).Equal(s.NextScheduleTime().Time))
+
+	s.DriftDetection = &DriftDetectionStatus{
+		NextScheduleTime: &metav1.Time{Time: now.Add(time.Hour)},
+	}
+	assert.True(t, now.Add(time.Hour).Equal(s.NextScheduleTime().Time))
+}
@@ -126,7 +126,7 @@ type RunTrigger struct {
 // At least one of the fields `ID` or `Name` is mandatory.
 // More information:
 //   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/teams
-type Team struct {
+type TeamRef struct {
 	// Team ID.
 	// Must match pattern: `^team-[a-zA-Z0-9]+$`
 	//
@@ -200,7 +200,7 @@ type TeamAccess struct {
 	// Team to grant access.
 	// More information:
 	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/teams
-	Team Team `json:"team"`
+	Team TeamRef `json:"team"`
 	// There are two ways to choose which permissions a given team has on a workspace: fixed permission sets, and custom permissions.
 	// Must be one of the following values: `admin`, `custom`, `plan`, `read`, `write`.
 	// More information:
@@ -369,6 +369,220 @@ const (
 	DeletionPolicyForce   DeletionPolicy = "force"
 )
 
+// ManagementMode defines how the Operator manages the HCP Terraform resource of an object.
+//
+// You must use one of the following values:
+// - `enforce`: The Operator creates, updates, and deletes the resource to match the spec.
+// - `observe`: The Operator only reads the resource and reports the differences from the spec in the status and events. It does not make any changes in HCP Terraform.
+type ManagementMode string
+
+const (
+	ManagementModeEnforce ManagementMode = "enforce"
+	ManagementModeObserve ManagementMode = "observe"
+)
+
+// Result of the last observation of the HCP Terraform resource in the `observe` management mode.
+type ObservationStatus struct {
+	// Time when the resource was observed.
+	ObservedAt metav1.Time `json:"observedAt"`
+	// Paths of the spec fields that do not match the resource.
+	//
+	//+optional
+	Diff []string `json:"diff,omitempty"`
+}
+
+// Type of drift detection Runs.
+//
+// You must use one of the following values:
+// - `refresh`: Refresh-only Run that detects changes of the real infrastructure that are not reflected in the state.
+// - `plan`: Speculative plan that detects both changes of the real infrastructure and changes of the configuration that are not applied yet.
+type DriftDetectionRunType string
+
+const (
+	DriftDetectionRunTypeRefresh DriftDetectionRunType = "refresh"
+	DriftDetectionRunTypePlan    DriftDetectionRunType = "plan"
+)
+
+// Drift detection periodically triggers a Run to check whether the real infrastructure matches the Workspace state.
+type DriftDetection struct {
+	// Schedule of drift detection Runs in the cron format.
+	// For example, `0 */6 * * *` triggers a Run every 6 hours.
+	// The `CRON_TZ=` and `TZ=` prefixes and the `@every` descriptor are not supported.
+	// More information:
+	//   - https://en.wikipedia.org/wiki/Cron
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Schedule string `json:"schedule"`
+	// Time zone of the schedule.
+	// Must be a valid IANA Time Zone name, for example, `Europe/Amsterdam`.
+	// Default: `UTC`.
+	// More information:
+	//   - https://en.wikipedia.org/wiki/List_of_tz_database_time_zones
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Type of drift detection Runs.
+	//
+	// You must use one of the following values:
+	// - `refresh`: Refresh-only Run that detects changes of the real infrastructure that are not reflected in the state.
+	// - `plan`: Speculative plan that detects both changes of the real infrastructure and changes of the configuration that are not applied yet.
+	// Default: `refresh`.
+	//
+	//+kubebuilder:validation:Enum:=refresh;plan
+	//+kubebuilder:default:=refresh
+	//+optional
+	RunType DriftDetectionRunType `json:"runType,omitempty"`
+	// Whether to apply changes automatically once drift is detected.
+	// For runs of type `refresh`, the Operator applies the refresh-only Run that updates the state.
+	// For runs of type `plan`, the Operator triggers a new apply Run that updates the real infrastructure.
+	// When set to `false`, refresh-only Runs with changes are discarded.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	AutoApply bool `json:"autoApply,omitempty"`
+}
+
+// Type of scheduled Runs.
+//
+// You must use one of the following values:
+// - `plan`: Speculative plan that cannot be applied.
+// - `apply`: Plan and apply.
+// - `destroy`: Plan and apply that destroys all resources managed by the Workspace.
+type WorkspaceScheduleRunType string
+
+const (
+	WorkspaceScheduleRunTypePlan    WorkspaceScheduleRunType = "plan"
+	WorkspaceScheduleRunTypeApply   WorkspaceScheduleRunType = "apply"
+	WorkspaceScheduleRunTypeDestroy WorkspaceScheduleRunType = "destroy"
+)
+
+// Time window during which scheduled Runs are not triggered.
+type ScheduleSuspensionWindow struct {
+	// Start of the window.
+	// Must be in the RFC 3339 format, for example, `2025-12-20T00:00:00Z`.
+	Start metav1.Time `json:"start"`
+	// End of the window.
+	// Must be in the RFC 3339 format, for example, `2026-01-05T00:00:00Z`.
+	End metav1.Time `json:"end"`
+}
+
+// Schedule to trigger Runs periodically.
+type WorkspaceSchedule struct {
+	// Schedule name. Must be unique within the Workspace.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Schedule of Runs in the cron format.
+	// For example, `0 2 * * *` triggers a Run every day at 2am.
+	// The `CRON_TZ=` and `TZ=` prefixes and the `@every` descriptor are not supported.
+	// More information:
+	//   - https://en.wikipedia.org/wiki/Cron
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Schedule string `json:"schedule"`
+	// Time zone of the schedule.
+	// Must be a valid IANA Time Zone name, for example, `Europe/Amsterdam`.
+	// Default: `UTC`.
+	// More information:
+	//   - https://en.wikipedia.org/wiki/List_of_tz_database_time_zones
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Type of scheduled Runs.
+	//
+	// You must use one of the following values:
+	// - `plan`: Speculative plan that cannot be applied.
+	// - `apply`: Plan and apply.
+	// - `destroy`: Plan and apply that destroys all resources managed by the Workspace.
+	// Default: `plan`.
+	//
+	//+kubebuilder:validation:Enum:=plan;apply;destroy
+	//+kubebuilder:default:=plan
+	//+optional
+	RunType WorkspaceScheduleRunType `json:"runType,omitempty"`
+	// Whether to apply scheduled Runs automatically once the plan succeeds.
+	// When not set, the Workspace apply method is used.
+	// Does not apply to runs of type `plan`.
+	//
+	//+optional
+	AutoApply *bool `json:"autoApply,omitempty"`
+	// Whether to suspend the schedule.
+	// Suspended schedules do not trigger Runs.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Suspend bool `json:"suspend,omitempty"`
+	// Time windows during which the schedule does not trigger Runs, for example, a change freeze.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	SuspensionWindows []ScheduleSuspensionWindow `json:"suspensionWindows,omitempty"`
+}
+
+// Adoption of an existing Workspace.
+type WorkspaceAdoption struct {
+	// ID of the Workspace to adopt.
+	// When not set, the Workspace with the name `spec.name` is adopted.
+	// When set, the Workspace is renamed to `spec.name` once it is adopted.
+	// Must match pattern: `^ws-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^ws-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Whether to record the settings of the Workspace in `status.adoption.snapshot` before the Operator takes over its management.
+	// Default: `false`.
+	//
+	//+kubebuilder:default:=false
+	//+optional
+	Snapshot bool `json:"snapshot,omitempty"`
+}
+
+// OutputTargetKind is the kind of the Kubernetes object that outputs are rendered into.
+// Must be one of the following values: `ConfigMap`, `Secret`.
+type OutputTargetKind string
+
+const (
+	OutputTargetKindConfigMap OutputTargetKind = "ConfigMap"
+	OutputTargetKindSecret    OutputTargetKind = "Secret"
+)
+
+// OutputTarget renders output values into a ConfigMap or Secret, for example, to feed a database endpoint and password into application manifests.
+type OutputTarget struct {
+	// Kind of the object.
+	// A `ConfigMap` can only refer to non-sensitive outputs.
+	// Must be one of the following values: `ConfigMap`, `Secret`.
+	//
+	//+kubebuilder:validation:Enum:=ConfigMap;Secret
+	Kind OutputTargetKind `json:"kind"`
+	// Name of the object.
+	// The object must not exist or must be owned by this object.
+	// The names `<metadata.name>-outputs` of a Workspace and `<metadata.name>-module-outputs` of a Module are reserved.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Type of the Secret.
+	// It is only allowed when `kind` is `Secret`.
+	// Must be one of the following values: `Opaque`, `kubernetes.io/basic-auth`, `kubernetes.io/dockerconfigjson`, `kubernetes.io/tls`.
+	// Default: `Opaque`.
+	// The type of a Secret is immutable, thus the Operator creates the Secret again when the type changes.
+	//
+	//+kubebuilder:validation:Enum:=Opaque;kubernetes.io/basic-auth;kubernetes.io/dockerconfigjson;kubernetes.io/tls
+	//+optional
+	Type corev1.SecretType `json:"type,omitempty"`
+	// Data of the object.
+	// Each value is a Go template rendered over the output values, for example, `{{ .db_password }}` to store the output `db_password` under a different key.
+	// Structured outputs can be accessed by their fields, for example, `{{ .db.host }}:{{ .db.port }}`.
+	// Additional functions: `toJson` encodes a value as JSON, `b64enc` encodes a string with Base64.
+	// Referring to an output that does not exist fails the rendering.
+	//
+	//+kubebuilder:validation:MinProperties:=1
+	Data map[string]string `json:"data"`
+}
+
 // NotificationTrigger represents the different TFC notifications that can be sent as a run's progress transitions between different states.
 // This must be aligned with go-tfe type `NotificationTriggerType`.
 // Must be one of the following values: `run:applying`, `assessment:check_failure`, `run:completed`, `run:created`, `assessment:drifted`, `run:errored`, `assessment:failed`, `run:needs_attention`, `run:planning`.
@@ -478,7 +692,16 @@ type WorkspaceSpec struct {
 	//+kubebuilder:validation:MinLength:=1
 	Organization string `json:"organization"`
 	// API Token to be used for API calls.
-	Token Token `json:"token"`
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
 	// Define either change will be applied automatically(auto) or require an operator to confirm(manual).
 	// Must be one of the following values: `auto`, `manual`.
 	// Default: `manual`.
@@ -646,6 +869,97 @@ type WorkspaceSpec struct {
 	//+kubebuilder:validation:MinItems:=1
 	//+optional
 	VariableSets []WorkspaceVariableSet `json:"variableSets,omitempty"`
+	// Drift detection periodically triggers a Run to check whether the real infrastructure matches the Workspace state.
+	//
+	//+optional
+	DriftDetection *DriftDetection `json:"driftDetection,omitempty"`
+	// Schedules to trigger Runs periodically, for example, a nightly apply or a weekend destroy of a development environment.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Schedules []WorkspaceSchedule `json:"schedules,omitempty"`
+	// Number of seconds after the creation of a Run, after which the Operator stops the Run if it is still pending or planning.
+	// Pending Runs are discarded and planning Runs are canceled.
+	// Runs that are still not stopped after twice the timeout are force-canceled once HCP Terraform allows it.
+	// When not set, Runs are not stopped.
+	//
+	//+kubebuilder:validation:Minimum:=60
+	//+optional
+	PlanTimeoutSeconds *int32 `json:"planTimeoutSeconds,omitempty"`
+	// Whether to lock the Workspace. Locked Workspaces do not start new Runs.
+	// The Operator only unlocks the Workspace if it locked it, locks held by users, teams, or Runs are kept.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/workspaces/settings#locking
+	//
+	//+optional
+	Locked bool `json:"locked,omitempty"`
+	// Reason for locking the Workspace.
+	// Only applies when `spec.locked` is `true`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	LockReason string `json:"lockReason,omitempty"`
+	// Adopt an existing Workspace instead of creating a new one.
+	// Once adopted, the Operator manages the Workspace according to the spec, the same way as Workspaces it created.
+	// A Workspace cannot be adopted when another Workspace object in the cluster already manages it.
+	//
+	//+optional
+	Adopt *WorkspaceAdoption `json:"adopt,omitempty"`
+	// Management mode of the object.
+	// - `enforce`: The Operator creates, updates, and deletes the Workspace to match the spec.
+	// - `observe`: The Operator only reads the Workspace and reports the differences from the spec in `status.observation` and events.
+	//   It does not make any changes in HCP Terraform, runs are not triggered.
+	// Default: `enforce`.
+	//
+	//+kubebuilder:validation:Enum:=enforce;observe
+	//+kubebuilder:default:=enforce
+	//+optional
+	ManagementMode ManagementMode `json:"managementMode,omitempty"`
+	// List of spec fields whose changes in HCP Terraform are ignored, similar to the Terraform `ignore_changes` lifecycle argument.
+	// The Operator applies these fields to a Workspace it creates and does not update them afterwards.
+	// Fields of an adopted Workspace are ignored from the start.
+	// Supported values:
+	// `allowDestroyPlan`, `agentPool`, `applyMethod`, `applyRunTrigger`, `description`, `environmentVariables`, `executionMode`,
+	// `notifications`, `project`, `remoteStateSharing`, `runTasks`, `runTriggers`, `sshKey`, `tags`, `teamAccess`,
+	// `terraformVariables`, `terraformVersion`, `variableSets`, `versionControl`, and `workingDirectory`.
+	// A single variable is referred to as `environmentVariables[NAME]` or `terraformVariables[NAME]`.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	IgnoreChanges []string `json:"ignoreChanges,omitempty"`
+	// ConfigMaps and Secrets to render the Workspace outputs into once a run is applied.
+	// They are created in addition to the `<metadata.name>-outputs` ConfigMap and Secret.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	OutputTargets []OutputTarget `json:"outputTargets,omitempty"`
+}
+
+// Summary of the plan phase of an HCP Terraform run.
+type PlanSummary struct {
+	// Plan ID.
+	PlanID string `json:"planID"`
+	// Plan status.
+	Status string `json:"status"`
+	// Number of resources to add.
+	ResourceAdditions int `json:"resourceAdditions"`
+	// Number of resources to change.
+	ResourceChanges int `json:"resourceChanges"`
+	// Number of resources to destroy.
+	ResourceDestructions int `json:"resourceDestructions"`
+	// Number of resources to import.
+	ResourceImports int `json:"resourceImports"`
+	// Names of the outputs that the plan changes.
+	// Only set when the JSON execution plan is readable, which requires admin access to the Workspace.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/api-docs/plans#retrieve-the-json-execution-plan
+	//
+	//+optional
+	ChangedOutputs []string `json:"changedOutputs,omitempty"`
+	// Link to the run in the HCP Terraform UI.
+	//
+	//+optional
+	RunURL string `json:"runURL,omitempty"`
 }
 
 type PlanStatus struct {
@@ -662,6 +976,10 @@ type PlanStatus struct {
 	//+kubebuilder:validation:Pattern:="^\\d{1}\\.\\d{1,2}\\.\\d{1,2}$"
 	//+optional
 	TerraformVersion string `json:"terraformVersion,omitempty"`
+	// Plan summary of the latest plan-only/speculative plan HCP Terraform run.
+	//
+	//+optional
+	Summary *PlanSummary `json:"summary,omitempty"`
 }
 
 type RunStatus struct {
@@ -681,6 +999,10 @@ type RunStatus struct {
 	//
 	//+optional
 	OutputRunID string `json:"outputRunID,omitempty"`
+	// Plan summary of the current HCP Terraform run.
+	//
+	//+optional
+	Summary *PlanSummary `json:"summary,omitempty"`
 }
 
 type VariableStatus struct {
@@ -741,10 +1063,182 @@ type WorkspaceStatus struct {
 	// Variable Sets.
 	//
 	//+optional
-	VariableSets []VariableSetStatus `json:"variableSet,omitempty"`
+	VariableSets []WorkspaceVariableSetStatus `json:"variableSet,omitempty"`
+	// Drift detection status.
+	//
+	//+optional
+	DriftDetection *DriftDetectionStatus `json:"driftDetection,omitempty"`
+	// The latest action taken on a Run via the annotation `workspace.app.terraform.io/run-action`.
+	//
+	//+optional
+	RunAction *RunActionStatus `json:"runAction,omitempty"`
+	// Lock of the Workspace held by the Operator.
+	//
+	//+optional
+	Lock *WorkspaceLockStatus `json:"lock,omitempty"`
+	// Adoption of an existing Workspace.
+	//
+	//+optional
+	Adoption *WorkspaceAdoptionStatus `json:"adoption,omitempty"`
+	// Drift of the Workspace settings from the spec.
+	// The Operator corrects the drift once it is detected and clears it once the settings match the spec.
+	//
+	//+optional
+	SettingsDrift *WorkspaceSettingsDriftStatus `json:"settingsDrift,omitempty"`
+	// Schedules status.
+	//
+	//+listType=map
+	//+listMapKey=name
+	//+optional
+	Schedules []WorkspaceScheduleStatus `json:"schedules,omitempty"`
+	// Result of the last observation of the Workspace in the `observe` management mode.
+	//
+	//+optional
+	Observation *ObservationStatus `json:"observation,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type WorkspaceAdoptionStatus struct {
+	// Time when the Workspace was adopted.
+	AdoptedAt metav1.Time `json:"adoptedAt"`
+	// Settings of the Workspace at the time of the adoption.
+	//
+	//+optional
+	Snapshot *WorkspaceSettingsSnapshot `json:"snapshot,omitempty"`
+}
+
+type WorkspaceSettingsSnapshot struct {
+	// Workspace name.
+	Name string `json:"name"`
+	// Workspace description.
+	//
+	//+optional
+	Description string `json:"description,omitempty"`
+	// Project ID.
+	//
+	//+optional
+	ProjectID string `json:"projectID,omitempty"`
+	// Execution mode.
+	//
+	//+optional
+	ExecutionMode string `json:"executionMode,omitempty"`
+	// Agent pool ID.
+	//
+	//+optional
+	AgentPoolID string `json:"agentPoolID,omitempty"`
+	// Whether Runs are applied automatically.
+	AutoApply bool `json:"autoApply"`
+	// Whether destroy plans are allowed.
+	AllowDestroyPlan bool `json:"allowDestroyPlan"`
+	// Terraform version.
+	//
+	//+optional
+	TerraformVersion string `json:"terraformVersion,omitempty"`
+	// Working directory.
+	//
+	//+optional
+	WorkingDirectory string `json:"workingDirectory,omitempty"`
+	// VCS repository identifier.
+	//
+	//+optional
+	VCSRepository string `json:"vcsRepository,omitempty"`
+	// VCS branch.
+	//
+	//+optional
+	VCSBranch string `json:"vcsBranch,omitempty"`
+	// Workspace tags.
+	//
+	//+optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+type WorkspaceSettingsDriftStatus struct {
+	// Paths of the spec fields whose settings were changed outside of the Operator.
+	Fields []string `json:"fields"`
+	// Time when the drift was detected.
+	DetectedAt metav1.Time `json:"detectedAt"`
+}
+
+type WorkspaceLockStatus struct {
+	// Reason for locking the Workspace.
+	//
+	//+optional
+	Reason string `json:"reason,omitempty"`
+	// Time when the Operator locked the Workspace.
+	LockedAt metav1.Time `json:"lockedAt"`
+}
+
+type RunActionStatus struct {
+	// Action taken on the Run: `confirm`, `discard`, or `cancel`.
+	Action string `json:"action"`
+	// HCP Terraform run ID.
+	RunID string `json:"runID"`
+	// Kubernetes user who requested the action.
+	//
+	//+optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+	// Time when the action was taken.
+	Time metav1.Time `json:"time"`
+}
+
+type DriftDetectionStatus struct {
+	// Latest drift detection HCP Terraform run ID.
+	//
+	//+optional
+	RunID string `json:"runID,omitempty"`
+	// Latest drift detection HCP Terraform run status.
+	//
+	//+optional
+	RunStatus string `json:"runStatus,omitempty"`
+	// Whether the latest completed drift detection Run found drift.
+	Drifted bool `json:"drifted"`
+	// Run ID that applies the detected drift.
+	//
+	//+optional
+	ApplyRunID string `json:"applyRunID,omitempty"`
+	// Time when the latest drift detection Run was triggered.
+	//
+	//+optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// Time when the latest drift detection Run was completed.
+	//
+	//+optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// Time when the next drift detection Run is triggered.
+	//
+	//+optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+type WorkspaceScheduleStatus struct {
+	// Schedule name.
+	Name string `json:"name"`
+	// Latest HCP Terraform run ID triggered by the schedule.
+	//
+	//+optional
+	RunID string `json:"runID,omitempty"`
+	// Time when the schedule last triggered a Run.
+	//
+	//+optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// Time when the schedule was last skipped because it was suspended.
+	//
+	//+optional
+	LastSuspendedTime *metav1.Time `json:"lastSuspendedTime,omitempty"`
+	// Time when the schedule triggers the next Run.
+	//
+	//+optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
 }
 
-type VariableSetStatus struct {
+type WorkspaceVariableSetStatus struct {
 	ID   string `json:"id,omitempty"`
 	Name string `json:"name,omitempty"`
 }
@@ -752,7 +1246,8 @@ type VariableSetStatus struct {
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Workspace ID",type=string,JSONPath=`.status.workspaceID`
-//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v25.4.0"
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
 
 // Workspace manages HCP Terraform Workspaces.
 // More information:
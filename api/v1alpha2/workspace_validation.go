@@ -5,6 +5,9 @@ package v1alpha2
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"time"
 
 	tfc "github.com/hashicorp/go-tfe"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -15,6 +18,7 @@ import (
 func (w *Workspace) ValidateSpec() error {
 	var allErrs field.ErrorList
 
+	allErrs = append(allErrs, validateToken(w.Spec.Token, w.Spec.ConnectionRef, field.NewPath("spec"))...)
 	allErrs = append(allErrs, w.validateSpecAgentPool()...)
 	allErrs = append(allErrs, w.validateSpecExecutionMode()...)
 	allErrs = append(allErrs, w.validateSpecNotifications()...)
@@ -28,6 +32,10 @@ func (w *Workspace) ValidateSpec() error {
 	allErrs = append(allErrs, w.validateSpecDeletionPolicy()...)
 	allErrs = append(allErrs, w.validateSpecVariableSets()...)
 	allErrs = append(allErrs, w.validateSpecVersionControl()...)
+	allErrs = append(allErrs, w.validateSpecDriftDetection()...)
+	allErrs = append(allErrs, w.validateSpecSchedules()...)
+	allErrs = append(allErrs, w.validateSpecIgnoreChanges()...)
+	allErrs = append(allErrs, validateOutputTargets(w.Spec.OutputTargets, w.Name+"-outputs", field.NewPath("spec").Child("outputTargets"))...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -656,6 +664,133 @@ func (w *Workspace) validateSpecVersionControlFileTriggers() field.ErrorList {
 	return allErrs
 }
 
+// validateSpecDriftDetection validates `spec.driftDetection`:
+//   - schedule must be a valid cron schedule.
+//   - timeZone must be a valid IANA Time Zone name.
+func (w *Workspace) validateSpecDriftDetection() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := w.Spec.DriftDetection
+
+	if spec == nil {
+		return allErrs
+	}
+
+	f := field.NewPath("spec").Child("driftDetection")
+	if _, err := parseSchedule(spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(f.Child("schedule"), spec.Schedule, err.Error()))
+	}
+	if spec.TimeZone != "" {
+		if _, err := time.LoadLocation(spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(f.Child("timeZone"), spec.TimeZone, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSpecSchedules validates `spec.schedules`:
+//   - name must be unique.
+//   - schedule must be a valid cron schedule.
+//   - timeZone must be a valid IANA Time Zone name.
+//   - autoApply cannot be set for runs of type `plan`.
+//   - runs of type `destroy` require `spec.allowDestroyPlan` to be set to `true`.
+//   - suspension window end must be after its start.
+func (w *Workspace) validateSpecSchedules() field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := make(map[string]int)
+
+	for i, s := range w.Spec.Schedules {
+		f := field.NewPath("spec").Child("schedules").Index(i)
+		if _, ok := names[s.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(f.Child("name"), s.Name))
+		}
+		names[s.Name] = i
+
+		if _, err := parseSchedule(s.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(f.Child("schedule"), s.Schedule, err.Error()))
+		}
+		if s.TimeZone != "" {
+			if _, err := time.LoadLocation(s.TimeZone); err != nil {
+				allErrs = append(allErrs, field.Invalid(f.Child("timeZone"), s.TimeZone, err.Error()))
+			}
+		}
+
+		if s.RunType == WorkspaceScheduleRunTypePlan && s.AutoApply != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				f.Child("autoApply"),
+				"autoApply cannot be set for runs of type plan"),
+			)
+		}
+		if s.RunType == WorkspaceScheduleRunTypeDestroy && !w.Spec.AllowDestroyPlan {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("spec").Child("allowDestroyPlan"),
+				"'spec.allowDestroyPlan' must be set to 'true' when a schedule runType is set to 'destroy'"),
+			)
+		}
+
+		for j, sw := range s.SuspensionWindows {
+			if !sw.End.After(sw.Start.Time) {
+				allErrs = append(allErrs, field.Invalid(
+					f.Child("suspensionWindows").Index(j).Child("end"),
+					sw.End.Format(time.RFC3339),
+					"end must be after start"),
+				)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// workspaceIgnoreChangesFields contains the spec fields that can be listed in `spec.ignoreChanges`.
+var workspaceIgnoreChangesFields = []string{
+	"allowDestroyPlan",
+	"agentPool",
+	"applyMethod",
+	"applyRunTrigger",
+	"description",
+	"environmentVariables",
+	"executionMode",
+	"notifications",
+	"project",
+	"remoteStateSharing",
+	"runTasks",
+	"runTriggers",
+	"sshKey",
+	"tags",
+	"teamAccess",
+	"terraformVariables",
+	"terraformVersion",
+	"variableSets",
+	"versionControl",
+	"workingDirectory",
+}
+
+// workspaceIgnoreChangesVariable matches a single variable in `spec.ignoreChanges`, e.g. `environmentVariables[NAME]`.
+var workspaceIgnoreChangesVariable = regexp.MustCompile(`^(environmentVariables|terraformVariables)\[[^\[\]]+\]$`)
+
+// validateSpecIgnoreChanges validates `spec.ignoreChanges`:
+//   - field must be unique.
+//   - field must be one of the supported spec fields or a single variable.
+func (w *Workspace) validateSpecIgnoreChanges() field.ErrorList {
+	allErrs := field.ErrorList{}
+	fields := make(map[string]int)
+
+	for i, p := range w.Spec.IgnoreChanges {
+		f := field.NewPath("spec").Child("ignoreChanges").Index(i)
+		if _, ok := fields[p]; ok {
+			allErrs = append(allErrs, field.Duplicate(f, p))
+		}
+		fields[p] = i
+
+		if !slices.Contains(workspaceIgnoreChangesFields, p) && !workspaceIgnoreChangesVariable.MatchString(p) {
+			allErrs = append(allErrs, field.NotSupported(f, p, workspaceIgnoreChangesFields))
+		}
+	}
+
+	return allErrs
+}
+
 // TODO:Validation
 //
 // + Tags duplicate: spec.tags[]
@@ -5,10 +5,12 @@ package v1alpha2
 
 import (
 	"testing"
+	"time"
 
 	tfc "github.com/hashicorp/go-tfe"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -1230,3 +1232,330 @@ func TestValidateSpecVersionControl(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSpecDriftDetection(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Workspace{
+		"HasNoDriftDetection": {
+			Spec: WorkspaceSpec{},
+		},
+		"HasSchedule": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "0 */6 * * *",
+				},
+			},
+		},
+		"HasScheduleDescriptor": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "@daily",
+				},
+			},
+		},
+		"HasScheduleAndTimeZone": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "30 8 * * 1-5",
+					TimeZone: "Europe/Amsterdam",
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecDriftDetection()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Workspace{
+		"HasInvalidSchedule": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "every day",
+				},
+			},
+		},
+		"HasScheduleWithSeconds": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "0 0 */6 * * *",
+				},
+			},
+		},
+		"HasScheduleWithCronTimeZone": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "CRON_TZ=Europe/Amsterdam 0 */6 * * *",
+				},
+			},
+		},
+		"HasScheduleWithTimeZone": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "TZ=Europe/Amsterdam 0 */6 * * *",
+				},
+			},
+		},
+		"HasScheduleEvery": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "@every 6h",
+				},
+			},
+		},
+		"HasInvalidTimeZone": {
+			Spec: WorkspaceSpec{
+				DriftDetection: &DriftDetection{
+					Schedule: "0 */6 * * *",
+					TimeZone: "Mars/Olympus_Mons",
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecDriftDetection()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateSpecSchedules(t *testing.T) {
+	t.Parallel()
+
+	autoApply := true
+	start := metav1.NewTime(time.Date(2025, time.December, 20, 0, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC))
+
+	successCases := map[string]Workspace{
+		"HasNoSchedules": {
+			Spec: WorkspaceSpec{},
+		},
+		"HasPlanSchedule": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "nightly",
+						Schedule: "0 2 * * *",
+						TimeZone: "Europe/Amsterdam",
+						RunType:  WorkspaceScheduleRunTypePlan,
+					},
+				},
+			},
+		},
+		"HasApplyAndDestroySchedules": {
+			Spec: WorkspaceSpec{
+				AllowDestroyPlan: true,
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:      "apply",
+						Schedule:  "0 7 * * 1",
+						RunType:   WorkspaceScheduleRunTypeApply,
+						AutoApply: &autoApply,
+					},
+					{
+						Name:      "destroy",
+						Schedule:  "0 19 * * 5",
+						RunType:   WorkspaceScheduleRunTypeDestroy,
+						AutoApply: &autoApply,
+						SuspensionWindows: []ScheduleSuspensionWindow{
+							{
+								Start: start,
+								End:   end,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecSchedules()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Workspace{
+		"HasDuplicateName": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "0 2 * * *",
+					},
+					{
+						Name:     "this",
+						Schedule: "0 3 * * *",
+					},
+				},
+			},
+		},
+		"HasInvalidSchedule": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "every night",
+					},
+				},
+			},
+		},
+		"HasScheduleWithCronTimeZone": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "CRON_TZ=Europe/Amsterdam 0 2 * * *",
+					},
+				},
+			},
+		},
+		"HasScheduleWithTimeZone": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "TZ=Europe/Amsterdam 0 2 * * *",
+					},
+				},
+			},
+		},
+		"HasScheduleEvery": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "@every 24h",
+					},
+				},
+			},
+		},
+		"HasInvalidTimeZone": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "0 2 * * *",
+						TimeZone: "Mars/Olympus_Mons",
+					},
+				},
+			},
+		},
+		"HasPlanWithAutoApply": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:      "this",
+						Schedule:  "0 2 * * *",
+						RunType:   WorkspaceScheduleRunTypePlan,
+						AutoApply: &autoApply,
+					},
+				},
+			},
+		},
+		"HasDestroyWithoutAllowDestroyPlan": {
+			Spec: WorkspaceSpec{
+				AllowDestroyPlan: false,
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "0 19 * * 5",
+						RunType:  WorkspaceScheduleRunTypeDestroy,
+					},
+				},
+			},
+		},
+		"HasSuspensionWindowEndBeforeStart": {
+			Spec: WorkspaceSpec{
+				Schedules: []WorkspaceSchedule{
+					{
+						Name:     "this",
+						Schedule: "0 2 * * *",
+						SuspensionWindows: []ScheduleSuspensionWindow{
+							{
+								Start: end,
+								End:   start,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecSchedules()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateSpecIgnoreChanges(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]Workspace{
+		"HasNoIgnoreChanges": {
+			Spec: WorkspaceSpec{},
+		},
+		"HasFields": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"tags", "teamAccess", "terraformVersion"},
+			},
+		},
+		"HasVariables": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"environmentVariables[AWS_REGION]", "terraformVariables[instance_type]"},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecIgnoreChanges()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]Workspace{
+		"HasDuplicateField": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"tags", "tags"},
+			},
+		},
+		"HasUnsupportedField": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"name"},
+			},
+		},
+		"HasNestedField": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"versionControl.branch"},
+			},
+		},
+		"HasVariableWithoutName": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"environmentVariables[]"},
+			},
+		},
+		"HasUnsupportedIndexedField": {
+			Spec: WorkspaceSpec{
+				IgnoreChanges: []string{"tags[team-a]"},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecIgnoreChanges()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
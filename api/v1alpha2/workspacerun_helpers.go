@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+func (r *WorkspaceRun) IsCreationCandidate() bool {
+	return r.Status.ID == ""
+}
+
+// RunCompleted reports whether the Run reached a final status.
+func (r *WorkspaceRun) RunCompleted() bool {
+	return runCompleted(r.Status.Status)
+}
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Type of the Run.
+//
+// You must use one of the following values:
+// - `plan`: Speculative plan that cannot be applied.
+// - `apply`: Plan and apply.
+// - `refresh`: Refresh-only plan and apply that updates the state to match the real world infrastructure.
+// - `destroy`: Plan and apply that destroys all resources managed by the Workspace.
+type WorkspaceRunType string
+
+const (
+	WorkspaceRunTypePlan    WorkspaceRunType = "plan"
+	WorkspaceRunTypeApply   WorkspaceRunType = "apply"
+	WorkspaceRunTypeRefresh WorkspaceRunType = "refresh"
+	WorkspaceRunTypeDestroy WorkspaceRunType = "destroy"
+)
+
+// Workspace to execute the Run.
+// Only one of the fields `ID` or `Name` is allowed.
+// At least one of the fields `ID` or `Name` is mandatory.
+type WorkspaceRunWorkspace struct {
+	// Workspace ID.
+	// Must match pattern: `^ws-[a-zA-Z0-9]+$`
+	//
+	//+kubebuilder:validation:Pattern:="^ws-[a-zA-Z0-9]+$"
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Workspace Name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Name string `json:"name,omitempty"`
+}
+
+// Run-specific variable value.
+// Run variables take precedence over the Workspace variables with the same name, but only for this Run.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/run/api#run-specific-variables
+type WorkspaceRunVariable struct {
+	// Variable name.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Name string `json:"name"`
+	// Variable value expressed as an HCL literal.
+	// Strings must be quoted, for example: `"\"eu-west-1\""`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Value string `json:"value"`
+}
+
+// WorkspaceRunSpec defines the desired state of WorkspaceRun.
+// The spec cannot be changed once the Run is created.
+type WorkspaceRunSpec struct {
+	// Organization name where the Workspace is located.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/users-teams-organizations/organizations
+	//
+	//+kubebuilder:validation:MinLength:=1
+	Organization string `json:"organization"`
+	// API Token to be used for API calls.
+	// Must be set unless `spec.connectionRef` refers to a Connection with a token.
+	//
+	//+optional
+	Token *Token `json:"token,omitempty"`
+	// Connection to use for API calls.
+	// The Connection must be in the same namespace as this object.
+	// When set, the API address, TLS, and proxy settings come from the Connection.
+	//
+	//+optional
+	ConnectionRef *corev1.LocalObjectReference `json:"connectionRef,omitempty"`
+	// Workspace to execute the Run.
+	Workspace *WorkspaceRunWorkspace `json:"workspace"`
+	// Type of the Run.
+	//
+	// You must use one of the following values:
+	// - `plan`: Speculative plan that cannot be applied.
+	// - `apply`: Plan and apply.
+	// - `refresh`: Refresh-only plan and apply that updates the state to match the real world infrastructure.
+	// - `destroy`: Plan and apply that destroys all resources managed by the Workspace.
+	// Default: `apply`.
+	//
+	//+kubebuilder:validation:Enum:=plan;apply;refresh;destroy
+	//+kubebuilder:default:=apply
+	//+optional
+	Type WorkspaceRunType `json:"type,omitempty"`
+	// Resource addresses to target.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/run/modes-and-options#resource-targeting
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Targets []string `json:"targets,omitempty"`
+	// Resource addresses to replace.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/run/modes-and-options#replacing-selected-resources
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	ReplaceAddrs []string `json:"replaceAddrs,omitempty"`
+	// Run-specific variable values.
+	//
+	//+kubebuilder:validation:MinItems:=1
+	//+optional
+	Variables []WorkspaceRunVariable `json:"variables,omitempty"`
+	// Message of the Run.
+	// Default: `Triggered by HCP Terraform Operator`.
+	//
+	//+kubebuilder:validation:MinLength:=1
+	//+optional
+	Message string `json:"message,omitempty"`
+	// Whether to apply the Run automatically once the plan succeeds.
+	// When not set, the Workspace auto-apply setting is used.
+	// Does not apply to runs of type `plan`.
+	//
+	//+optional
+	AutoApply *bool `json:"autoApply,omitempty"`
+	// Whether to allow the Run to be applied when the plan has no changes.
+	// This can be used to update the outputs of the Workspace state.
+	// Does not apply to runs of type `plan`.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/run/modes-and-options#allow-empty-apply
+	//
+	//+optional
+	AllowEmptyApply bool `json:"allowEmptyApply,omitempty"`
+	// Number of seconds after which the finished object is deleted.
+	// When not set, the object is kept until it is deleted manually.
+	// The Run itself stays in the Workspace history.
+	//
+	//+kubebuilder:validation:Minimum:=0
+	//+optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// Plan summary of the Run.
+type WorkspaceRunPlanStatus struct {
+	// Plan ID.
+	ID string `json:"id"`
+	// Plan status.
+	Status string `json:"status"`
+	// Number of resources to add.
+	ResourceAdditions int `json:"resourceAdditions"`
+	// Number of resources to change.
+	ResourceChanges int `json:"resourceChanges"`
+	// Number of resources to destroy.
+	ResourceDestructions int `json:"resourceDestructions"`
+	// Number of resources to import.
+	ResourceImports int `json:"resourceImports"`
+}
+
+// Output of the Workspace state created by the Run.
+type WorkspaceRunOutput struct {
+	// Output name.
+	Name string `json:"name"`
+	// Output value. Sensitive values are not exposed.
+	//
+	//+optional
+	Value string `json:"value,omitempty"`
+	// Whether the output is sensitive.
+	Sensitive bool `json:"sensitive"`
+}
+
+// WorkspaceRunStatus defines the observed state of WorkspaceRun.
+type WorkspaceRunStatus struct {
+	// Real world state generation.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Run ID.
+	//
+	//+optional
+	ID string `json:"id,omitempty"`
+	// Run status.
+	// More information:
+	//   - https://developer.hashicorp.com/terraform/cloud-docs/run/states
+	//
+	//+optional
+	Status string `json:"status,omitempty"`
+	// Workspace ID where the Run is executed.
+	//
+	//+optional
+	WorkspaceID string `json:"workspaceID,omitempty"`
+	// Configuration Version ID that the Run uses.
+	//
+	//+optional
+	ConfigurationVersion string `json:"configurationVersion,omitempty"`
+	// Plan summary.
+	//
+	//+optional
+	Plan *WorkspaceRunPlanStatus `json:"plan,omitempty"`
+	// Outputs of the Workspace state created by the Run.
+	// Only set when the Run is applied and its state is the current Workspace state.
+	//
+	//+optional
+	Outputs []WorkspaceRunOutput `json:"outputs,omitempty"`
+	// Time when the Run reached a final status.
+	//
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Conditions represent the latest available observations of the resource state.
+	// More information:
+	//   - https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
+	//
+	//+listType=map
+	//+listMapKey=type
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+//+kubebuilder:printcolumn:name="Run ID",type=string,JSONPath=`.status.id`
+//+kubebuilder:printcolumn:name="Run Status",type=string,JSONPath=`.status.status`
+//+kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+//+kubebuilder:metadata:labels="app.terraform.io/crd-schema-version=v26.10.0"
+
+// WorkspaceRun executes a single Run in an HCP Terraform Workspace.
+// Each object represents one Run, the history of Runs is kept as objects until they are deleted.
+// More information:
+//   - https://developer.hashicorp.com/terraform/cloud-docs/run/api
+type WorkspaceRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkspaceRunSpec   `json:"spec"`
+	Status WorkspaceRunStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// WorkspaceRunList contains a list of WorkspaceRun
+type WorkspaceRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkspaceRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkspaceRun{}, &WorkspaceRunList{})
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (r *WorkspaceRun) ValidateSpec() error {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateToken(r.Spec.Token, r.Spec.ConnectionRef, field.NewPath("spec"))...)
+	allErrs = append(allErrs, r.validateSpecWorkspace()...)
+	allErrs = append(allErrs, r.validateSpecType()...)
+	allErrs = append(allErrs, r.validateSpecVariables()...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: "", Kind: "WorkspaceRun"},
+		r.Name,
+		allErrs,
+	)
+}
+
+func (r *WorkspaceRun) validateSpecWorkspace() field.ErrorList {
+	allErrs := field.ErrorList{}
+	spec := r.Spec.Workspace
+	f := field.NewPath("spec").Child("workspace")
+
+	if spec == nil {
+		allErrs = append(allErrs, field.Required(f, "workspace must be set"))
+		return allErrs
+	}
+
+	if spec.ID == "" && spec.Name == "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"one of the field ID or Name must be set"),
+		)
+	}
+
+	if spec.ID != "" && spec.Name != "" {
+		allErrs = append(allErrs, field.Invalid(
+			f,
+			"",
+			"only one of the field ID or Name is allowed"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecType validates that the options of the Run are supported by its type:
+//   - spec.autoApply and spec.allowEmptyApply cannot be set for runs of type `plan`.
+//   - spec.replaceAddrs cannot be set for runs of type `refresh` or `destroy`.
+func (r *WorkspaceRun) validateSpecType() field.ErrorList {
+	allErrs := field.ErrorList{}
+	f := field.NewPath("spec")
+
+	if r.Spec.Type == WorkspaceRunTypePlan && r.Spec.AutoApply != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			f.Child("autoApply"),
+			"autoApply cannot be set for runs of type plan"),
+		)
+	}
+
+	if r.Spec.Type == WorkspaceRunTypePlan && r.Spec.AllowEmptyApply {
+		allErrs = append(allErrs, field.Forbidden(
+			f.Child("allowEmptyApply"),
+			"allowEmptyApply cannot be set for runs of type plan"),
+		)
+	}
+
+	if (r.Spec.Type == WorkspaceRunTypeRefresh || r.Spec.Type == WorkspaceRunTypeDestroy) && len(r.Spec.ReplaceAddrs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(
+			f.Child("replaceAddrs"),
+			"replaceAddrs cannot be set for runs of type refresh or destroy"),
+		)
+	}
+
+	return allErrs
+}
+
+// validateSpecVariables validates that the variable names are unique.
+func (r *WorkspaceRun) validateSpecVariables() field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := make(map[string]int)
+
+	for i, v := range r.Spec.Variables {
+		f := field.NewPath("spec").Child("variables").Index(i).Child("name")
+		if _, ok := names[v.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(f, v.Name))
+		}
+		names[v.Name] = i
+	}
+
+	return allErrs
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWorkspaceRunSpecWorkspace(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]WorkspaceRun{
+		"HasOnlyID": {
+			Spec: WorkspaceRunSpec{
+				Workspace: &WorkspaceRunWorkspace{
+					ID: "ws-this",
+				},
+			},
+		},
+		"HasOnlyName": {
+			Spec: WorkspaceRunSpec{
+				Workspace: &WorkspaceRunWorkspace{
+					Name: "this",
+				},
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecWorkspace()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]WorkspaceRun{
+		"HasIDandName": {
+			Spec: WorkspaceRunSpec{
+				Workspace: &WorkspaceRunWorkspace{
+					ID:   "ws-this",
+					Name: "this",
+				},
+			},
+		},
+		"HasEmptyIDandName": {
+			Spec: WorkspaceRunSpec{
+				Workspace: &WorkspaceRunWorkspace{},
+			},
+		},
+		"HasNoWorkspace": {
+			Spec: WorkspaceRunSpec{},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecWorkspace()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateWorkspaceRunSpecType(t *testing.T) {
+	t.Parallel()
+
+	autoApply := true
+
+	successCases := map[string]WorkspaceRun{
+		"PlanWithTargets": {
+			Spec: WorkspaceRunSpec{
+				Type:    WorkspaceRunTypePlan,
+				Targets: []string{"aws_instance.this"},
+			},
+		},
+		"ApplyWithAutoApplyAndReplaceAddrs": {
+			Spec: WorkspaceRunSpec{
+				Type:         WorkspaceRunTypeApply,
+				AutoApply:    &autoApply,
+				ReplaceAddrs: []string{"aws_instance.this"},
+			},
+		},
+		"DestroyWithAutoApply": {
+			Spec: WorkspaceRunSpec{
+				Type:      WorkspaceRunTypeDestroy,
+				AutoApply: &autoApply,
+			},
+		},
+		"RefreshWithAllowEmptyApply": {
+			Spec: WorkspaceRunSpec{
+				Type:            WorkspaceRunTypeRefresh,
+				AllowEmptyApply: true,
+			},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecType()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]WorkspaceRun{
+		"PlanWithAutoApply": {
+			Spec: WorkspaceRunSpec{
+				Type:      WorkspaceRunTypePlan,
+				AutoApply: &autoApply,
+			},
+		},
+		"PlanWithAllowEmptyApply": {
+			Spec: WorkspaceRunSpec{
+				Type:            WorkspaceRunTypePlan,
+				AllowEmptyApply: true,
+			},
+		},
+		"RefreshWithReplaceAddrs": {
+			Spec: WorkspaceRunSpec{
+				Type:         WorkspaceRunTypeRefresh,
+				ReplaceAddrs: []string{"aws_instance.this"},
+			},
+		},
+		"DestroyWithReplaceAddrs": {
+			Spec: WorkspaceRunSpec{
+				Type:         WorkspaceRunTypeDestroy,
+				ReplaceAddrs: []string{"aws_instance.this"},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecType()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
+
+func TestValidateWorkspaceRunSpecVariables(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]WorkspaceRun{
+		"HasUniqueNames": {
+			Spec: WorkspaceRunSpec{
+				Variables: []WorkspaceRunVariable{
+					{Name: "this", Value: `"this"`},
+					{Name: "that", Value: `"that"`},
+				},
+			},
+		},
+		"HasNoVariables": {
+			Spec: WorkspaceRunSpec{},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecVariables()
+			assert.Empty(t, errs, "Unexpected validation errors: %v", errs)
+		})
+	}
+
+	errorCases := map[string]WorkspaceRun{
+		"HasDuplicateNames": {
+			Spec: WorkspaceRunSpec{
+				Variables: []WorkspaceRunVariable{
+					{Name: "this", Value: `"this"`},
+					{Name: "this", Value: `"that"`},
+				},
+			},
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			errs := c.validateSpecVariables()
+			assert.NotEmpty(t, errs, "Unexpected failure, at least one error is expected")
+		})
+	}
+}
@@ -9,6 +9,7 @@ package v1alpha2
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -246,7 +247,16 @@ func (in *AgentPoolRef) DeepCopy() *AgentPoolRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentPoolSpec) DeepCopyInto(out *AgentPoolSpec) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.AgentTokens != nil {
 		in, out := &in.AgentTokens, &out.AgentTokens
 		*out = make([]*AgentAPIToken, len(*in))
@@ -299,6 +309,18 @@ func (in *AgentPoolStatus) DeepCopyInto(out *AgentPoolStatus) {
 		*out = new(AgentDeploymentAutoscalingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Observation != nil {
+		in, out := &in.Observation, &out.Observation
+		*out = new(ObservationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolStatus.
@@ -373,7 +395,16 @@ func (in *AgentTokenList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentTokenSpec) DeepCopyInto(out *AgentTokenSpec) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	out.AgentPool = in.AgentPool
 	if in.AgentTokens != nil {
 		in, out := &in.AgentTokens, &out.AgentTokens
@@ -413,6 +444,13 @@ func (in *AgentTokenStatus) DeepCopyInto(out *AgentTokenStatus) {
 			}
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTokenStatus.
@@ -440,6 +478,114 @@ func (in *ConfigurationVersionStatus) DeepCopy() *ConfigurationVersionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Connection) DeepCopyInto(out *Connection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Connection.
+func (in *Connection) DeepCopy() *Connection {
+	if in == nil {
+		return nil
+	}
+	out := new(Connection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Connection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionList) DeepCopyInto(out *ConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Connection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionList.
+func (in *ConnectionList) DeepCopy() *ConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ConnectionTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSpec.
+func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionTLS) DeepCopyInto(out *ConnectionTLS) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(ValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTLS.
+func (in *ConnectionTLS) DeepCopy() *ConnectionTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsumerWorkspace) DeepCopyInto(out *ConsumerWorkspace) {
 	*out = *in
@@ -485,6 +631,48 @@ func (in *CustomProjectPermissions) DeepCopy() *CustomProjectPermissions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetection) DeepCopyInto(out *DriftDetection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetection.
+func (in *DriftDetection) DeepCopy() *DriftDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionStatus) DeepCopyInto(out *DriftDetectionStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionStatus.
+func (in *DriftDetectionStatus) DeepCopy() *DriftDetectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Module) DeepCopyInto(out *Module) {
 	*out = *in
@@ -577,7 +765,16 @@ func (in *ModuleSource) DeepCopy() *ModuleSource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModuleSpec) DeepCopyInto(out *ModuleSpec) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.Module != nil {
 		in, out := &in.Module, &out.Module
 		*out = new(ModuleSource)
@@ -598,6 +795,13 @@ func (in *ModuleSpec) DeepCopyInto(out *ModuleSpec) {
 		*out = make([]ModuleOutput, len(*in))
 		copy(*out, *in)
 	}
+	if in.OutputTargets != nil {
+		in, out := &in.OutputTargets, &out.OutputTargets
+		*out = make([]OutputTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleSpec.
@@ -621,13 +825,25 @@ func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
 	if in.Run != nil {
 		in, out := &in.Run, &out.Run
 		*out = new(RunStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Output != nil {
 		in, out := &in.Output, &out.Output
 		*out = new(OutputStatus)
 		**out = **in
 	}
+	if in.Observation != nil {
+		in, out := &in.Observation, &out.Observation
+		*out = new(ObservationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
@@ -701,56 +917,47 @@ func (in *Notification) DeepCopy() *Notification {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
+func (in *ObservationStatus) DeepCopyInto(out *ObservationStatus) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
-func (in *OutputStatus) DeepCopy() *OutputStatus {
-	if in == nil {
-		return nil
+	in.ObservedAt.DeepCopyInto(&out.ObservedAt)
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(OutputStatus)
-	in.DeepCopyInto(out)
-	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
-func (in *PlanStatus) DeepCopy() *PlanStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservationStatus.
+func (in *ObservationStatus) DeepCopy() *ObservationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PlanStatus)
+	out := new(ObservationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *OrganizationRunTask) DeepCopyInto(out *OrganizationRunTask) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRunTask.
+func (in *OrganizationRunTask) DeepCopy() *OrganizationRunTask {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(OrganizationRunTask)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
+func (in *OrganizationRunTask) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -758,31 +965,31 @@ func (in *Project) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *OrganizationRunTaskList) DeepCopyInto(out *OrganizationRunTaskList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+		*out = make([]OrganizationRunTask, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRunTaskList.
+func (in *OrganizationRunTaskList) DeepCopy() *OrganizationRunTaskList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectList)
+	out := new(OrganizationRunTaskList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectList) DeepCopyObject() runtime.Object {
+func (in *OrganizationRunTaskList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -790,126 +997,136 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+func (in *OrganizationRunTaskSpec) DeepCopyInto(out *OrganizationRunTaskSpec) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
-	if in.TeamAccess != nil {
-		in, out := &in.TeamAccess, &out.TeamAccess
-		*out = make([]*ProjectTeamAccess, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ProjectTeamAccess)
-				(*in).DeepCopyInto(*out)
-			}
-		}
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.HMACKey != nil {
+		in, out := &in.HMACKey, &out.HMACKey
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
-func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRunTaskSpec.
+func (in *OrganizationRunTaskSpec) DeepCopy() *OrganizationRunTaskSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectSpec)
+	out := new(OrganizationRunTaskSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+func (in *OrganizationRunTaskStatus) DeepCopyInto(out *OrganizationRunTaskStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
-func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRunTaskStatus.
+func (in *OrganizationRunTaskStatus) DeepCopy() *OrganizationRunTaskStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectStatus)
+	out := new(OrganizationRunTaskStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectTeamAccess) DeepCopyInto(out *ProjectTeamAccess) {
+func (in *OutputStatus) DeepCopyInto(out *OutputStatus) {
 	*out = *in
-	out.Team = in.Team
-	if in.Custom != nil {
-		in, out := &in.Custom, &out.Custom
-		*out = new(CustomProjectPermissions)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAccess.
-func (in *ProjectTeamAccess) DeepCopy() *ProjectTeamAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputStatus.
+func (in *OutputStatus) DeepCopy() *OutputStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectTeamAccess)
+	out := new(OutputStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RemoteStateSharing) DeepCopyInto(out *RemoteStateSharing) {
+func (in *OutputTarget) DeepCopyInto(out *OutputTarget) {
 	*out = *in
-	if in.Workspaces != nil {
-		in, out := &in.Workspaces, &out.Workspaces
-		*out = make([]*ConsumerWorkspace, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(ConsumerWorkspace)
-				**out = **in
-			}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteStateSharing.
-func (in *RemoteStateSharing) DeepCopy() *RemoteStateSharing {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputTarget.
+func (in *OutputTarget) DeepCopy() *OutputTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(RemoteStateSharing)
+	out := new(OutputTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunStatus) DeepCopyInto(out *RunStatus) {
+func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
 	*out = *in
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(PlanSummary)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStatus.
-func (in *RunStatus) DeepCopy() *RunStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanStatus.
+func (in *PlanStatus) DeepCopy() *PlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RunStatus)
+	out := new(PlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunTrigger) DeepCopyInto(out *RunTrigger) {
+func (in *PlanSummary) DeepCopyInto(out *PlanSummary) {
 	*out = *in
+	if in.ChangedOutputs != nil {
+		in, out := &in.ChangedOutputs, &out.ChangedOutputs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunTrigger.
-func (in *RunTrigger) DeepCopy() *RunTrigger {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanSummary.
+func (in *PlanSummary) DeepCopy() *PlanSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(RunTrigger)
+	out := new(PlanSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunsCollector) DeepCopyInto(out *RunsCollector) {
+func (in *PolicySet) DeepCopyInto(out *PolicySet) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -917,18 +1134,18 @@ func (in *RunsCollector) DeepCopyInto(out *RunsCollector) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollector.
-func (in *RunsCollector) DeepCopy() *RunsCollector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySet.
+func (in *PolicySet) DeepCopy() *PolicySet {
 	if in == nil {
 		return nil
 	}
-	out := new(RunsCollector)
+	out := new(PolicySet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RunsCollector) DeepCopyObject() runtime.Object {
+func (in *PolicySet) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -936,31 +1153,31 @@ func (in *RunsCollector) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunsCollectorList) DeepCopyInto(out *RunsCollectorList) {
+func (in *PolicySetList) DeepCopyInto(out *PolicySetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RunsCollector, len(*in))
+		*out = make([]PolicySet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorList.
-func (in *RunsCollectorList) DeepCopy() *RunsCollectorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetList.
+func (in *PolicySetList) DeepCopy() *PolicySetList {
 	if in == nil {
 		return nil
 	}
-	out := new(RunsCollectorList)
+	out := new(PolicySetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RunsCollectorList) DeepCopyObject() runtime.Object {
+func (in *PolicySetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -968,313 +1185,1519 @@ func (in *RunsCollectorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunsCollectorSpec) DeepCopyInto(out *RunsCollectorSpec) {
+func (in *PolicySetParameter) DeepCopyInto(out *PolicySetParameter) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
-	if in.AgentPool != nil {
-		in, out := &in.AgentPool, &out.AgentPool
-		*out = new(AgentPoolRef)
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetParameter.
+func (in *PolicySetParameter) DeepCopy() *PolicySetParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetParameterStatus) DeepCopyInto(out *PolicySetParameterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetParameterStatus.
+func (in *PolicySetParameterStatus) DeepCopy() *PolicySetParameterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetParameterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetPolicy) DeepCopyInto(out *PolicySetPolicy) {
+	*out = *in
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetPolicy.
+func (in *PolicySetPolicy) DeepCopy() *PolicySetPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetPolicyStatus) DeepCopyInto(out *PolicySetPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetPolicyStatus.
+func (in *PolicySetPolicyStatus) DeepCopy() *PolicySetPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetProject) DeepCopyInto(out *PolicySetProject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetProject.
+func (in *PolicySetProject) DeepCopy() *PolicySetProject {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetSpec) DeepCopyInto(out *PolicySetSpec) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicySetPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VersionControl != nil {
+		in, out := &in.VersionControl, &out.VersionControl
+		*out = new(PolicySetVersionControl)
 		**out = **in
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]PolicySetParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]PolicySetWorkspace, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]PolicySetProject, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorSpec.
-func (in *RunsCollectorSpec) DeepCopy() *RunsCollectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetSpec.
+func (in *PolicySetSpec) DeepCopy() *PolicySetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RunsCollectorSpec)
+	out := new(PolicySetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunsCollectorStatus) DeepCopyInto(out *RunsCollectorStatus) {
+func (in *PolicySetStatus) DeepCopyInto(out *PolicySetStatus) {
 	*out = *in
-	if in.AgentPool != nil {
-		in, out := &in.AgentPool, &out.AgentPool
-		*out = new(AgentPoolRef)
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicySetPolicyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]PolicySetParameterStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetStatus.
+func (in *PolicySetStatus) DeepCopy() *PolicySetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetVersionControl) DeepCopyInto(out *PolicySetVersionControl) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetVersionControl.
+func (in *PolicySetVersionControl) DeepCopy() *PolicySetVersionControl {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetVersionControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySetWorkspace) DeepCopyInto(out *PolicySetWorkspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySetWorkspace.
+func (in *PolicySetWorkspace) DeepCopy() *PolicySetWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySetWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.TeamAccess != nil {
+		in, out := &in.TeamAccess, &out.TeamAccess
+		*out = make([]*ProjectTeamAccess, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ProjectTeamAccess)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorStatus.
-func (in *RunsCollectorStatus) DeepCopy() *RunsCollectorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	if in.Observation != nil {
+		in, out := &in.Observation, &out.Observation
+		*out = new(ObservationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectTeamAccess) DeepCopyInto(out *ProjectTeamAccess) {
+	*out = *in
+	out.Team = in.Team
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = new(CustomProjectPermissions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectTeamAccess.
+func (in *ProjectTeamAccess) DeepCopy() *ProjectTeamAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectTeamAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteStateSharing) DeepCopyInto(out *RemoteStateSharing) {
+	*out = *in
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]*ConsumerWorkspace, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ConsumerWorkspace)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteStateSharing.
+func (in *RemoteStateSharing) DeepCopy() *RemoteStateSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteStateSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunActionStatus) DeepCopyInto(out *RunActionStatus) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunActionStatus.
+func (in *RunActionStatus) DeepCopy() *RunActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RunActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunStatus) DeepCopyInto(out *RunStatus) {
+	*out = *in
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(PlanSummary)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStatus.
+func (in *RunStatus) DeepCopy() *RunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunTrigger) DeepCopyInto(out *RunTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunTrigger.
+func (in *RunTrigger) DeepCopy() *RunTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RunTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunsCollector) DeepCopyInto(out *RunsCollector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollector.
+func (in *RunsCollector) DeepCopy() *RunsCollector {
+	if in == nil {
+		return nil
+	}
+	out := new(RunsCollector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RunsCollector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunsCollectorList) DeepCopyInto(out *RunsCollectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RunsCollector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorList.
+func (in *RunsCollectorList) DeepCopy() *RunsCollectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(RunsCollectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RunsCollectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunsCollectorSpec) DeepCopyInto(out *RunsCollectorSpec) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.AgentPool != nil {
+		in, out := &in.AgentPool, &out.AgentPool
+		*out = new(AgentPoolRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorSpec.
+func (in *RunsCollectorSpec) DeepCopy() *RunsCollectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RunsCollectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunsCollectorStatus) DeepCopyInto(out *RunsCollectorStatus) {
+	*out = *in
+	if in.AgentPool != nil {
+		in, out := &in.AgentPool, &out.AgentPool
+		*out = new(AgentPoolRef)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunsCollectorStatus.
+func (in *RunsCollectorStatus) DeepCopy() *RunsCollectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RunsCollectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKey) DeepCopyInto(out *SSHKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
+func (in *SSHKey) DeepCopy() *SSHKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleSuspensionWindow) DeepCopyInto(out *ScheduleSuspensionWindow) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSuspensionWindow.
+func (in *ScheduleSuspensionWindow) DeepCopy() *ScheduleSuspensionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleSuspensionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetWorkspace) DeepCopyInto(out *TargetWorkspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetWorkspace.
+func (in *TargetWorkspace) DeepCopy() *TargetWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Team) DeepCopyInto(out *Team) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
+	if in == nil {
+		return nil
+	}
+	out := new(Team)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Team) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamAccess) DeepCopyInto(out *TeamAccess) {
+	*out = *in
+	out.Team = in.Team
+	out.Custom = in.Custom
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamAccess.
+func (in *TeamAccess) DeepCopy() *TeamAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamList) DeepCopyInto(out *TeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMember) DeepCopyInto(out *TeamMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMember.
+func (in *TeamMember) DeepCopy() *TeamMember {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMemberStatus) DeepCopyInto(out *TeamMemberStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMemberStatus.
+func (in *TeamMemberStatus) DeepCopy() *TeamMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamOrganizationAccess) DeepCopyInto(out *TeamOrganizationAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamOrganizationAccess.
+func (in *TeamOrganizationAccess) DeepCopy() *TeamOrganizationAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamOrganizationAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamRef) DeepCopyInto(out *TeamRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamRef.
+func (in *TeamRef) DeepCopy() *TeamRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.OrganizationAccess != nil {
+		in, out := &in.OrganizationAccess, &out.OrganizationAccess
+		*out = new(TeamOrganizationAccess)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]TeamMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]TeamMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Token) DeepCopyInto(out *Token) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Token.
+func (in *Token) DeepCopy() *Token {
+	if in == nil {
+		return nil
+	}
+	out := new(Token)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValueFrom.
+func (in *ValueFrom) DeepCopy() *ValueFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ValueFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Variable) DeepCopyInto(out *Variable) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueFrom)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
+func (in *Variable) DeepCopy() *Variable {
+	if in == nil {
+		return nil
+	}
+	out := new(Variable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSet) DeepCopyInto(out *VariableSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSet.
+func (in *VariableSet) DeepCopy() *VariableSet {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VariableSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSetList) DeepCopyInto(out *VariableSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VariableSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetList.
+func (in *VariableSetList) DeepCopy() *VariableSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VariableSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSetProject) DeepCopyInto(out *VariableSetProject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetProject.
+func (in *VariableSetProject) DeepCopy() *VariableSetProject {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSetProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSetSpec) DeepCopyInto(out *VariableSetSpec) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.TerraformVariables != nil {
+		in, out := &in.TerraformVariables, &out.TerraformVariables
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvironmentVariables != nil {
+		in, out := &in.EnvironmentVariables, &out.EnvironmentVariables
+		*out = make([]Variable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]VariableSetWorkspace, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]VariableSetProject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetSpec.
+func (in *VariableSetSpec) DeepCopy() *VariableSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSetStatus) DeepCopyInto(out *VariableSetStatus) {
+	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]VariableStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetStatus.
+func (in *VariableSetStatus) DeepCopy() *VariableSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableSetWorkspace) DeepCopyInto(out *VariableSetWorkspace) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetWorkspace.
+func (in *VariableSetWorkspace) DeepCopy() *VariableSetWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableSetWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
+func (in *VariableStatus) DeepCopy() *VariableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VariableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionControl) DeepCopyInto(out *VersionControl) {
+	*out = *in
+	if in.TriggerPatterns != nil {
+		in, out := &in.TriggerPatterns, &out.TriggerPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TriggerPrefixes != nil {
+		in, out := &in.TriggerPrefixes, &out.TriggerPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionControl.
+func (in *VersionControl) DeepCopy() *VersionControl {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Workspace) DeepCopyInto(out *Workspace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
+func (in *Workspace) DeepCopy() *Workspace {
+	if in == nil {
+		return nil
+	}
+	out := new(Workspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Workspace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceAdoption) DeepCopyInto(out *WorkspaceAdoption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAdoption.
+func (in *WorkspaceAdoption) DeepCopy() *WorkspaceAdoption {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceAdoption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceAdoptionStatus) DeepCopyInto(out *WorkspaceAdoptionStatus) {
+	*out = *in
+	in.AdoptedAt.DeepCopyInto(&out.AdoptedAt)
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(WorkspaceSettingsSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceAdoptionStatus.
+func (in *WorkspaceAdoptionStatus) DeepCopy() *WorkspaceAdoptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceAdoptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceList) DeepCopyInto(out *WorkspaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Workspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceList.
+func (in *WorkspaceList) DeepCopy() *WorkspaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceLockStatus) DeepCopyInto(out *WorkspaceLockStatus) {
+	*out = *in
+	in.LockedAt.DeepCopyInto(&out.LockedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceLockStatus.
+func (in *WorkspaceLockStatus) DeepCopy() *WorkspaceLockStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RunsCollectorStatus)
+	out := new(WorkspaceLockStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKey) DeepCopyInto(out *SSHKey) {
+func (in *WorkspaceProject) DeepCopyInto(out *WorkspaceProject) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
-func (in *SSHKey) DeepCopy() *SSHKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceProject.
+func (in *WorkspaceProject) DeepCopy() *WorkspaceProject {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKey)
+	out := new(WorkspaceProject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TargetWorkspace) DeepCopyInto(out *TargetWorkspace) {
+func (in *WorkspaceRun) DeepCopyInto(out *WorkspaceRun) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetWorkspace.
-func (in *TargetWorkspace) DeepCopy() *TargetWorkspace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRun.
+func (in *WorkspaceRun) DeepCopy() *WorkspaceRun {
 	if in == nil {
 		return nil
 	}
-	out := new(TargetWorkspace)
+	out := new(WorkspaceRun)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Team) DeepCopyInto(out *Team) {
+func (in *WorkspaceRunList) DeepCopyInto(out *WorkspaceRunList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkspaceRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
-func (in *Team) DeepCopy() *Team {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunList.
+func (in *WorkspaceRunList) DeepCopy() *WorkspaceRunList {
 	if in == nil {
 		return nil
 	}
-	out := new(Team)
+	out := new(WorkspaceRunList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkspaceRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamAccess) DeepCopyInto(out *TeamAccess) {
+func (in *WorkspaceRunOutput) DeepCopyInto(out *WorkspaceRunOutput) {
 	*out = *in
-	out.Team = in.Team
-	out.Custom = in.Custom
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamAccess.
-func (in *TeamAccess) DeepCopy() *TeamAccess {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunOutput.
+func (in *WorkspaceRunOutput) DeepCopy() *WorkspaceRunOutput {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamAccess)
+	out := new(WorkspaceRunOutput)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Token) DeepCopyInto(out *Token) {
+func (in *WorkspaceRunPlanStatus) DeepCopyInto(out *WorkspaceRunPlanStatus) {
 	*out = *in
-	if in.SecretKeyRef != nil {
-		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Token.
-func (in *Token) DeepCopy() *Token {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunPlanStatus.
+func (in *WorkspaceRunPlanStatus) DeepCopy() *WorkspaceRunPlanStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Token)
+	out := new(WorkspaceRunPlanStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
+func (in *WorkspaceRunSpec) DeepCopyInto(out *WorkspaceRunSpec) {
 	*out = *in
-	if in.ConfigMapKeyRef != nil {
-		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
-		*out = new(v1.ConfigMapKeySelector)
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SecretKeyRef != nil {
-		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(v1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Workspace != nil {
+		in, out := &in.Workspace, &out.Workspace
+		*out = new(WorkspaceRunWorkspace)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplaceAddrs != nil {
+		in, out := &in.ReplaceAddrs, &out.ReplaceAddrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]WorkspaceRunVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoApply != nil {
+		in, out := &in.AutoApply, &out.AutoApply
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValueFrom.
-func (in *ValueFrom) DeepCopy() *ValueFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunSpec.
+func (in *WorkspaceRunSpec) DeepCopy() *WorkspaceRunSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ValueFrom)
+	out := new(WorkspaceRunSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Variable) DeepCopyInto(out *Variable) {
+func (in *WorkspaceRunStatus) DeepCopyInto(out *WorkspaceRunStatus) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(ValueFrom)
-		(*in).DeepCopyInto(*out)
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(WorkspaceRunPlanStatus)
+		**out = **in
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]WorkspaceRunOutput, len(*in))
+		copy(*out, *in)
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Variable.
-func (in *Variable) DeepCopy() *Variable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunStatus.
+func (in *WorkspaceRunStatus) DeepCopy() *WorkspaceRunStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Variable)
+	out := new(WorkspaceRunStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableSetStatus) DeepCopyInto(out *VariableSetStatus) {
+func (in *WorkspaceRunTask) DeepCopyInto(out *WorkspaceRunTask) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableSetStatus.
-func (in *VariableSetStatus) DeepCopy() *VariableSetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunTask.
+func (in *WorkspaceRunTask) DeepCopy() *WorkspaceRunTask {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableSetStatus)
+	out := new(WorkspaceRunTask)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VariableStatus) DeepCopyInto(out *VariableStatus) {
+func (in *WorkspaceRunVariable) DeepCopyInto(out *WorkspaceRunVariable) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VariableStatus.
-func (in *VariableStatus) DeepCopy() *VariableStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunVariable.
+func (in *WorkspaceRunVariable) DeepCopy() *WorkspaceRunVariable {
 	if in == nil {
 		return nil
 	}
-	out := new(VariableStatus)
+	out := new(WorkspaceRunVariable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VersionControl) DeepCopyInto(out *VersionControl) {
+func (in *WorkspaceRunWorkspace) DeepCopyInto(out *WorkspaceRunWorkspace) {
 	*out = *in
-	if in.TriggerPatterns != nil {
-		in, out := &in.TriggerPatterns, &out.TriggerPatterns
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TriggerPrefixes != nil {
-		in, out := &in.TriggerPrefixes, &out.TriggerPrefixes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionControl.
-func (in *VersionControl) DeepCopy() *VersionControl {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunWorkspace.
+func (in *WorkspaceRunWorkspace) DeepCopy() *WorkspaceRunWorkspace {
 	if in == nil {
 		return nil
 	}
-	out := new(VersionControl)
+	out := new(WorkspaceRunWorkspace)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Workspace) DeepCopyInto(out *Workspace) {
+func (in *WorkspaceSchedule) DeepCopyInto(out *WorkspaceSchedule) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.AutoApply != nil {
+		in, out := &in.AutoApply, &out.AutoApply
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SuspensionWindows != nil {
+		in, out := &in.SuspensionWindows, &out.SuspensionWindows
+		*out = make([]ScheduleSuspensionWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workspace.
-func (in *Workspace) DeepCopy() *Workspace {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSchedule.
+func (in *WorkspaceSchedule) DeepCopy() *WorkspaceSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(Workspace)
+	out := new(WorkspaceSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Workspace) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *WorkspaceList) DeepCopyInto(out *WorkspaceList) {
+func (in *WorkspaceScheduleStatus) DeepCopyInto(out *WorkspaceScheduleStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Workspace, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuspendedTime != nil {
+		in, out := &in.LastSuspendedTime, &out.LastSuspendedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceList.
-func (in *WorkspaceList) DeepCopy() *WorkspaceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceScheduleStatus.
+func (in *WorkspaceScheduleStatus) DeepCopy() *WorkspaceScheduleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(WorkspaceList)
+	out := new(WorkspaceScheduleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *WorkspaceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *WorkspaceProject) DeepCopyInto(out *WorkspaceProject) {
+func (in *WorkspaceSettingsDriftStatus) DeepCopyInto(out *WorkspaceSettingsDriftStatus) {
 	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceProject.
-func (in *WorkspaceProject) DeepCopy() *WorkspaceProject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSettingsDriftStatus.
+func (in *WorkspaceSettingsDriftStatus) DeepCopy() *WorkspaceSettingsDriftStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(WorkspaceProject)
+	out := new(WorkspaceSettingsDriftStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *WorkspaceRunTask) DeepCopyInto(out *WorkspaceRunTask) {
+func (in *WorkspaceSettingsSnapshot) DeepCopyInto(out *WorkspaceSettingsSnapshot) {
 	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceRunTask.
-func (in *WorkspaceRunTask) DeepCopy() *WorkspaceRunTask {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSettingsSnapshot.
+func (in *WorkspaceSettingsSnapshot) DeepCopy() *WorkspaceSettingsSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(WorkspaceRunTask)
+	out := new(WorkspaceSettingsSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1282,7 +2705,16 @@ func (in *WorkspaceRunTask) DeepCopy() *WorkspaceRunTask {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 	*out = *in
-	in.Token.DeepCopyInto(&out.Token)
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(Token)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionRef != nil {
+		in, out := &in.ConnectionRef, &out.ConnectionRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.AgentPool != nil {
 		in, out := &in.AgentPool, &out.AgentPool
 		*out = new(AgentPoolRef)
@@ -1360,6 +2792,40 @@ func (in *WorkspaceSpec) DeepCopyInto(out *WorkspaceSpec) {
 		*out = make([]WorkspaceVariableSet, len(*in))
 		copy(*out, *in)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetection)
+		**out = **in
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]WorkspaceSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlanTimeoutSeconds != nil {
+		in, out := &in.PlanTimeoutSeconds, &out.PlanTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Adopt != nil {
+		in, out := &in.Adopt, &out.Adopt
+		*out = new(WorkspaceAdoption)
+		**out = **in
+	}
+	if in.IgnoreChanges != nil {
+		in, out := &in.IgnoreChanges, &out.IgnoreChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputTargets != nil {
+		in, out := &in.OutputTargets, &out.OutputTargets
+		*out = make([]OutputTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceSpec.
@@ -1378,12 +2844,12 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 	if in.Run != nil {
 		in, out := &in.Run, &out.Run
 		*out = new(RunStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Plan != nil {
 		in, out := &in.Plan, &out.Plan
 		*out = new(PlanStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Variables != nil {
 		in, out := &in.Variables, &out.Variables
@@ -1392,9 +2858,53 @@ func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 	}
 	if in.VariableSets != nil {
 		in, out := &in.VariableSets, &out.VariableSets
-		*out = make([]VariableSetStatus, len(*in))
+		*out = make([]WorkspaceVariableSetStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunAction != nil {
+		in, out := &in.RunAction, &out.RunAction
+		*out = new(RunActionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lock != nil {
+		in, out := &in.Lock, &out.Lock
+		*out = new(WorkspaceLockStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(WorkspaceAdoptionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SettingsDrift != nil {
+		in, out := &in.SettingsDrift, &out.SettingsDrift
+		*out = new(WorkspaceSettingsDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]WorkspaceScheduleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Observation != nil {
+		in, out := &in.Observation, &out.Observation
+		*out = new(ObservationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceStatus.
@@ -1421,3 +2931,18 @@ func (in *WorkspaceVariableSet) DeepCopy() *WorkspaceVariableSet {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceVariableSetStatus) DeepCopyInto(out *WorkspaceVariableSetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceVariableSetStatus.
+func (in *WorkspaceVariableSetStatus) DeepCopy() *WorkspaceVariableSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceVariableSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -46,18 +46,30 @@ func defaultDeployment() appsv1.Deployment {
 							Command: []string{"/manager"},
 							Args: []string{
 								"--sync-period=1h",
+								"--api-rate-limit=30",
+								"--api-rate-burst=30",
 								"--agent-pool-workers=1",
 								"--agent-pool-sync-period=30s",
 								"--agent-token-workers=1",
 								"--agent-token-sync-period=15m",
 								"--module-workers=1",
 								"--module-sync-period=5m",
+								"--organization-run-task-workers=1",
+								"--organization-run-task-sync-period=5m",
+								"--policy-set-workers=1",
+								"--policy-set-sync-period=5m",
 								"--project-workers=1",
 								"--project-sync-period=5m",
 								"--runs-collector-workers=1",
 								"--runs-collector-sync-period=15s",
+								"--team-workers=1",
+								"--team-sync-period=5m",
+								"--variable-set-workers=1",
+								"--variable-set-sync-period=5m",
 								"--workspace-workers=1",
 								"--workspace-sync-period=5m",
+								"--workspace-run-workers=1",
+								"--workspace-run-sync-period=30s",
 							},
 							Resources: corev1.ResourceRequirements{
 								Limits: corev1.ResourceList{
@@ -409,23 +421,51 @@ func TestDeploymentOperatorSyncPeriod(t *testing.T) {
 	dd := defaultDeployment()
 	dd.Spec.Template.Spec.Containers[0].Args = []string{
 		"--sync-period=4h",
+		"--api-rate-limit=30",
+		"--api-rate-burst=30",
 		"--agent-pool-workers=1",
 		"--agent-pool-sync-period=30s",
 		"--agent-token-workers=1",
 		"--agent-token-sync-period=15m",
 		"--module-workers=1",
 		"--module-sync-period=5m",
+		"--organization-run-task-workers=1",
+		"--organization-run-task-sync-period=5m",
+		"--policy-set-workers=1",
+		"--policy-set-sync-period=5m",
 		"--project-workers=1",
 		"--project-sync-period=5m",
 		"--runs-collector-workers=1",
 		"--runs-collector-sync-period=15s",
+		"--team-workers=1",
+		"--team-sync-period=5m",
+		"--variable-set-workers=1",
+		"--variable-set-sync-period=5m",
 		"--workspace-workers=1",
 		"--workspace-sync-period=5m",
+		"--workspace-run-workers=1",
+		"--workspace-run-sync-period=30s",
 	}
 
 	assert.Equal(t, dd, deployment)
 }
 
+func TestDeploymentOperatorAPIRateLimit(t *testing.T) {
+	options := &helm.Options{
+		SetValues: map[string]string{
+			"operator.apiRateLimit.requestsPerSecond": "7.5",
+			"operator.apiRateLimit.burst":             "15",
+		},
+		Version: helmChartVersion,
+	}
+	deployment := renderDeploymentManifest(t, options)
+	dd := defaultDeployment()
+	dd.Spec.Template.Spec.Containers[0].Args[1] = "--api-rate-limit=7.5"
+	dd.Spec.Template.Spec.Containers[0].Args[2] = "--api-rate-burst=15"
+
+	assert.Equal(t, dd, deployment)
+}
+
 func TestDeploymentOperatorWatchedNamespaces(t *testing.T) {
 	options := &helm.Options{
 		SetValues: map[string]string{
@@ -582,18 +622,28 @@ func TestDeploymentKubeRbacProxyResources(t *testing.T) {
 func TestDeploymentControllers(t *testing.T) {
 	options := &helm.Options{
 		SetValues: map[string]string{
-			"controllers.agentPool.workers":        "5",
-			"controllers.agentPool.syncPeriod":     "15m",
-			"controllers.agentToken.workers":       "5",
-			"controllers.agentToken.syncPeriod":    "15m",
-			"controllers.module.workers":           "5",
-			"controllers.module.syncPeriod":        "15m",
-			"controllers.project.workers":          "5",
-			"controllers.project.syncPeriod":       "15m",
-			"controllers.runsCollector.workers":    "5",
-			"controllers.runsCollector.syncPeriod": "15m",
-			"controllers.workspace.workers":        "5",
-			"controllers.workspace.syncPeriod":     "15m",
+			"controllers.agentPool.workers":              "5",
+			"controllers.agentPool.syncPeriod":           "15m",
+			"controllers.agentToken.workers":             "5",
+			"controllers.agentToken.syncPeriod":          "15m",
+			"controllers.module.workers":                 "5",
+			"controllers.module.syncPeriod":              "15m",
+			"controllers.organizationRunTask.workers":    "5",
+			"controllers.organizationRunTask.syncPeriod": "15m",
+			"controllers.policySet.workers":              "5",
+			"controllers.policySet.syncPeriod":           "15m",
+			"controllers.project.workers":                "5",
+			"controllers.project.syncPeriod":             "15m",
+			"controllers.runsCollector.workers":          "5",
+			"controllers.runsCollector.syncPeriod":       "15m",
+			"controllers.team.workers":                   "5",
+			"controllers.team.syncPeriod":                "15m",
+			"controllers.variableSet.workers":            "5",
+			"controllers.variableSet.syncPeriod":         "15m",
+			"controllers.workspace.workers":              "5",
+			"controllers.workspace.syncPeriod":           "15m",
+			"controllers.workspaceRun.workers":           "5",
+			"controllers.workspaceRun.syncPeriod":        "15m",
 		},
 		Version: helmChartVersion,
 	}
@@ -601,18 +651,30 @@ func TestDeploymentControllers(t *testing.T) {
 	dd := defaultDeployment()
 	dd.Spec.Template.Spec.Containers[0].Args = []string{
 		"--sync-period=1h",
+		"--api-rate-limit=30",
+		"--api-rate-burst=30",
 		"--agent-pool-workers=5",
 		"--agent-pool-sync-period=15m",
 		"--agent-token-workers=5",
 		"--agent-token-sync-period=15m",
 		"--module-workers=5",
 		"--module-sync-period=15m",
+		"--organization-run-task-workers=5",
+		"--organization-run-task-sync-period=15m",
+		"--policy-set-workers=5",
+		"--policy-set-sync-period=15m",
 		"--project-workers=5",
 		"--project-sync-period=15m",
 		"--runs-collector-workers=5",
 		"--runs-collector-sync-period=15m",
+		"--team-workers=5",
+		"--team-sync-period=15m",
+		"--variable-set-workers=5",
+		"--variable-set-sync-period=15m",
 		"--workspace-workers=5",
 		"--workspace-sync-period=15m",
+		"--workspace-run-workers=5",
+		"--workspace-run-sync-period=15m",
 	}
 
 	assert.Equal(t, dd, deployment)
@@ -651,6 +713,126 @@ func TestDeploymentCustomCAcertificates(t *testing.T) {
 	assert.Equal(t, dd, deployment)
 }
 
+func TestDeploymentOperatorTLSCABundle(t *testing.T) {
+	options := &helm.Options{
+		SetValues: map[string]string{
+			"operator.tls.caBundle.configMapName": "tfe-ca",
+		},
+		Version: helmChartVersion,
+	}
+	deployment := renderDeploymentManifest(t, options)
+	dd := defaultDeployment()
+	dd.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+		{
+			Name:  "TFC_TLS_CA_BUNDLE_FILE",
+			Value: "/etc/hcp-terraform-operator/tls/ca/ca.crt",
+		},
+	}
+	dd.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "tls-ca-bundle",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "tfe-ca",
+					},
+				},
+			},
+		},
+	}
+	dd.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      "tls-ca-bundle",
+			ReadOnly:  true,
+			MountPath: "/etc/hcp-terraform-operator/tls/ca",
+		},
+	}
+
+	assert.Equal(t, dd, deployment)
+}
+
+func TestDeploymentOperatorTLSClientCertificate(t *testing.T) {
+	options := &helm.Options{
+		SetValues: map[string]string{
+			"operator.tls.clientCertificateSecretName": "tfe-client-certificate",
+		},
+		Version: helmChartVersion,
+	}
+	deployment := renderDeploymentManifest(t, options)
+	dd := defaultDeployment()
+	dd.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{
+		{
+			Name:  "TFC_TLS_CLIENT_CERT_FILE",
+			Value: "/etc/hcp-terraform-operator/tls/client/tls.crt",
+		},
+		{
+			Name:  "TFC_TLS_CLIENT_KEY_FILE",
+			Value: "/etc/hcp-terraform-operator/tls/client/tls.key",
+		},
+	}
+	dd.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "tls-client-certificate",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: "tfe-client-certificate",
+				},
+			},
+		},
+	}
+	dd.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      "tls-client-certificate",
+			ReadOnly:  true,
+			MountPath: "/etc/hcp-terraform-operator/tls/client",
+		},
+	}
+
+	assert.Equal(t, dd, deployment)
+}
+
+func TestDeploymentWebhook(t *testing.T) {
+	options := &helm.Options{
+		SetValues: map[string]string{
+			"webhook.enabled": "true",
+		},
+		Version: helmChartVersion,
+	}
+	deployment := renderDeploymentManifest(t, options)
+	dd := defaultDeployment()
+	dd.Spec.Template.Spec.Containers[0].Args = append(dd.Spec.Template.Spec.Containers[0].Args,
+		"--enable-webhooks",
+		"--webhook-port=9443",
+		"--webhook-cert-dir=/tmp/k8s-webhook-server/serving-certs",
+	)
+	dd.Spec.Template.Spec.Containers[0].Ports = []corev1.ContainerPort{
+		{
+			Name:          "webhook-server",
+			ContainerPort: 9443,
+			Protocol:      corev1.ProtocolTCP,
+		},
+	}
+	dd.Spec.Template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "webhook-server-cert",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: fmt.Sprintf("%s-webhook-server-cert", helmReleaseName),
+				},
+			},
+		},
+	}
+	dd.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      "webhook-server-cert",
+			ReadOnly:  true,
+			MountPath: "/tmp/k8s-webhook-server/serving-certs",
+		},
+	}
+
+	assert.Equal(t, dd, deployment)
+}
+
 func TestDeploymentServiceAccountName(t *testing.T) {
 	serviceAccountName := "this"
 	options := &helm.Options{
@@ -49,6 +49,7 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 				"update",
 				"watch",
 				"patch",
+				"delete",
 			},
 			APIGroups: []string{""},
 			Resources: []string{
@@ -79,8 +80,13 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 				"agentpools",
 				"agenttokens",
 				"modules",
+				"organizationruntasks",
+				"policysets",
 				"projects",
 				"runscollectors",
+				"teams",
+				"variablesets",
+				"workspaceruns",
 				"workspaces",
 			},
 		},
@@ -93,8 +99,13 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 				"agentpools/finalizers",
 				"agenttokens/finalizers",
 				"modules/finalizers",
+				"organizationruntasks/finalizers",
+				"policysets/finalizers",
 				"projects/finalizers",
 				"runscollectors/finalizers",
+				"teams/finalizers",
+				"variablesets/finalizers",
+				"workspaceruns/finalizers",
 				"workspaces/finalizers",
 			},
 		},
@@ -109,11 +120,27 @@ func testRBACClusterRoleManagerRules(t *testing.T, rbac rbacv1.ClusterRole) {
 				"agentpools/status",
 				"agenttokens/status",
 				"modules/status",
+				"organizationruntasks/status",
+				"policysets/status",
 				"projects/status",
 				"runscollectors/status",
+				"teams/status",
+				"variablesets/status",
+				"workspaceruns/status",
 				"workspaces/status",
 			},
 		},
+		{
+			Verbs: []string{
+				"get",
+				"list",
+				"watch",
+			},
+			APIGroups: []string{"app.terraform.io"},
+			Resources: []string{
+				"connections",
+			},
+		},
 		{
 			Verbs: []string{
 				"create",
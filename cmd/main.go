@@ -27,11 +27,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/go-logr/zapr"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 	"github.com/hashicorp/hcp-terraform-operator/internal/controller"
+	webhookv1alpha2 "github.com/hashicorp/hcp-terraform-operator/internal/webhook/v1alpha2"
 	"github.com/hashicorp/hcp-terraform-operator/version"
 	//+kubebuilder:scaffold:imports
 )
@@ -64,6 +66,21 @@ func main() {
 	flag.Var(&watchNamespaces, "namespace", "Namespace to watch")
 	var opVersion bool
 	flag.BoolVar(&opVersion, "version", false, "Print operator version")
+	// API OPTIONS
+	flag.Float64Var(&controller.APIRateLimit, "api-rate-limit", 30,
+		"The maximum number of HCP Terraform API requests per second per API endpoint and organization, shared across all controllers. Set to 0 to disable.")
+	flag.IntVar(&controller.APIRateBurst, "api-rate-burst", 30,
+		"The maximum burst of HCP Terraform API requests per API endpoint and organization.")
+	// WEBHOOK OPTIONS
+	var enableWebhooks bool
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Enable the validating and defaulting admission webhooks.")
+	var webhookPort int
+	flag.IntVar(&webhookPort, "webhook-port", 9443,
+		"The port the admission webhook server listens on.")
+	var webhookCertDir string
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "",
+		"The directory that contains the admission webhook server certificate and key, named tls.crt and tls.key respectively. The certificate is reloaded when the files change. Defaults to <temp-dir>/k8s-webhook-server/serving-certs.")
 	// AGENT POOL CONTROLLER OPTIONS
 	var agentPoolWorkers int
 	flag.IntVar(&agentPoolWorkers, "agent-pool-workers", 1,
@@ -82,6 +99,18 @@ func main() {
 		"The number of the Module controller workers.")
 	flag.DurationVar(&controller.ModuleSyncPeriod, "module-sync-period", 5*time.Minute,
 		"The minimum frequency at which watched workspace resources are reconciled. Format: 5s, 1m, etc.")
+	// ORGANIZATION RUN TASK CONTROLLER OPTIONS
+	var organizationRunTaskWorkers int
+	flag.IntVar(&organizationRunTaskWorkers, "organization-run-task-workers", 1,
+		"The number of the Organization Run Task controller workers.")
+	flag.DurationVar(&controller.OrganizationRunTaskSyncPeriod, "organization-run-task-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched organization run task resources are reconciled. Format: 5s, 1m, etc.")
+	// POLICY SET CONTROLLER OPTIONS
+	var policySetWorkers int
+	flag.IntVar(&policySetWorkers, "policy-set-workers", 1,
+		"The number of the Policy Set controller workers.")
+	flag.DurationVar(&controller.PolicySetSyncPeriod, "policy-set-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched policy set resources are reconciled. Format: 5s, 1m, etc.")
 	// PROJECT CONTROLLER OPTIONS
 	var projectWorkers int
 	flag.IntVar(&projectWorkers, "project-workers", 1,
@@ -94,12 +123,30 @@ func main() {
 		"The number of the Runs Collector controller workers.")
 	flag.DurationVar(&controller.RunsCollectorSyncPeriod, "runs-collector-sync-period", 15*time.Second,
 		"The minimum frequency at which watched runs collector resources are reconciled. Format: 5s, 1m, etc.")
+	// TEAM CONTROLLER OPTIONS
+	var teamWorkers int
+	flag.IntVar(&teamWorkers, "team-workers", 1,
+		"The number of the Team controller workers.")
+	flag.DurationVar(&controller.TeamSyncPeriod, "team-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched team resources are reconciled. Format: 5s, 1m, etc.")
+	// VARIABLE SET CONTROLLER OPTIONS
+	var variableSetWorkers int
+	flag.IntVar(&variableSetWorkers, "variable-set-workers", 1,
+		"The number of the Variable Set controller workers.")
+	flag.DurationVar(&controller.VariableSetSyncPeriod, "variable-set-sync-period", 5*time.Minute,
+		"The minimum frequency at which watched variable set resources are reconciled. Format: 5s, 1m, etc.")
 	// WORKSPACE CONTROLLER OPTIONS
 	var workspaceWorkers int
 	flag.IntVar(&workspaceWorkers, "workspace-workers", 1,
 		"The number of the Workspace controller workers.")
 	flag.DurationVar(&controller.WorkspaceSyncPeriod, "workspace-sync-period", 5*time.Minute,
 		"The minimum frequency at which watched workspace resources are reconciled. Format: 5s, 1m, etc.")
+	// WORKSPACE RUN CONTROLLER OPTIONS
+	var workspaceRunWorkers int
+	flag.IntVar(&workspaceRunWorkers, "workspace-run-workers", 1,
+		"The number of the Workspace Run controller workers.")
+	flag.DurationVar(&controller.WorkspaceRunSyncPeriod, "workspace-run-sync-period", 30*time.Second,
+		"The minimum frequency at which watched workspace run resources in progress are reconciled. Format: 5s, 1m, etc.")
 
 	// TODO
 	// - Add validation that 'sync-period' has a higher value than '*-sync-period'
@@ -139,12 +186,17 @@ func main() {
 	options := ctrl.Options{
 		Controller: config.Controller{
 			GroupKindConcurrency: map[string]int{
-				"AgentPool.app.terraform.io":     agentPoolWorkers,
-				"AgentToken.app.terraform.io":    agentTokenWorkers,
-				"Module.app.terraform.io":        moduleWorkers,
-				"Project.app.terraform.io":       projectWorkers,
-				"RunsCollector.app.terraform.io": runsCollectorWorkers,
-				"Workspace.app.terraform.io":     workspaceWorkers,
+				"AgentPool.app.terraform.io":           agentPoolWorkers,
+				"AgentToken.app.terraform.io":          agentTokenWorkers,
+				"Module.app.terraform.io":              moduleWorkers,
+				"OrganizationRunTask.app.terraform.io": organizationRunTaskWorkers,
+				"PolicySet.app.terraform.io":           policySetWorkers,
+				"Project.app.terraform.io":             projectWorkers,
+				"RunsCollector.app.terraform.io":       runsCollectorWorkers,
+				"Team.app.terraform.io":                teamWorkers,
+				"VariableSet.app.terraform.io":         variableSetWorkers,
+				"Workspace.app.terraform.io":           workspaceWorkers,
+				"WorkspaceRun.app.terraform.io":        workspaceRunWorkers,
 			},
 		},
 		Scheme: scheme,
@@ -177,6 +229,14 @@ func main() {
 		}
 	}
 
+	if enableWebhooks {
+		setupLog.Info(fmt.Sprintf("Admission webhooks are enabled on port %d", webhookPort))
+		options.WebhookServer = webhook.NewServer(webhook.Options{
+			Port:    webhookPort,
+			CertDir: webhookCertDir,
+		})
+	}
+
 	if len(watchNamespaces) != 0 {
 		setupLog.Info("Watching namespaces: " + strings.Join(watchNamespaces, " "))
 		for _, n := range watchNamespaces {
@@ -190,9 +250,15 @@ func main() {
 	setupLog.Info(fmt.Sprintf("Agent Pool sync period: %s", controller.AgentPoolSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Agent Token sync period: %s", controller.AgentTokenSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Module sync period: %s", controller.ModuleSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Organization Run Task sync period: %s", controller.OrganizationRunTaskSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Policy Set sync period: %s", controller.PolicySetSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Project sync period: %s", controller.ProjectSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Runs Collector sync period: %s", controller.RunsCollectorSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Team sync period: %s", controller.TeamSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Variable Set sync period: %s", controller.VariableSetSyncPeriod))
 	setupLog.Info(fmt.Sprintf("Workspace sync period: %s", controller.WorkspaceSyncPeriod))
+	setupLog.Info(fmt.Sprintf("Workspace Run sync period: %s", controller.WorkspaceRunSyncPeriod))
+	setupLog.Info(fmt.Sprintf("API rate limit: %g requests per second with a burst of %d", controller.APIRateLimit, controller.APIRateBurst))
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
 	if err != nil {
@@ -200,54 +266,122 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := controller.LoadOperatorTLS(); err != nil {
+		setupLog.Error(err, "unable to load TLS settings")
+		os.Exit(1)
+	}
+
+	clientCache := controller.NewTerraformClientCache()
+	if err := clientCache.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to set up HCP Terraform client cache")
+		os.Exit(1)
+	}
+
 	if err := (&controller.AgentPoolReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("AgentPoolController"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("AgentPoolController"),
+		ClientCache: clientCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AgentPool")
 		os.Exit(1)
 	}
 	if err = (&controller.AgentTokenReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("AgentTokenController"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("AgentTokenController"),
+		ClientCache: clientCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AgentToken")
 		os.Exit(1)
 	}
 	if err = (&controller.ModuleReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("ModuleController"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("ModuleController"),
+		ClientCache: clientCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Module")
 		os.Exit(1)
 	}
+	if err := (&controller.OrganizationRunTaskReconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("OrganizationRunTaskController"),
+		ClientCache: clientCache,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OrganizationRunTask")
+		os.Exit(1)
+	}
+	if err := (&controller.PolicySetReconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("PolicySetController"),
+		ClientCache: clientCache,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "PolicySet")
+		os.Exit(1)
+	}
 	if err := (&controller.ProjectReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("ProjectController"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("ProjectController"),
+		ClientCache: clientCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Project")
 		os.Exit(1)
 	}
 	if err := (&controller.RunsCollectorReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("RunsCollectorController"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("RunsCollectorController"),
+		ClientCache: clientCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "RunsCollector")
 		os.Exit(1)
 	}
+	if err := (&controller.TeamReconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("TeamController"),
+		ClientCache: clientCache,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Team")
+		os.Exit(1)
+	}
+	if err := (&controller.VariableSetReconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("VariableSetController"),
+		ClientCache: clientCache,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VariableSet")
+		os.Exit(1)
+	}
 	if err := (&controller.WorkspaceReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("WorkspaceController"),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("WorkspaceController"),
+		ClientCache: clientCache,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Workspace")
 		os.Exit(1)
 	}
+	if err := (&controller.WorkspaceRunReconciler{
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor("WorkspaceRunController"),
+		ClientCache: clientCache,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "WorkspaceRun")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err := webhookv1alpha2.SetupWebhooksWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -5,34 +5,30 @@ package controller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
-	"os"
-	"strconv"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
-	"github.com/hashicorp/hcp-terraform-operator/version"
 )
 
 // AgentPoolReconciler reconciles a AgentPool object
 type AgentPoolReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
 }
 
 type agentPoolInstance struct {
@@ -76,9 +72,14 @@ func (r *AgentPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err := ap.instance.ValidateSpec(); err != nil {
 		ap.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
 		r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&ap.instance.Status.Conditions, ap.instance.Generation, err)
+		if err := r.Status().Update(ctx, &ap.instance); err != nil {
+			ap.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
 		return doNotRequeue()
 	}
 	ap.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&ap.instance.Status.Conditions, ap.instance.Generation, nil)
 
 	if needToAddFinalizer(&ap.instance, agentPoolFinalizer) {
 		err := r.addFinalizer(ctx, &ap.instance)
@@ -95,13 +96,38 @@ func (r *AgentPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err != nil {
 		ap.log.Error(err, "Agent Pool Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&ap.instance.Status.Conditions, ap.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &ap.instance); err != nil {
+			ap.log.Error(err, "Agent Pool Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
+	if ap.instance.Spec.ManagementMode == appv1alpha2.ManagementModeObserve {
+		err = r.observeAgentPool(ctx, &ap)
+		if err != nil {
+			ap.log.Error(err, "Agent Pool Controller", "msg", "observe agent pool")
+			r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentPool", "Failed to observe agent pool")
+			setSyncedCondition(&ap.instance.Status.Conditions, ap.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+			if err := r.Status().Update(ctx, &ap.instance); err != nil {
+				ap.log.Error(err, "Agent Pool Controller", "msg", "failed to update status conditions")
+			}
+			return requeueAfter(requeueInterval)
+		}
+		ap.log.Info("Agent Pool Controller", "msg", "successfully observed agent pool")
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeNormal, "ReconcileAgentPool", "Successfully observed agent pool ID %s", ap.instance.Status.AgentPoolID)
+
+		return requeueAfter(AgentPoolSyncPeriod)
+	}
+
 	err = r.reconcileAgentPool(ctx, &ap)
 	if err != nil {
 		ap.log.Error(err, "Agent Pool Controller", "msg", "reconcile agent pool")
 		r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentPool", "Failed to reconcile agent pool")
+		setSyncedCondition(&ap.instance.Status.Conditions, ap.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &ap.instance); err != nil {
+			ap.log.Error(err, "Agent Pool Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 	ap.log.Info("Agent Pool Controller", "msg", "successfully reconcilied agent pool")
@@ -116,46 +142,19 @@ func (r *AgentPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AgentPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.AgentPool{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
-		Complete(r)
-}
-
-func (r *AgentPoolReconciler) getTerraformClient(ctx context.Context, ap *agentPoolInstance) error {
-	nn := types.NamespacedName{
-		Namespace: ap.instance.Namespace,
-		Name:      ap.instance.Spec.Token.SecretKeyRef.Name,
-	}
-	token, err := secretKeyRef(ctx, r.Client, nn, ap.instance.Spec.Token.SecretKeyRef.Key)
-	if err != nil {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.AgentPool{}); err != nil {
 		return err
 	}
 
-	httpClient := tfc.DefaultConfig().HTTPClient
-	insecure := false
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.AgentPool{}, builder.WithPredicates(predicate.Or(genericPredicates())))
 
-	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
-		insecure, err = strconv.ParseBool(v)
-		if err != nil {
-			return err
-		}
-	}
-
-	if insecure {
-		ap.log.Info("Reconcile Workspace", "msg", "client configured to skip TLS certificate verifications")
-	}
-
-	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+	return watchReferences(mgr, b, &appv1alpha2.AgentPoolList{}).Complete(r)
+}
 
-	config := &tfc.Config{
-		Token:      token,
-		HTTPClient: httpClient,
-		Headers: http.Header{
-			"User-Agent": []string{version.UserAgent},
-		},
-	}
-	ap.tfClient.Client, err = tfc.NewClient(config)
+func (r *AgentPoolReconciler) getTerraformClient(ctx context.Context, ap *agentPoolInstance) error {
+	var err error
+	ap.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, ap.log, ap.instance.Namespace, ap.instance.Spec.Organization, ap.instance.Spec.Token, ap.instance.Spec.ConnectionRef)
 
 	return err
 }
@@ -185,6 +184,9 @@ func (r *AgentPoolReconciler) updateStatus(ctx context.Context, ap *agentPoolIns
 	if agentPool != nil {
 		ap.instance.Status.AgentPoolID = agentPool.ID
 	}
+	ap.instance.Status.Observation = nil
+	setSyncedCondition(&ap.instance.Status.Conditions, ap.instance.Generation, "", nil)
+
 	return r.Status().Update(ctx, &ap.instance)
 }
 
@@ -197,9 +199,7 @@ func (r *AgentPoolReconciler) createAgentPool(ctx context.Context, ap *agentPool
 		return nil, err
 	}
 
-	ap.instance.Status = appv1alpha2.AgentPoolStatus{
-		AgentPoolID: agentPool.ID,
-	}
+	ap.instance.Status.AgentPoolID = agentPool.ID
 
 	return agentPool, nil
 }
@@ -246,8 +246,6 @@ func (r *AgentPoolReconciler) reconcileAgentPool(ctx context.Context, ap *agentP
 		// Cannot call updateStatus method since it updated multiple fields and can break reconciliation logic.
 		//
 		// TODO:
-		// - Use conditions(https://maelvls.dev/kubernetes-conditions/)
-		// - Let Objects update their own status conditions
 		// - Simplify updateStatus method in a way it could be called anytime
 		if agentPool != nil && agentPool.ID != "" {
 			ap.instance.Status.AgentPoolID = agentPool.ID
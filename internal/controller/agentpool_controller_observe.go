@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// readObservedAgentPool reads the agent pool by the ID from the status or, if it is not known yet, by the name from the spec.
+func (r *AgentPoolReconciler) readObservedAgentPool(ctx context.Context, ap *agentPoolInstance) (*tfc.AgentPool, error) {
+	if ap.instance.Status.AgentPoolID != "" {
+		return r.readAgentPool(ctx, ap)
+	}
+
+	listOpts := &tfc.AgentPoolListOptions{
+		Query: ap.instance.Spec.Name,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		agentPools, err := ap.tfClient.Client.AgentPools.List(ctx, ap.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, agentPool := range agentPools.Items {
+			if agentPool.Name == ap.instance.Spec.Name {
+				return agentPool, nil
+			}
+		}
+		if agentPools.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = agentPools.NextPage
+	}
+
+	return nil, fmt.Errorf("agent pool %q not found", ap.instance.Spec.Name)
+}
+
+// observeAgentPool reads the agent pool and reports the differences from the spec without making any changes in HCP Terraform.
+// The agent deployment and autoscaling are not managed in the observe management mode.
+func (r *AgentPoolReconciler) observeAgentPool(ctx context.Context, ap *agentPoolInstance) error {
+	ap.log.Info("Observe Agent Pool", "msg", "observing agent pool")
+
+	// the agent pool is kept when the object is deleted in the observe management mode
+	if isDeletionCandidate(&ap.instance, agentPoolFinalizer) {
+		ap.log.Info("Observe Agent Pool", "msg", "object marked as deleted, the agent pool is kept in the observe management mode")
+		r.Recorder.Event(&ap.instance, corev1.EventTypeNormal, "ReconcileAgentPool", "Object marked as deleted, the agent pool is kept in the observe management mode")
+		return r.removeFinalizer(ctx, ap)
+	}
+
+	agentPool, err := r.readObservedAgentPool(ctx, ap)
+	if err != nil {
+		ap.log.Error(err, "Observe Agent Pool", "msg", "failed to read agent pool")
+		r.Recorder.Event(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentPool", "Failed to read agent pool")
+		return err
+	}
+	ap.instance.Status.AgentPoolID = agentPool.ID
+
+	var diff []string
+	if agentPool.Name != ap.instance.Spec.Name {
+		diff = append(diff, "spec.name")
+	}
+
+	tokens, err := ap.getTokens(ctx)
+	if err != nil {
+		ap.log.Error(err, "Observe Agent Pool", "msg", "failed to get agent tokens")
+		return err
+	}
+	specTokens := make([]string, len(ap.instance.Spec.AgentTokens))
+	for i, t := range ap.instance.Spec.AgentTokens {
+		specTokens[i] = t.Name
+	}
+	slices.Sort(specTokens)
+	if !slices.Equal(slices.Compact(specTokens), slices.Compact(slices.Sorted(maps.Values(tokens)))) {
+		diff = append(diff, "spec.agentTokens")
+	}
+
+	if len(diff) > 0 {
+		ap.log.Info("Observe Agent Pool", "msg", fmt.Sprintf("observed differences from the spec in agent pool ID %s: %s", agentPool.ID, strings.Join(diff, ", ")))
+		r.Recorder.Eventf(&ap.instance, corev1.EventTypeWarning, "ReconcileAgentPool", "Observed differences from the spec in agent pool ID %s: %s", agentPool.ID, strings.Join(diff, ", "))
+	}
+
+	ap.instance.Status.ObservedGeneration = ap.instance.Generation
+	ap.instance.Status.Observation = &appv1alpha2.ObservationStatus{
+		ObservedAt: metav1.Now(),
+		Diff:       diff,
+	}
+	setObservedCondition(&ap.instance.Status.Conditions, ap.instance.Generation, diff)
+
+	return r.Status().Update(ctx, &ap.instance)
+}
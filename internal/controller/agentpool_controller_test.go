@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestAgentPoolReconcile(t *testing.T) {
+	t.Parallel()
+
+	pool := &appv1alpha2.AgentPool{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.AgentPoolSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			AgentTokens: []*appv1alpha2.AgentAPIToken{
+				{Name: "first"},
+				{Name: "second"},
+			},
+			DeletionPolicy: appv1alpha2.AgentPoolDeletionPolicyDestroy,
+		},
+	}
+	f := newFakeAPI(t, pool)
+	r := &AgentPoolReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, pool)
+	requireSynced(t, pool.Status.Conditions)
+	assert.Contains(t, pool.Finalizers, agentPoolFinalizer)
+	p := f.server.AgentPool(pool.Status.AgentPoolID)
+	require.NotNil(t, p)
+	assert.Equal(t, "this", p.Name)
+	assert.Len(t, f.server.AgentTokens(p.ID), 2)
+	assert.Len(t, pool.Status.AgentTokens, 2)
+
+	key := types.NamespacedName{Namespace: fakeNamespace, Name: agentPoolOutputObjectName(pool.Name)}
+	secret := &corev1.Secret{}
+	require.NoError(t, f.client.Get(context.TODO(), key, secret))
+	assert.Contains(t, secret.Data, "first")
+	assert.Contains(t, secret.Data, "second")
+
+	pool.Spec.Name = "that"
+	pool.Spec.AgentTokens = pool.Spec.AgentTokens[:1]
+	pool.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), pool))
+	f.reconcile(t, r, pool)
+	requireSynced(t, pool.Status.Conditions)
+	assert.Equal(t, "that", f.server.AgentPool(p.ID).Name)
+	tokens := f.server.AgentTokens(p.ID)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "first", tokens[0].Description)
+	require.NoError(t, f.client.Get(context.TODO(), key, secret))
+	assert.NotContains(t, secret.Data, "second")
+
+	f.delete(t, pool)
+	f.reconcile(t, r, pool)
+	assert.False(t, f.exists(t, pool))
+	assert.Nil(t, f.server.AgentPool(p.ID))
+}
+
+func TestAgentPoolReconcileCreateKeepsConditions(t *testing.T) {
+	t.Parallel()
+
+	pool := &appv1alpha2.AgentPool{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.AgentPoolSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			DeletionPolicy: appv1alpha2.AgentPoolDeletionPolicyRetain,
+		},
+	}
+	// the finalizer is already set, thus the conditions set before the agent pool is created are not reloaded from the object
+	pool.Finalizers = []string{agentPoolFinalizer}
+	f := newFakeAPI(t, pool)
+	r := &AgentPoolReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, pool)
+	requireSynced(t, pool.Status.Conditions)
+	require.NotEmpty(t, pool.Status.AgentPoolID)
+	assert.True(t, meta.IsStatusConditionTrue(pool.Status.Conditions, appv1alpha2.ConditionTypeSpecValid))
+}
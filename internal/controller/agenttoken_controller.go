@@ -5,11 +5,7 @@ package controller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
-	"os"
-	"strconv"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
@@ -20,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -28,14 +25,14 @@ import (
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
 	"github.com/hashicorp/hcp-terraform-operator/internal/slice"
-	"github.com/hashicorp/hcp-terraform-operator/version"
 )
 
 // AgentTokenReconciler reconciles a AgentToken object
 type AgentTokenReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
 }
 
 type agentTokenInstance struct {
@@ -73,9 +70,14 @@ func (r *AgentTokenReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err := t.instance.ValidateSpec(); err != nil {
 		t.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
 		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&t.instance.Status.Conditions, t.instance.Generation, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
 		return doNotRequeue()
 	}
 	t.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&t.instance.Status.Conditions, t.instance.Generation, nil)
 
 	if needToAddFinalizer(&t.instance, agentTokenFinalizer) {
 		err := r.addFinalizer(ctx, &t.instance)
@@ -92,6 +94,10 @@ func (r *AgentTokenReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err != nil {
 		t.log.Error(err, "Agent Token Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Agent Token Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
@@ -99,6 +105,10 @@ func (r *AgentTokenReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err != nil {
 		t.log.Error(err, "Agent Token Controller", "msg", "Reconcile Agent Token")
 		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileAgentToken", "Failed to Reconcile Agent Token")
+		setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Agent Token Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 	t.log.Info("Agent Token Controller", "msg", "successfully reconcilied agent token")
@@ -114,49 +124,22 @@ func (r *AgentTokenReconciler) addFinalizer(ctx context.Context, instance *appv1
 }
 
 func (r *AgentTokenReconciler) getTerraformClient(ctx context.Context, t *agentTokenInstance) error {
-	nn := types.NamespacedName{
-		Namespace: t.instance.Namespace,
-		Name:      t.instance.Spec.Token.SecretKeyRef.Name,
-	}
-	token, err := secretKeyRef(ctx, r.Client, nn, t.instance.Spec.Token.SecretKeyRef.Key)
-	if err != nil {
-		return err
-	}
-
-	httpClient := tfc.DefaultConfig().HTTPClient
-	insecure := false
-
-	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
-		insecure, err = strconv.ParseBool(v)
-		if err != nil {
-			return err
-		}
-	}
-
-	if insecure {
-		t.log.Info("Reconcile Agent Token", "msg", "client configured to skip TLS certificate verifications")
-	}
-
-	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
-
-	config := &tfc.Config{
-		Token:      token,
-		HTTPClient: httpClient,
-		Headers: http.Header{
-			"User-Agent": []string{version.UserAgent},
-		},
-	}
-	t.tfClient.Client, err = tfc.NewClient(config)
+	var err error
+	t.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, t.log, t.instance.Namespace, t.instance.Spec.Organization, t.instance.Spec.Token, t.instance.Spec.ConnectionRef)
 
 	return err
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AgentTokenReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.AgentToken{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
-		Complete(r)
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.AgentToken{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.AgentToken{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.AgentTokenList{}).Complete(r)
 }
 
 func (r *AgentTokenReconciler) removeFinalizer(ctx context.Context, t *agentTokenInstance) error {
@@ -415,6 +398,7 @@ func (r *AgentTokenReconciler) reconcileToken(ctx context.Context, t *agentToken
 	}
 
 	t.instance.Status.ObservedGeneration = t.instance.Generation
+	setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, "", nil)
 
 	return r.Status().Update(ctx, &t.instance)
 }
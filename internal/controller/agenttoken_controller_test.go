@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestAgentTokenReconcile(t *testing.T) {
+	t.Parallel()
+
+	token := &appv1alpha2.AgentToken{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.AgentTokenSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			AgentPool:     appv1alpha2.AgentPoolRef{Name: "pool"},
+			AgentTokens: []appv1alpha2.AgentAPIToken{
+				{Name: "first"},
+				{Name: "second"},
+			},
+			SecretName:     "this-token",
+			DeletionPolicy: appv1alpha2.AgentTokenDeletionPolicyDestroy,
+		},
+	}
+	f := newFakeAPI(t, token)
+	pool := f.server.AddAgentPool(fakeOrganization, "pool")
+	r := &AgentTokenReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, token)
+	requireSynced(t, token.Status.Conditions)
+	assert.Contains(t, token.Finalizers, agentTokenFinalizer)
+	require.NotNil(t, token.Status.AgentPool)
+	assert.Equal(t, pool.ID, token.Status.AgentPool.ID)
+	assert.Len(t, f.server.AgentTokens(pool.ID), 2)
+	assert.Len(t, token.Status.AgentTokens, 2)
+
+	key := types.NamespacedName{Namespace: fakeNamespace, Name: token.Spec.SecretName}
+	secret := &corev1.Secret{}
+	require.NoError(t, f.client.Get(context.TODO(), key, secret))
+	assert.Contains(t, secret.Data, "first")
+	assert.Contains(t, secret.Data, "second")
+	assert.Equal(t, pool.ID, secret.Annotations["app.terraform.io/agent-pool-id"])
+
+	token.Spec.AgentTokens = token.Spec.AgentTokens[:1]
+	token.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), token))
+	f.reconcile(t, r, token)
+	requireSynced(t, token.Status.Conditions)
+	tokens := f.server.AgentTokens(pool.ID)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "first", tokens[0].Description)
+	require.NoError(t, f.client.Get(context.TODO(), key, secret))
+	assert.NotContains(t, secret.Data, "second")
+
+	f.delete(t, token)
+	f.reconcile(t, r, token)
+	assert.False(t, f.exists(t, token))
+	assert.Empty(t, f.server.AgentTokens(pool.ID))
+	assert.NotNil(t, f.server.AgentPool(pool.ID))
+}
+
+func TestAgentTokenReconcileManagementPolicy(t *testing.T) {
+	t.Parallel()
+
+	token := &appv1alpha2.AgentToken{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.AgentTokenSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			AgentTokens: []appv1alpha2.AgentAPIToken{
+				{Name: "first"},
+			},
+			SecretName:       "this-token",
+			ManagementPolicy: appv1alpha2.AgentTokenManagementPolicyMerge,
+			DeletionPolicy:   appv1alpha2.AgentTokenDeletionPolicyRetain,
+		},
+	}
+	f := newFakeAPI(t, token)
+	pool := f.server.AddAgentPool(fakeOrganization, "pool")
+	token.Spec.AgentPool = appv1alpha2.AgentPoolRef{ID: pool.ID}
+	require.NoError(t, f.client.Update(context.TODO(), token))
+	r := &AgentTokenReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, token)
+	requireSynced(t, token.Status.Conditions)
+	// another object manages the second token of the agent pool, the merge policy keeps it
+	second := &appv1alpha2.AgentToken{
+		ObjectMeta: objectMeta("that"),
+		Spec: appv1alpha2.AgentTokenSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			AgentPool:     appv1alpha2.AgentPoolRef{ID: pool.ID},
+			AgentTokens: []appv1alpha2.AgentAPIToken{
+				{Name: "second"},
+			},
+			SecretName:     "that-token",
+			DeletionPolicy: appv1alpha2.AgentTokenDeletionPolicyRetain,
+		},
+	}
+	require.NoError(t, f.client.Create(context.TODO(), second))
+	f.reconcile(t, r, second)
+	requireSynced(t, second.Status.Conditions)
+	assert.Len(t, f.server.AgentTokens(pool.ID), 2)
+
+	token.Spec.ManagementPolicy = appv1alpha2.AgentTokenManagementPolicyOwner
+	token.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), token))
+	f.reconcile(t, r, token)
+	requireSynced(t, token.Status.Conditions)
+	tokens := f.server.AgentTokens(pool.ID)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "first", tokens[0].Description)
+
+	f.delete(t, token)
+	f.reconcile(t, r, token)
+	assert.False(t, f.exists(t, token))
+	assert.Len(t, f.server.AgentTokens(pool.ID), 1)
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// setCondition adds a new or updates an existing condition of the given type.
+// The last transition time is updated only when the condition status changes.
+func setCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setSpecValidCondition sets the SpecValid condition based on the spec validation result.
+// An invalid spec also marks the object as not ready.
+func setSpecValidCondition(conditions *[]metav1.Condition, generation int64, err error) {
+	if err != nil {
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeSpecValid, metav1.ConditionFalse, appv1alpha2.ConditionReasonSpecInvalid, err.Error())
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeReady, metav1.ConditionFalse, appv1alpha2.ConditionReasonSpecInvalid, "Spec is invalid")
+		return
+	}
+	setCondition(conditions, generation, appv1alpha2.ConditionTypeSpecValid, metav1.ConditionTrue, appv1alpha2.ConditionReasonSpecValid, "Spec is valid")
+}
+
+// setSyncedCondition sets the Synced and Ready conditions based on the reconciliation result.
+// The reason is used only when the reconciliation failed.
+func setSyncedCondition(conditions *[]metav1.Condition, generation int64, reason string, err error) {
+	if err != nil {
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeSynced, metav1.ConditionFalse, reason, err.Error())
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeReady, metav1.ConditionFalse, reason, "Failed to reconcile with HCP Terraform")
+		return
+	}
+	setCondition(conditions, generation, appv1alpha2.ConditionTypeSynced, metav1.ConditionTrue, appv1alpha2.ConditionReasonReconcileSuccess, "Successfully reconciled with HCP Terraform")
+	setCondition(conditions, generation, appv1alpha2.ConditionTypeReady, metav1.ConditionTrue, appv1alpha2.ConditionReasonReconcileSuccess, "Resource is ready")
+}
+
+// setObservedCondition sets the Synced and Ready conditions based on the differences observed in the `observe` management mode.
+func setObservedCondition(conditions *[]metav1.Condition, generation int64, diff []string) {
+	if len(diff) > 0 {
+		message := fmt.Sprintf("Observed differences from the spec: %s", strings.Join(diff, ", "))
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeSynced, metav1.ConditionFalse, appv1alpha2.ConditionReasonObservedDiff, message)
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeReady, metav1.ConditionFalse, appv1alpha2.ConditionReasonObservedDiff, message)
+		return
+	}
+	setCondition(conditions, generation, appv1alpha2.ConditionTypeSynced, metav1.ConditionTrue, appv1alpha2.ConditionReasonObserved, "Observed resource matches the spec")
+	setCondition(conditions, generation, appv1alpha2.ConditionTypeReady, metav1.ConditionTrue, appv1alpha2.ConditionReasonObserved, "Resource is ready")
+}
+
+// setRunSucceededCondition sets the RunSucceeded condition based on the given run status.
+// The condition is not set when there are no runs.
+func setRunSucceededCondition(conditions *[]metav1.Condition, generation int64, run *appv1alpha2.RunStatus) {
+	if run == nil || run.ID == "" {
+		return
+	}
+	switch {
+	case run.RunApplied():
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeRunSucceeded, metav1.ConditionTrue, appv1alpha2.ConditionReasonRunSucceeded, fmt.Sprintf("Run %s finished with status %s", run.ID, run.Status))
+	case run.RunCompleted():
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeRunSucceeded, metav1.ConditionFalse, appv1alpha2.ConditionReasonRunFailed, fmt.Sprintf("Run %s finished with status %s", run.ID, run.Status))
+	default:
+		setCondition(conditions, generation, appv1alpha2.ConditionTypeRunSucceeded, metav1.ConditionUnknown, appv1alpha2.ConditionReasonRunInProgress, fmt.Sprintf("Run %s is in status %s", run.ID, run.Status))
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestSetSpecValidCondition(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Valid", func(t *testing.T) {
+		conditions := []metav1.Condition{}
+		setSpecValidCondition(&conditions, 2, nil)
+		c := meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeSpecValid)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionTrue, c.Status)
+		assert.Equal(t, int64(2), c.ObservedGeneration)
+		assert.Nil(t, meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeReady))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		conditions := []metav1.Condition{}
+		setSpecValidCondition(&conditions, 3, fmt.Errorf("spec.name is invalid"))
+		c := meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeSpecValid)
+		assert.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionFalse, c.Status)
+		assert.Equal(t, appv1alpha2.ConditionReasonSpecInvalid, c.Reason)
+		assert.Equal(t, "spec.name is invalid", c.Message)
+		assert.True(t, meta.IsStatusConditionFalse(conditions, appv1alpha2.ConditionTypeReady))
+	})
+}
+
+func TestSetSyncedCondition(t *testing.T) {
+	t.Parallel()
+
+	conditions := []metav1.Condition{}
+	setSyncedCondition(&conditions, 1, appv1alpha2.ConditionReasonReconcileFailed, fmt.Errorf("unauthorized"))
+	c := meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeSynced)
+	assert.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, appv1alpha2.ConditionReasonReconcileFailed, c.Reason)
+	assert.Equal(t, "unauthorized", c.Message)
+	assert.True(t, meta.IsStatusConditionFalse(conditions, appv1alpha2.ConditionTypeReady))
+
+	setSyncedCondition(&conditions, 2, "", nil)
+	c = meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeSynced)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, appv1alpha2.ConditionReasonReconcileSuccess, c.Reason)
+	assert.Equal(t, int64(2), c.ObservedGeneration)
+	assert.True(t, meta.IsStatusConditionTrue(conditions, appv1alpha2.ConditionTypeReady))
+}
+
+func TestSetRunSucceededCondition(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		run      *appv1alpha2.RunStatus
+		expected metav1.ConditionStatus
+		reason   string
+	}{
+		"Applied": {
+			run:      &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunApplied)},
+			expected: metav1.ConditionTrue,
+			reason:   appv1alpha2.ConditionReasonRunSucceeded,
+		},
+		"PlannedAndFinished": {
+			run:      &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunPlannedAndFinished)},
+			expected: metav1.ConditionTrue,
+			reason:   appv1alpha2.ConditionReasonRunSucceeded,
+		},
+		"Errored": {
+			run:      &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunErrored)},
+			expected: metav1.ConditionFalse,
+			reason:   appv1alpha2.ConditionReasonRunFailed,
+		},
+		"Discarded": {
+			run:      &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunDiscarded)},
+			expected: metav1.ConditionFalse,
+			reason:   appv1alpha2.ConditionReasonRunFailed,
+		},
+		"Planning": {
+			run:      &appv1alpha2.RunStatus{ID: "run-1", Status: string(tfc.RunPlanning)},
+			expected: metav1.ConditionUnknown,
+			reason:   appv1alpha2.ConditionReasonRunInProgress,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+			conditions := []metav1.Condition{}
+			setRunSucceededCondition(&conditions, 1, c.run)
+			rc := meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeRunSucceeded)
+			assert.NotNil(t, rc)
+			assert.Equal(t, c.expected, rc.Status)
+			assert.Equal(t, c.reason, rc.Reason)
+		})
+	}
+
+	t.Run("NoRun", func(t *testing.T) {
+		t.Parallel()
+		conditions := []metav1.Condition{}
+		setRunSucceededCondition(&conditions, 1, nil)
+		assert.Empty(t, conditions)
+	})
+}
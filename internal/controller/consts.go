@@ -5,14 +5,18 @@ package controller
 
 import (
 	"time"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
 // SHARED CONSTANTS
 const (
 	annotationPaused = "app.terraform.io/paused"
 	labelHasChanged  = "app.terraform.io/has-changed"
-	MetaTrue         = "true"
-	metaFalse        = "false"
+	// labelOutputTarget marks the ConfigMaps and Secrets that output targets are rendered into.
+	labelOutputTarget = "app.terraform.io/output-target"
+	MetaTrue          = "true"
+	metaFalse         = "false"
 
 	InitPageNumber  = 1
 	MaxPageSize     = 100
@@ -69,6 +73,16 @@ output "{{ $o.Name }}" {
 `
 )
 
+// ORGANIZATION RUN TASK CONTROLLER'S CONSTANTS
+const (
+	organizationRunTaskFinalizer = "organizationruntask.app.terraform.io/finalizer"
+)
+
+// POLICY SET CONTROLLER'S CONSTANTS
+const (
+	policySetFinalizer = "policyset.app.terraform.io/finalizer"
+)
+
 // PROJECT CONTROLLER'S CONSTANTS
 const (
 	projectFinalizer = "project.app.terraform.io/finalizer"
@@ -79,17 +93,48 @@ const (
 	runsCollectorFinalizer = "runscollector.app.terraform.io/finalizer"
 )
 
+// TEAM CONTROLLER'S CONSTANTS
+const (
+	teamFinalizer = "team.app.terraform.io/finalizer"
+)
+
+// VARIABLE SET CONTROLLER'S CONSTANTS
+const (
+	variableSetFinalizer = "variableset.app.terraform.io/finalizer"
+)
+
 // WORKSPACE CONTROLLER'S CONSTANTS
 const (
 	workspaceFinalizerAlpha1 = "finalizer.workspace.app.terraform.io"
 	workspaceFinalizer       = "workspace.app.terraform.io/finalizer"
 
-	WorkspaceAnnotationRunNew              = "workspace.app.terraform.io/run-new"
-	WorkspaceAnnotationRunType             = "workspace.app.terraform.io/run-type"
-	WorkspaceAnnotationRunTerraformVersion = "workspace.app.terraform.io/run-terraform-version"
+	driftDetectionRunMessage     = "Drift detection triggered by HCP Terraform Operator"
+	driftDetectionApplyMessage   = "Drift remediation triggered by HCP Terraform Operator"
+	driftDetectionDiscardComment = "Drift detected, discarded by HCP Terraform Operator"
+
+	scheduledRunMessage = "Scheduled run triggered by HCP Terraform Operator"
+)
 
-	RunTypePlan    = "plan"
-	RunTypeApply   = "apply"
-	RunTypeRefresh = "refresh"
-	RunTypeDefault = RunTypePlan
+// Deprecated aliases of the Workspace annotations and run types that moved to the api/v1alpha2 package.
+const (
+	// Deprecated: Use appv1alpha2.WorkspaceAnnotationRunNew instead.
+	WorkspaceAnnotationRunNew = appv1alpha2.WorkspaceAnnotationRunNew
+	// Deprecated: Use appv1alpha2.WorkspaceAnnotationRunType instead.
+	WorkspaceAnnotationRunType = appv1alpha2.WorkspaceAnnotationRunType
+	// Deprecated: Use appv1alpha2.WorkspaceAnnotationRunTerraformVersion instead.
+	WorkspaceAnnotationRunTerraformVersion = appv1alpha2.WorkspaceAnnotationRunTerraformVersion
+
+	// Deprecated: Use appv1alpha2.RunTypePlan instead.
+	RunTypePlan = appv1alpha2.RunTypePlan
+	// Deprecated: Use appv1alpha2.RunTypeApply instead.
+	RunTypeApply = appv1alpha2.RunTypeApply
+	// Deprecated: Use appv1alpha2.RunTypeRefresh instead.
+	RunTypeRefresh = appv1alpha2.RunTypeRefresh
+	// Deprecated: Use appv1alpha2.RunTypeDefault instead.
+	RunTypeDefault = appv1alpha2.RunTypeDefault
+)
+
+// WORKSPACE RUN CONTROLLER'S CONSTANTS
+const (
+	workspaceRunFinalizer = "workspacerun.app.terraform.io/finalizer"
 )
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/tfcfake"
+)
+
+const (
+	fakeNamespace    = "default"
+	fakeOrganization = "kubernetes-operator"
+	// fakeConnection is the name of the Connection that points to the fake HCP Terraform API.
+	fakeConnection = "fake"
+)
+
+// fakeAPI runs reconcilers offline against a fake HCP Terraform API and a fake Kubernetes API.
+// It is backed by the controller-runtime fake client only, not by envtest: the Kubernetes API server does not run,
+// thus CRD schema and CEL validations, admission webhooks, garbage collection, and UIDs and creation timestamps
+// of objects are not covered. The e2e tests cover them against a real cluster.
+type fakeAPI struct {
+	server   *tfcfake.Server
+	client   client.Client
+	recorder *record.FakeRecorder
+}
+
+// newFakeAPI starts a fake HCP Terraform API with a single organization and builds a fake Kubernetes client
+// with given objects, a Connection to the fake API, and the Secret with its token.
+func newFakeAPI(t *testing.T, objs ...client.Object) *fakeAPI {
+	t.Helper()
+
+	server := tfcfake.NewServer()
+	t.Cleanup(server.Close)
+	server.Token = "token"
+	server.AddOrganization(fakeOrganization)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: fakeNamespace},
+			Data:       map[string][]byte{"token": []byte(server.Token)},
+		},
+		&appv1alpha2.Connection{
+			ObjectMeta: metav1.ObjectMeta{Name: fakeConnection, Namespace: fakeNamespace},
+			Spec: appv1alpha2.ConnectionSpec{
+				Address: server.URL(),
+				Token:   secretToken("token"),
+			},
+		},
+	)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(
+			&appv1alpha2.AgentPool{},
+			&appv1alpha2.AgentToken{},
+			&appv1alpha2.Module{},
+			&appv1alpha2.OrganizationRunTask{},
+			&appv1alpha2.PolicySet{},
+			&appv1alpha2.Project{},
+			&appv1alpha2.RunsCollector{},
+			&appv1alpha2.Team{},
+			&appv1alpha2.VariableSet{},
+			&appv1alpha2.Workspace{},
+			&appv1alpha2.WorkspaceRun{},
+		).
+		Build()
+
+	return &fakeAPI{
+		server:   server,
+		client:   c,
+		recorder: record.NewFakeRecorder(1024),
+	}
+}
+
+// connectionRef returns a reference to the Connection that points to the fake HCP Terraform API.
+func connectionRef() *corev1.LocalObjectReference {
+	return &corev1.LocalObjectReference{Name: fakeConnection}
+}
+
+// objectMeta returns the metadata of a new object with a given name.
+func objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: fakeNamespace, Generation: 1}
+}
+
+// reconcile runs a given reconciler for the object with a given name and returns the object afterwards.
+func (f *fakeAPI) reconcile(t *testing.T, r reconcile.Reconciler, obj client.Object) {
+	t.Helper()
+
+	key := types.NamespacedName{Namespace: fakeNamespace, Name: obj.GetName()}
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+	require.NoError(t, err)
+	if err := f.client.Get(context.TODO(), key, obj); err != nil {
+		require.True(t, client.IgnoreNotFound(err) == nil, err)
+	}
+}
+
+// delete marks the object with a given name as deleted.
+func (f *fakeAPI) delete(t *testing.T, obj client.Object) {
+	t.Helper()
+
+	require.NoError(t, f.client.Delete(context.TODO(), obj))
+}
+
+// exists reports whether a given object still exists in the fake Kubernetes API.
+func (f *fakeAPI) exists(t *testing.T, obj client.Object) bool {
+	t.Helper()
+
+	err := f.client.Get(context.TODO(), client.ObjectKeyFromObject(obj), obj)
+	require.True(t, client.IgnoreNotFound(err) == nil, err)
+
+	return err == nil
+}
+
+// requireSynced fails the test unless a given status has the Synced condition set to true.
+func requireSynced(t *testing.T, conditions []metav1.Condition) {
+	t.Helper()
+
+	c := meta.FindStatusCondition(conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c, "condition %s is not set", appv1alpha2.ConditionTypeSynced)
+	require.Equal(t, metav1.ConditionTrue, c.Status, c.Message)
+}
@@ -8,10 +8,20 @@ import (
 )
 
 var (
-	AgentPoolSyncPeriod     time.Duration
-	AgentTokenSyncPeriod    time.Duration
-	ModuleSyncPeriod        time.Duration
-	ProjectSyncPeriod       time.Duration
-	RunsCollectorSyncPeriod time.Duration
-	WorkspaceSyncPeriod     time.Duration
+	AgentPoolSyncPeriod           time.Duration
+	AgentTokenSyncPeriod          time.Duration
+	ModuleSyncPeriod              time.Duration
+	OrganizationRunTaskSyncPeriod time.Duration
+	PolicySetSyncPeriod           time.Duration
+	ProjectSyncPeriod             time.Duration
+	RunsCollectorSyncPeriod       time.Duration
+	TeamSyncPeriod                time.Duration
+	VariableSetSyncPeriod         time.Duration
+	WorkspaceSyncPeriod           time.Duration
+	WorkspaceRunSyncPeriod        time.Duration
+)
+
+var (
+	APIRateLimit float64
+	APIRateBurst int
 )
@@ -18,6 +18,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
 func doNotRequeue() (reconcile.Result, error) {
@@ -100,6 +102,28 @@ func secretKeyRef(ctx context.Context, c client.Client, nn types.NamespacedName,
 	return "", fmt.Errorf("unable to find key=%q in secret=%q namespace=%q", key, nn.Name, nn.Namespace)
 }
 
+// variableValue returns the value of a given variable. The value comes either from the variable itself
+// or from a Kubernetes ConfigMap or Secret in a given namespace.
+func variableValue(ctx context.Context, c client.Client, namespace string, v appv1alpha2.Variable) (string, error) {
+	if v.ValueFrom == nil {
+		return v.Value, nil
+	}
+
+	objectKey := types.NamespacedName{
+		Namespace: namespace,
+	}
+	if cm := v.ValueFrom.ConfigMapKeyRef; cm != nil {
+		objectKey.Name = cm.Name
+		return configMapKeyRef(ctx, c, objectKey, cm.Key)
+	}
+	if s := v.ValueFrom.SecretKeyRef; s != nil {
+		objectKey.Name = s.Name
+		return secretKeyRef(ctx, c, objectKey, s.Key)
+	}
+
+	return v.Value, nil
+}
+
 // useRunsEndpoint determines whether to use the Runs endpoint(available since v202409-1) based on the TFE version.
 func useRunsEndpoint(version string) (bool, error) {
 	// For versions 1.0.0 and 1.0.1 version string will be empty.
@@ -122,3 +146,30 @@ func useRunsEndpoint(version string) (bool, error) {
 
 	return false, fmt.Errorf("malformed TFE version %s", version)
 }
+
+// getProjectIDByName returns ID of the project with a given name.
+func getProjectIDByName(ctx context.Context, c *tfc.Client, organization, name string) (string, error) {
+	listOpts := &tfc.ProjectListOptions{
+		Name: name,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		projects, err := c.Projects.List(ctx, organization, listOpts)
+		if err != nil {
+			return "", err
+		}
+		for _, p := range projects.Items {
+			if p.Name == name {
+				return p.ID, nil
+			}
+		}
+		if projects.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = projects.NextPage
+	}
+
+	return "", fmt.Errorf("project ID not found for project name %q", name)
+}
@@ -41,9 +41,62 @@ var (
 	// - Add a metric to track associated Workspaces.
 )
 
+// Workspace Metrics
+var (
+	MetricWorkspaceDrift = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "hcp_tf_workspace_drift",
+			Help: "HCP Terraform - Whether the latest drift detection run found drift in the Workspace",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"workspace_id",
+		},
+	)
+)
+
+// API Metrics
+var (
+	MetricAPIThrottledRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hcp_tf_api_throttled_requests_total",
+			Help: "HCP Terraform - Total number of API requests rejected with 429 Too Many Requests",
+		},
+		[]string{
+			"endpoint",
+			"organization",
+		},
+	)
+	MetricAPIRateLimitedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hcp_tf_api_rate_limited_requests_total",
+			Help: "HCP Terraform - Total number of API requests delayed by the Operator rate limiter",
+		},
+		[]string{
+			"endpoint",
+			"organization",
+		},
+	)
+	MetricAPIRateLimiterWaitSeconds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "hcp_tf_api_rate_limiter_wait_seconds_total",
+			Help: "HCP Terraform - Total time in seconds API requests were delayed by the Operator rate limiter",
+		},
+		[]string{
+			"endpoint",
+			"organization",
+		},
+	)
+)
+
 func RegisterMetrics() {
 	metrics.Registry.MustRegister(
 		MetricRuns,
 		MetricRunsTotal,
+		MetricWorkspaceDrift,
+		MetricAPIThrottledRequests,
+		MetricAPIRateLimitedRequests,
+		MetricAPIRateLimiterWaitSeconds,
 	)
 }
@@ -6,11 +6,8 @@ package controller
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
 	"os"
-	"strconv"
 	"text/template"
 
 	"github.com/go-logr/logr"
@@ -18,24 +15,25 @@ import (
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
-	"github.com/hashicorp/hcp-terraform-operator/version"
 )
 
 // ModuleReconciler reconciles a Module object
 type ModuleReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
 }
 
 type moduleInstance struct {
@@ -62,8 +60,8 @@ var (
 // +kubebuilder:rbac:groups=app.terraform.io,resources=modules/finalizers,verbs=update
 // +kubebuilder:rbac:groups=app.terraform.io,resources=modules/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;list;update;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;list;update;watch;delete
 
 func (r *ModuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	m := moduleInstance{}
@@ -92,9 +90,14 @@ func (r *ModuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err := m.instance.ValidateSpec(); err != nil {
 		m.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
 		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&m.instance.Status.Conditions, m.instance.Generation, err)
+		if err := r.Status().Update(ctx, &m.instance); err != nil {
+			m.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
 		return doNotRequeue()
 	}
 	m.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&m.instance.Status.Conditions, m.instance.Generation, nil)
 
 	if needToAddFinalizer(&m.instance, moduleFinalizer) {
 		err := r.addFinalizer(ctx, &m.instance)
@@ -111,13 +114,37 @@ func (r *ModuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err != nil {
 		m.log.Error(err, "Module Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&m.instance.Status.Conditions, m.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &m.instance); err != nil {
+			m.log.Error(err, "Module Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
+	if m.instance.Spec.ManagementMode == appv1alpha2.ManagementModeObserve {
+		err = r.observeModule(ctx, &m)
+		if err != nil {
+			m.log.Error(err, "Module Controller", "msg", "observe module")
+			r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileModule", "Failed to observe module")
+			setSyncedCondition(&m.instance.Status.Conditions, m.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+			if err := r.Status().Update(ctx, &m.instance); err != nil {
+				m.log.Error(err, "Module Controller", "msg", "failed to update status conditions")
+			}
+			return requeueAfter(requeueInterval)
+		}
+		m.log.Info("Module Controller", "msg", "successfully observed module")
+
+		return requeueAfter(ModuleSyncPeriod)
+	}
+
 	err = r.reconcileModule(ctx, &m)
 	if err != nil {
 		m.log.Error(err, "Module Controller", "msg", "reconcile module")
 		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileModule", "Failed to reconcile module")
+		setSyncedCondition(&m.instance.Status.Conditions, m.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &m.instance); err != nil {
+			m.log.Error(err, "Module Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
@@ -143,10 +170,14 @@ func (r *ModuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ModuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.Module{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
-		Complete(r)
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.Module{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.Module{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.ModuleList{}).Complete(r)
 }
 
 func (r *ModuleReconciler) updateStatusCV(ctx context.Context, instance *appv1alpha2.Module, workspace *tfc.Workspace, cv *tfc.ConfigurationVersion) error {
@@ -160,17 +191,36 @@ func (r *ModuleReconciler) updateStatusCV(ctx context.Context, instance *appv1al
 		// Erase the run status since we proceeding with a new config version
 		instance.Status.Run = nil
 	}
+	setSyncedCondition(&instance.Status.Conditions, instance.Generation, "", nil)
+	// The module is not ready until the run triggered by the new configuration version completes.
+	setCondition(&instance.Status.Conditions, instance.Generation, appv1alpha2.ConditionTypeReady, metav1.ConditionFalse, appv1alpha2.ConditionReasonRunInProgress, "Waiting for the configuration version to be uploaded")
 
 	return r.Status().Update(ctx, instance)
 }
 
-func (r *ModuleReconciler) updateStatusRun(ctx context.Context, instance *appv1alpha2.Module, workspace *tfc.Workspace, run *tfc.Run) error {
+func (r *ModuleReconciler) updateStatusRun(ctx context.Context, m *moduleInstance, workspace *tfc.Workspace, run *tfc.Run) error {
+	instance := &m.instance
+	var summary *appv1alpha2.PlanSummary
+	if instance.Status.Run != nil {
+		summary = instance.Status.Run.Summary
+	}
+	summary, err := readPlanSummary(ctx, m.log, m.tfClient.Client, instance.Spec.Organization, workspace.Name, run, summary)
+	if err != nil {
+		return err
+	}
+
 	instance.Status.WorkspaceID = workspace.ID
 	instance.Status.ObservedGeneration = instance.Generation
 	instance.Status.Run = &appv1alpha2.RunStatus{
 		ID:                   run.ID,
 		Status:               string(run.Status),
 		ConfigurationVersion: run.ConfigurationVersion.ID,
+		Summary:              summary,
+	}
+	setSyncedCondition(&instance.Status.Conditions, instance.Generation, "", nil)
+	setRunSucceededCondition(&instance.Status.Conditions, instance.Generation, instance.Status.Run)
+	if !instance.Status.Run.RunCompleted() {
+		setCondition(&instance.Status.Conditions, instance.Generation, appv1alpha2.ConditionTypeReady, metav1.ConditionFalse, appv1alpha2.ConditionReasonRunInProgress, fmt.Sprintf("Waiting for the run %s to complete", run.ID))
 	}
 
 	return r.Status().Update(ctx, instance)
@@ -179,6 +229,9 @@ func (r *ModuleReconciler) updateStatusRun(ctx context.Context, instance *appv1a
 func (r *ModuleReconciler) updateStatusOutputs(ctx context.Context, instance *appv1alpha2.Module, workspace *tfc.Workspace) error {
 	instance.Status.WorkspaceID = workspace.ID
 	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.Observation = nil
+	setSyncedCondition(&instance.Status.Conditions, instance.Generation, "", nil)
+	setRunSucceededCondition(&instance.Status.Conditions, instance.Generation, instance.Status.Run)
 
 	return r.Status().Update(ctx, instance)
 }
@@ -196,39 +249,8 @@ func (r *ModuleReconciler) updateStatusDestroy(ctx context.Context, instance *ap
 }
 
 func (r *ModuleReconciler) getTerraformClient(ctx context.Context, m *moduleInstance) error {
-	nn := types.NamespacedName{
-		Namespace: m.instance.Namespace,
-		Name:      m.instance.Spec.Token.SecretKeyRef.Name,
-	}
-	token, err := secretKeyRef(ctx, r.Client, nn, m.instance.Spec.Token.SecretKeyRef.Key)
-	if err != nil {
-		return err
-	}
-
-	httpClient := tfc.DefaultConfig().HTTPClient
-	insecure := false
-
-	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
-		insecure, err = strconv.ParseBool(v)
-		if err != nil {
-			return err
-		}
-	}
-
-	if insecure {
-		m.log.Info("Reconcile Module", "msg", "client configured to skip TLS certificate verifications")
-	}
-
-	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
-
-	config := &tfc.Config{
-		Token:      token,
-		HTTPClient: httpClient,
-		Headers: http.Header{
-			"User-Agent": []string{version.UserAgent},
-		},
-	}
-	m.tfClient.Client, err = tfc.NewClient(config)
+	var err error
+	m.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, m.log, m.instance.Namespace, m.instance.Spec.Organization, m.instance.Spec.Token, m.instance.Spec.ConnectionRef)
 
 	return err
 }
@@ -416,7 +438,7 @@ func (r *ModuleReconciler) reconcileModule(ctx context.Context, m *moduleInstanc
 		// It can take a while to proceed with a new run
 		// To unblock a worker we return the object back to the queue
 		// and validate the run status during the next reconciliation
-		return r.updateStatusRun(ctx, &m.instance, workspace, run)
+		return r.updateStatusRun(ctx, m, workspace, run)
 	}
 
 	// checks if a new version of the Run is finished
@@ -428,7 +450,7 @@ func (r *ModuleReconciler) reconcileModule(ctx context.Context, m *moduleInstanc
 			return err
 		}
 		m.log.Info("Reconcile Run", "msg", fmt.Sprintf("successfully got the run status: %s", run.Status))
-		if err := r.updateStatusRun(ctx, &m.instance, workspace, run); err != nil {
+		if err := r.updateStatusRun(ctx, m, workspace, run); err != nil {
 			return err
 		}
 	}
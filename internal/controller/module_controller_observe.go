@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// getWorkspaceVariableNames returns the names of the variables of a given workspace.
+func (r *ModuleReconciler) getWorkspaceVariableNames(ctx context.Context, m *moduleInstance, workspaceID string) (map[string]struct{}, error) {
+	names := make(map[string]struct{})
+
+	listOpts := &tfc.VariableListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		variables, err := m.tfClient.Client.Variables.List(ctx, workspaceID, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range variables.Items {
+			names[v.Key] = struct{}{}
+		}
+		if variables.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = variables.NextPage
+	}
+
+	return names, nil
+}
+
+// observeModule reads the workspace of the module and reports the differences from the spec without making any changes in HCP Terraform.
+// The module is neither uploaded nor executed in the observe management mode.
+func (r *ModuleReconciler) observeModule(ctx context.Context, m *moduleInstance) error {
+	m.log.Info("Observe Module", "msg", "observing module")
+
+	// no destroy run is triggered when the object is deleted in the observe management mode
+	if isDeletionCandidate(&m.instance, moduleFinalizer) {
+		m.log.Info("Observe Module", "msg", "object marked as deleted, no destroy run is triggered in the observe management mode")
+		r.Recorder.Event(&m.instance, corev1.EventTypeNormal, "ReconcileModule", "Object marked as deleted, no destroy run is triggered in the observe management mode")
+		return r.removeFinalizer(ctx, m)
+	}
+
+	workspace, err := r.getWorkspace(ctx, m)
+	if err != nil {
+		m.log.Error(err, "Observe Module", "msg", "failed to get workspace")
+		r.Recorder.Event(&m.instance, corev1.EventTypeWarning, "ReconcileModule", "Failed to get workspace")
+		return err
+	}
+	m.instance.Status.WorkspaceID = workspace.ID
+
+	var diff []string
+	// the module code is only known to match the spec if the current configuration version was uploaded by the Operator
+	cv := m.instance.Status.ConfigurationVersion
+	if cv == nil || workspace.CurrentConfigurationVersion == nil || workspace.CurrentConfigurationVersion.ID != cv.ID {
+		diff = append(diff, "spec.module")
+	}
+
+	variables, err := r.getWorkspaceVariableNames(ctx, m, workspace.ID)
+	if err != nil {
+		m.log.Error(err, "Observe Module", "msg", "failed to get workspace variables")
+		return err
+	}
+	for _, v := range m.instance.Spec.Variables {
+		if _, ok := variables[v.Name]; !ok {
+			diff = append(diff, "spec.variables")
+			break
+		}
+	}
+
+	if len(diff) > 0 {
+		m.log.Info("Observe Module", "msg", fmt.Sprintf("observed differences from the spec in workspace ID %s: %s", workspace.ID, strings.Join(diff, ", ")))
+		r.Recorder.Eventf(&m.instance, corev1.EventTypeWarning, "ReconcileModule", "Observed differences from the spec in workspace ID %s: %s", workspace.ID, strings.Join(diff, ", "))
+	}
+
+	// The observed generation is not updated, it tracks the generation of the uploaded module.
+	m.instance.Status.Observation = &appv1alpha2.ObservationStatus{
+		ObservedAt: metav1.Now(),
+		Diff:       diff,
+	}
+	setObservedCondition(&m.instance.Status.Conditions, m.instance.Generation, diff)
+
+	return r.Status().Update(ctx, &m.instance)
+}
@@ -147,6 +147,12 @@ func (r *ModuleReconciler) setOutputs(ctx context.Context, m *moduleInstance) er
 	}
 	m.log.Info("Reconcile Module Outputs", "mgs", fmt.Sprintf("secret create or update result: %s", ur))
 
+	// render output targets
+	if err := setOutputTargets(ctx, r.Client, r.Scheme, &m.instance, m.instance.Spec.OutputTargets, outputs, labels); err != nil {
+		m.log.Error(err, "Reconcile Module Outputs", "msg", "failed to render output targets")
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestModuleReconcile(t *testing.T) {
+	t.Parallel()
+
+	module := &appv1alpha2.Module{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.ModuleSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Module: &appv1alpha2.ModuleSource{
+				Source:  "app.terraform.io/kubernetes-operator/module/random",
+				Version: "1.0.0",
+			},
+			Workspace: &appv1alpha2.ModuleWorkspace{Name: "this"},
+			Outputs: []appv1alpha2.ModuleOutput{
+				{Name: "plain"},
+				{Name: "secret", Sensitive: true},
+			},
+		},
+	}
+	f := newFakeAPI(t, module)
+	r := &ModuleReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(context.TODO(), fakeOrganization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+
+	// The first reconciliation uploads the module, the second one starts a new run.
+	f.reconcile(t, r, module)
+	assert.Contains(t, module.Finalizers, moduleFinalizer)
+	assert.Equal(t, ws.ID, module.Status.WorkspaceID)
+	require.NotNil(t, module.Status.ConfigurationVersion)
+	f.reconcile(t, r, module)
+	assert.Equal(t, string(tfc.ConfigurationUploaded), module.Status.ConfigurationVersion.Status)
+	require.NotNil(t, module.Status.Run)
+	run := f.server.Run(module.Status.Run.ID)
+	require.NotNil(t, run)
+	assert.Equal(t, module.Status.ConfigurationVersion.ID, run.ConfigurationVersion.ID)
+
+	require.NoError(t, f.server.SetRunStatus(run.ID, tfc.RunApplied))
+	require.NoError(t, f.server.SetOutputs(ws.ID, []*tfc.StateVersionOutput{
+		{Name: "plain", Value: "value"},
+		{Name: "secret", Value: "s3cr3t", Sensitive: true},
+	}))
+	f.reconcile(t, r, module)
+	assert.Equal(t, string(tfc.RunApplied), module.Status.Run.Status)
+	require.NotNil(t, module.Status.Run.Summary)
+	assert.Equal(t, f.server.Run(run.ID).Plan.ID, module.Status.Run.Summary.PlanID)
+	assert.Equal(t, fmt.Sprintf("%s/app/%s/workspaces/%s/runs/%s", f.server.URL(), fakeOrganization, ws.Name, run.ID), module.Status.Run.Summary.RunURL)
+
+	key := types.NamespacedName{Namespace: fakeNamespace, Name: moduleOutputObjectName(module.Name)}
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, f.client.Get(context.TODO(), key, cm))
+	assert.Equal(t, "value", cm.Data["plain"])
+	secret := &corev1.Secret{}
+	require.NoError(t, f.client.Get(context.TODO(), key, secret))
+	assert.Equal(t, []byte("s3cr3t"), secret.Data["secret"])
+}
@@ -0,0 +1,357 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// OrganizationRunTaskReconciler reconciles a OrganizationRunTask object
+type OrganizationRunTaskReconciler struct {
+	client.Client
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
+}
+
+type organizationRunTaskInstance struct {
+	instance appv1alpha2.OrganizationRunTask
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizationruntasks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizationruntasks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=organizationruntasks/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *OrganizationRunTaskReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	t := organizationRunTaskInstance{}
+
+	t.log = log.Log.WithValues("organizationruntask", req.NamespacedName)
+	t.log.Info("Organization Run Task Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &t.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			t.log.Info("Organization Run Task Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		t.log.Error(err, "Organization Run Task Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := t.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		t.log.Info("Organization Run Task Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	t.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := t.instance.ValidateSpec(); err != nil {
+		t.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&t.instance.Status.Conditions, t.instance.Generation, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
+		return doNotRequeue()
+	}
+	t.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&t.instance.Status.Conditions, t.instance.Generation, nil)
+
+	if needToAddFinalizer(&t.instance, organizationRunTaskFinalizer) {
+		err := r.addFinalizer(ctx, &t.instance)
+		if err != nil {
+			t.log.Error(err, "Organization Run Task Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", organizationRunTaskFinalizer))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", organizationRunTaskFinalizer)
+			return requeueOnErr(err)
+		}
+		t.log.Info("Organization Run Task Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", organizationRunTaskFinalizer))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", organizationRunTaskFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &t)
+	if err != nil {
+		t.log.Error(err, "Organization Run Task Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Organization Run Task Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileRunTask(ctx, &t)
+	if err != nil {
+		t.log.Error(err, "Organization Run Task Controller", "msg", "reconcile run task")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to reconcile run task")
+		setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Organization Run Task Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+	t.log.Info("Organization Run Task Controller", "msg", "successfully reconcilied run task")
+	r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileRunTask", "Successfully reconcilied run task ID %s", t.instance.Status.ID)
+
+	return requeueAfter(OrganizationRunTaskSyncPeriod)
+}
+
+func (r *OrganizationRunTaskReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.OrganizationRunTask) error {
+	controllerutil.AddFinalizer(instance, organizationRunTaskFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *OrganizationRunTaskReconciler) getTerraformClient(ctx context.Context, t *organizationRunTaskInstance) error {
+	var err error
+	t.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, t.log, t.instance.Namespace, t.instance.Spec.Organization, t.instance.Spec.Token, t.instance.Spec.ConnectionRef)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *OrganizationRunTaskReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.OrganizationRunTask{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.OrganizationRunTask{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.OrganizationRunTaskList{}).Complete(r)
+}
+
+func (r *OrganizationRunTaskReconciler) updateStatus(ctx context.Context, t *organizationRunTaskInstance, runTask *tfc.RunTask, hmacKeyID string) error {
+	t.instance.Status.ObservedGeneration = t.instance.Generation
+	t.instance.Status.ID = runTask.ID
+	t.instance.Status.Name = runTask.Name
+	t.instance.Status.HMACKeyID = hmacKeyID
+	setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, "", nil)
+
+	return r.Status().Update(ctx, &t.instance)
+}
+
+func (r *OrganizationRunTaskReconciler) removeFinalizer(ctx context.Context, t *organizationRunTaskInstance) error {
+	controllerutil.RemoveFinalizer(&t.instance, organizationRunTaskFinalizer)
+
+	err := r.Update(ctx, &t.instance)
+	if err != nil {
+		t.log.Error(err, "Reconcile Run Task", "msg", fmt.Sprintf("failed to remove finalizer %s", organizationRunTaskFinalizer))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "RemoveRunTask", "Failed to remove finalizer %s", organizationRunTaskFinalizer)
+	}
+
+	return err
+}
+
+// getHMACKey returns the HMAC key of the run task or an empty string if the key is not set.
+func (r *OrganizationRunTaskReconciler) getHMACKey(ctx context.Context, t *organizationRunTaskInstance) (string, error) {
+	k := t.instance.Spec.HMACKey
+	if k == nil || k.SecretKeyRef == nil {
+		return "", nil
+	}
+	nn := types.NamespacedName{
+		Namespace: t.instance.Namespace,
+		Name:      k.SecretKeyRef.Name,
+	}
+
+	return secretKeyRef(ctx, r.Client, nn, k.SecretKeyRef.Key)
+}
+
+// runTaskHMACKeyID returns a hash of a given HMAC key or an empty string if the key is empty.
+// The API never returns the HMAC key, the hash allows detecting changes of the key without storing it.
+func runTaskHMACKeyID(key string) string {
+	if key == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func needToUpdateRunTask(instance *appv1alpha2.OrganizationRunTask, runTask *tfc.RunTask, hmacKeyID string) bool {
+	// generation changed
+	if instance.Generation != instance.Status.ObservedGeneration {
+		return true
+	}
+
+	// HMAC key changed
+	if hmacKeyID != instance.Status.HMACKeyID {
+		return true
+	}
+
+	// attributes changed
+	spec := instance.Spec
+	if spec.Name != runTask.Name ||
+		spec.URL != runTask.URL ||
+		spec.Description != runTask.Description ||
+		runTaskCategory(spec) != runTask.Category ||
+		spec.Enabled != runTask.Enabled {
+		return true
+	}
+
+	return false
+}
+
+// runTaskCategory returns the category of the run task, the only category the API supports is `task`.
+func runTaskCategory(spec appv1alpha2.OrganizationRunTaskSpec) string {
+	if spec.Category == "" {
+		return "task"
+	}
+
+	return spec.Category
+}
+
+func (r *OrganizationRunTaskReconciler) createRunTask(ctx context.Context, t *organizationRunTaskInstance, hmacKey string) (*tfc.RunTask, error) {
+	spec := t.instance.Spec
+	options := tfc.RunTaskCreateOptions{
+		Name:        spec.Name,
+		URL:         spec.URL,
+		Description: tfc.String(spec.Description),
+		Category:    runTaskCategory(spec),
+		Enabled:     tfc.Bool(spec.Enabled),
+	}
+	if hmacKey != "" {
+		options.HMACKey = tfc.String(hmacKey)
+	}
+
+	runTask, err := t.tfClient.Client.RunTasks.Create(ctx, spec.Organization, options)
+	if err != nil {
+		t.log.Error(err, "Reconcile Run Task", "msg", "failed to create a new run task")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to create a new run task")
+		return nil, err
+	}
+
+	t.instance.Status.ID = runTask.ID
+	t.instance.Status.HMACKeyID = runTaskHMACKeyID(hmacKey)
+
+	return runTask, nil
+}
+
+func (r *OrganizationRunTaskReconciler) readRunTask(ctx context.Context, t *organizationRunTaskInstance) (*tfc.RunTask, error) {
+	return t.tfClient.Client.RunTasks.Read(ctx, t.instance.Status.ID)
+}
+
+func (r *OrganizationRunTaskReconciler) updateRunTask(ctx context.Context, t *organizationRunTaskInstance, hmacKey string) (*tfc.RunTask, error) {
+	spec := t.instance.Spec
+	options := tfc.RunTaskUpdateOptions{
+		Name:        tfc.String(spec.Name),
+		URL:         tfc.String(spec.URL),
+		Description: tfc.String(spec.Description),
+		Category:    tfc.String(runTaskCategory(spec)),
+		Enabled:     tfc.Bool(spec.Enabled),
+	}
+	// An empty HMAC key removes the key from the run task.
+	if hmacKey != "" || t.instance.Status.HMACKeyID != "" {
+		options.HMACKey = tfc.String(hmacKey)
+	}
+
+	return t.tfClient.Client.RunTasks.Update(ctx, t.instance.Status.ID, options)
+}
+
+func (r *OrganizationRunTaskReconciler) reconcileRunTask(ctx context.Context, t *organizationRunTaskInstance) error {
+	t.log.Info("Reconcile Run Task", "msg", "reconciling run task")
+
+	var runTask *tfc.RunTask
+	var err error
+
+	defer func() {
+		// Update the status with the Run Task ID. This is useful if the reconciliation failed.
+		// An example here would be the case when the run task has been created successfully,
+		// but further reconciliation steps failed.
+		if runTask != nil && runTask.ID != "" {
+			t.instance.Status.ID = runTask.ID
+			err = r.Status().Update(ctx, &t.instance)
+			if err != nil {
+				t.log.Error(err, "Organization Run Task Controller", "msg", "update status with run task ID")
+				r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to update status with run task ID")
+			}
+		}
+	}()
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the run task
+	if isDeletionCandidate(&t.instance, organizationRunTaskFinalizer) {
+		t.log.Info("Reconcile Run Task", "msg", "object marked as deleted, need to delete run task first")
+		r.Recorder.Event(&t.instance, corev1.EventTypeNormal, "ReconcileRunTask", "Object marked as deleted, need to delete run task first")
+		return r.deleteRunTask(ctx, t)
+	}
+
+	hmacKey, err := r.getHMACKey(ctx, t)
+	if err != nil {
+		t.log.Error(err, "Reconcile Run Task", "msg", "failed to get HMAC key")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to get HMAC key")
+		return err
+	}
+	hmacKeyID := runTaskHMACKeyID(hmacKey)
+
+	// create a new run task if run task ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	if t.instance.IsCreationCandidate() {
+		t.log.Info("Reconcile Run Task", "msg", "status.ID is empty, creating a new run task")
+		r.Recorder.Event(&t.instance, corev1.EventTypeNormal, "ReconcileRunTask", "Status.ID is empty, creating a new run task")
+		_, err = r.createRunTask(ctx, t, hmacKey)
+		if err != nil {
+			return err
+		}
+		t.log.Info("Reconcile Run Task", "msg", "successfully created a new run task")
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileRunTask", "Successfully created a new run task with ID %s", t.instance.Status.ID)
+	}
+
+	// read the HCP Terraform run task to compare it with the Kubernetes object spec
+	runTask, err = r.readRunTask(ctx, t)
+	if err != nil {
+		// 'ResourceNotFound' means that the run task was removed from HCP Terraform bypass the operator
+		if err == tfc.ErrResourceNotFound {
+			t.log.Info("Reconcile Run Task", "msg", "run task not found, creating a new run task")
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Run task ID %s not found, creating a new run task", t.instance.Status.ID)
+			runTask, err = r.createRunTask(ctx, t, hmacKey)
+			if err != nil {
+				return err
+			}
+			t.log.Info("Reconcile Run Task", "msg", "successfully created a new run task")
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileRunTask", "Successfully created a new run task with ID %s", t.instance.Status.ID)
+		} else {
+			t.log.Error(err, "Reconcile Run Task", "msg", fmt.Sprintf("failed to read run task ID %s", t.instance.Status.ID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to read run task ID %s", t.instance.Status.ID)
+			return err
+		}
+	}
+
+	// update run task if any changes have been made in the Kubernetes object spec or HCP Terraform run task
+	if needToUpdateRunTask(&t.instance, runTask, hmacKeyID) {
+		t.log.Info("Reconcile Run Task", "msg", fmt.Sprintf("observed and desired states are not matching, need to update run task ID %s", t.instance.Status.ID))
+		runTask, err = r.updateRunTask(ctx, t, hmacKey)
+		if err != nil {
+			t.log.Error(err, "Reconcile Run Task", "msg", fmt.Sprintf("failed to update run task ID %s", t.instance.Status.ID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to update run task ID %s", t.instance.Status.ID)
+			return err
+		}
+	} else {
+		t.log.Info("Reconcile Run Task", "msg", fmt.Sprintf("observed and desired states are matching, no need to update run task ID %s", t.instance.Status.ID))
+	}
+
+	return r.updateStatus(ctx, t, runTask, hmacKeyID)
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *OrganizationRunTaskReconciler) deleteRunTask(ctx context.Context, t *organizationRunTaskInstance) error {
+	t.log.Info("Reconcile Run Task", "msg", fmt.Sprintf("deletion policy is %s", t.instance.Spec.DeletionPolicy))
+
+	if t.instance.Status.ID == "" {
+		t.log.Info("Reconcile Run Task", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", organizationRunTaskFinalizer))
+		return r.removeFinalizer(ctx, t)
+	}
+
+	switch t.instance.Spec.DeletionPolicy {
+	case appv1alpha2.OrganizationRunTaskDeletionPolicyRetain:
+		t.log.Info("Reconcile Run Task", "msg", fmt.Sprintf("remove finalizer %s", organizationRunTaskFinalizer))
+		return r.removeFinalizer(ctx, t)
+	case appv1alpha2.OrganizationRunTaskDeletionPolicyDestroy:
+		err := t.tfClient.Client.RunTasks.Delete(ctx, t.instance.Status.ID)
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				t.log.Info("Reconcile Run Task", "msg", "Run task was not found, remove finalizer")
+				return r.removeFinalizer(ctx, t)
+			}
+			t.log.Error(err, "Reconcile Run Task", "msg", fmt.Sprintf("failed to delete run task ID %s, retry later", t.instance.Status.ID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileRunTask", "Failed to delete run task ID %s, retry later", t.instance.Status.ID)
+			return err
+		}
+
+		t.log.Info("Reconcile Run Task", "msg", fmt.Sprintf("Run task ID %s has been deleted, remove finalizer", t.instance.Status.ID))
+		return r.removeFinalizer(ctx, t)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestOrganizationRunTaskReconcile(t *testing.T) {
+	t.Parallel()
+
+	runTask := &appv1alpha2.OrganizationRunTask{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.OrganizationRunTaskSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			URL:           "https://example.com/this",
+			Description:   "this",
+			Category:      "task",
+			HMACKey: &appv1alpha2.Token{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "run-task"},
+					Key:                  "hmac-key",
+				},
+			},
+			Enabled:        true,
+			DeletionPolicy: appv1alpha2.OrganizationRunTaskDeletionPolicyDestroy,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "run-task", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"hmac-key": []byte("this")},
+	}
+	f := newFakeAPI(t, runTask, secret)
+	r := &OrganizationRunTaskReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, runTask)
+	requireSynced(t, runTask.Status.Conditions)
+	assert.Contains(t, runTask.Finalizers, organizationRunTaskFinalizer)
+	rt := f.server.RunTask(runTask.Status.ID)
+	require.NotNil(t, rt)
+	assert.Equal(t, "this", rt.Name)
+	assert.Equal(t, "https://example.com/this", rt.URL)
+	assert.Equal(t, "this", rt.Description)
+	assert.True(t, rt.Enabled)
+	assert.Equal(t, "this", f.server.RunTaskHMACKey(rt.ID))
+	assert.Equal(t, runTaskHMACKeyID("this"), runTask.Status.HMACKeyID)
+
+	// A new value of the HMAC key is applied without changes to the spec.
+	ctx := context.TODO()
+	secret.Data["hmac-key"] = []byte("that")
+	require.NoError(t, f.client.Update(ctx, secret))
+	f.reconcile(t, r, runTask)
+	requireSynced(t, runTask.Status.Conditions)
+	assert.Equal(t, "that", f.server.RunTaskHMACKey(rt.ID))
+	assert.Equal(t, runTaskHMACKeyID("that"), runTask.Status.HMACKeyID)
+
+	runTask.Spec.URL = "https://example.com/that"
+	runTask.Spec.Enabled = false
+	runTask.Spec.HMACKey = nil
+	runTask.Generation++
+	require.NoError(t, f.client.Update(ctx, runTask))
+	f.reconcile(t, r, runTask)
+	requireSynced(t, runTask.Status.Conditions)
+	rt = f.server.RunTask(rt.ID)
+	assert.Equal(t, "https://example.com/that", rt.URL)
+	assert.False(t, rt.Enabled)
+	assert.Empty(t, f.server.RunTaskHMACKey(rt.ID))
+	assert.Empty(t, runTask.Status.HMACKeyID)
+
+	f.delete(t, runTask)
+	f.reconcile(t, r, runTask)
+	assert.False(t, f.exists(t, runTask))
+	assert.Nil(t, f.server.RunTask(rt.ID))
+}
+
+func TestOrganizationRunTaskReconcileCreateKeepsConditions(t *testing.T) {
+	t.Parallel()
+
+	runTask := &appv1alpha2.OrganizationRunTask{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.OrganizationRunTaskSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			URL:            "https://example.com/this",
+			Category:       "task",
+			Enabled:        true,
+			DeletionPolicy: appv1alpha2.OrganizationRunTaskDeletionPolicyRetain,
+		},
+	}
+	// the finalizer is already set, thus the conditions set before the run task is created are not reloaded from the object
+	runTask.Finalizers = []string{organizationRunTaskFinalizer}
+	f := newFakeAPI(t, runTask)
+	r := &OrganizationRunTaskReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, runTask)
+	requireSynced(t, runTask.Status.Conditions)
+	require.NotEmpty(t, runTask.Status.ID)
+	assert.True(t, meta.IsStatusConditionTrue(runTask.Status.Conditions, appv1alpha2.ConditionTypeSpecValid))
+}
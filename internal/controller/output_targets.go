@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// renderOutputTarget renders the data of a given output target over the output values.
+// Sensitive outputs are only available to Secrets.
+func renderOutputTarget(target appv1alpha2.OutputTarget, outputs []*tfc.StateVersionOutput) (map[string][]byte, error) {
+	values := make(map[string]any, len(outputs))
+	for _, o := range outputs {
+		if o.Sensitive && target.Kind != appv1alpha2.OutputTargetKindSecret {
+			continue
+		}
+		values[o.Name] = o.Value
+	}
+
+	templates, err := target.Templates()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string][]byte, len(templates))
+	for k, t := range templates {
+		var b bytes.Buffer
+		if err := t.Execute(&b, values); err != nil {
+			return nil, err
+		}
+		data[k] = b.Bytes()
+	}
+
+	return data, nil
+}
+
+// setOutputTargets renders the outputs into the ConfigMaps and Secrets of given output targets owned by a given object.
+// An object that already exists and is not owned by the owner is not overwritten.
+// The objects of output targets that were removed from the given ones are deleted.
+func setOutputTargets(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, targets []appv1alpha2.OutputTarget, outputs []*tfc.StateVersionOutput, labels map[string]string) error {
+	labels = maps.Clone(labels)
+	labels[labelOutputTarget] = MetaTrue
+
+	for _, t := range targets {
+		data, err := renderOutputTarget(t, outputs)
+		if err != nil {
+			return fmt.Errorf("failed to render %s %s: %w", t.Kind, t.Name, err)
+		}
+
+		om := metav1.ObjectMeta{
+			Name:      t.Name,
+			Namespace: owner.GetNamespace(),
+		}
+		var o client.Object
+		var setData func()
+		switch t.Kind {
+		case appv1alpha2.OutputTargetKindConfigMap:
+			cm := &corev1.ConfigMap{ObjectMeta: om}
+			o = cm
+			setData = func() {
+				cm.Data = make(map[string]string, len(data))
+				for k, v := range data {
+					cm.Data[k] = string(v)
+				}
+			}
+		case appv1alpha2.OutputTargetKindSecret:
+			secretType := t.Type
+			if secretType == "" {
+				secretType = corev1.SecretTypeOpaque
+			}
+			if err := deleteSecretOfDifferentType(ctx, c, owner, client.ObjectKeyFromObject(&corev1.Secret{ObjectMeta: om}), secretType); err != nil {
+				return err
+			}
+			secret := &corev1.Secret{ObjectMeta: om}
+			o = secret
+			setData = func() {
+				secret.Type = secretType
+				secret.Data = data
+			}
+		default:
+			return fmt.Errorf("unsupported output target kind %q", t.Kind)
+		}
+
+		_, err = controllerutil.CreateOrUpdate(ctx, c, o, func() error {
+			if o.GetResourceVersion() != "" && !containsOwnerReference(o.GetOwnerReferences(), owner.GetUID()) {
+				return fmt.Errorf("%s %s is in use by different object thus it cannot be used to store outputs", t.Kind, t.Name)
+			}
+			o.SetLabels(labels)
+			setData()
+			return controllerutil.SetControllerReference(owner, o, scheme)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return deleteRemovedOutputTargets(ctx, c, owner, targets)
+}
+
+// deleteRemovedOutputTargets deletes the ConfigMaps and Secrets owned by a given object that belong to none of given output targets.
+// The objects are found by the output target label, thus the ConfigMap and Secret that store all outputs are kept.
+func deleteRemovedOutputTargets(ctx context.Context, c client.Client, owner client.Object, targets []appv1alpha2.OutputTarget) error {
+	keep := make(map[appv1alpha2.OutputTargetKind][]string, len(targets))
+	for _, t := range targets {
+		keep[t.Kind] = append(keep[t.Kind], t.Name)
+	}
+
+	opts := []client.ListOption{
+		client.InNamespace(owner.GetNamespace()),
+		client.MatchingLabels{labelOutputTarget: MetaTrue},
+	}
+	configMaps := &corev1.ConfigMapList{}
+	if err := c.List(ctx, configMaps, opts...); err != nil {
+		return err
+	}
+	secrets := &corev1.SecretList{}
+	if err := c.List(ctx, secrets, opts...); err != nil {
+		return err
+	}
+
+	objects := make(map[client.Object]appv1alpha2.OutputTargetKind, len(configMaps.Items)+len(secrets.Items))
+	for i := range configMaps.Items {
+		objects[&configMaps.Items[i]] = appv1alpha2.OutputTargetKindConfigMap
+	}
+	for i := range secrets.Items {
+		objects[&secrets.Items[i]] = appv1alpha2.OutputTargetKindSecret
+	}
+	for o, kind := range objects {
+		if slices.Contains(keep[kind], o.GetName()) || !containsOwnerReference(o.GetOwnerReferences(), owner.GetUID()) {
+			continue
+		}
+		if err := client.IgnoreNotFound(c.Delete(ctx, o)); err != nil {
+			return fmt.Errorf("failed to delete %s %s: %w", kind, o.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// deleteSecretOfDifferentType deletes a Secret owned by a given owner if its type differs from a given one.
+// The type of a Secret is immutable, thus the Secret is created again.
+func deleteSecretOfDifferentType(ctx context.Context, c client.Client, owner client.Object, key client.ObjectKey, secretType corev1.SecretType) error {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if secret.Type == secretType || !containsOwnerReference(secret.GetOwnerReferences(), owner.GetUID()) {
+		return nil
+	}
+
+	return client.IgnoreNotFound(c.Delete(ctx, secret))
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestRenderOutputTarget(t *testing.T) {
+	t.Parallel()
+
+	outputs := []*tfc.StateVersionOutput{
+		{Name: "db", Value: map[string]any{"host": "db.example.com", "port": float64(5432)}},
+		{Name: "db_password", Value: "s3cr3t", Sensitive: true},
+	}
+
+	data, err := renderOutputTarget(appv1alpha2.OutputTarget{
+		Kind: appv1alpha2.OutputTargetKindSecret,
+		Name: "this",
+		Data: map[string]string{
+			"DB_URL":      "postgres://{{ .db.host }}:{{ .db.port }}",
+			"DB_PASSWORD": "{{ .db_password }}",
+			"DB_JSON":     "{{ toJson .db }}",
+			"DB_AUTH":     "{{ b64enc .db_password }}",
+		},
+	}, outputs)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"DB_URL":      []byte("postgres://db.example.com:5432"),
+		"DB_PASSWORD": []byte("s3cr3t"),
+		"DB_JSON":     []byte(`{"host":"db.example.com","port":5432}`),
+		"DB_AUTH":     []byte("czNjcjN0"),
+	}, data)
+
+	// Sensitive outputs are not available to ConfigMaps.
+	_, err = renderOutputTarget(appv1alpha2.OutputTarget{
+		Kind: appv1alpha2.OutputTargetKindConfigMap,
+		Name: "this",
+		Data: map[string]string{"DB_PASSWORD": "{{ .db_password }}"},
+	}, outputs)
+	assert.Error(t, err)
+
+	// Missing outputs fail the rendering.
+	_, err = renderOutputTarget(appv1alpha2.OutputTarget{
+		Kind: appv1alpha2.OutputTargetKindSecret,
+		Name: "this",
+		Data: map[string]string{"DB_USER": "{{ .db_user }}"},
+	}, outputs)
+	assert.Error(t, err)
+}
+
+func TestDeleteSecretOfDifferentType(t *testing.T) {
+	t.Parallel()
+
+	owner := &appv1alpha2.Workspace{ObjectMeta: objectMeta("this")}
+	owner.UID = "this"
+	secret := func(name string, uid types.UID) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       fakeNamespace,
+				OwnerReferences: []metav1.OwnerReference{{Name: "owner", UID: uid}},
+			},
+			Type: corev1.SecretTypeBasicAuth,
+		}
+	}
+	f := newFakeAPI(t, secret("owned", "this"), secret("foreign", "that"))
+	ctx := context.TODO()
+	key := func(name string) client.ObjectKey {
+		return client.ObjectKey{Namespace: fakeNamespace, Name: name}
+	}
+
+	// The type did not change.
+	require.NoError(t, deleteSecretOfDifferentType(ctx, f.client, owner, key("owned"), corev1.SecretTypeBasicAuth))
+	assert.True(t, f.exists(t, secret("owned", "this")))
+
+	// The Secret is not owned by the owner.
+	require.NoError(t, deleteSecretOfDifferentType(ctx, f.client, owner, key("foreign"), corev1.SecretTypeOpaque))
+	assert.True(t, f.exists(t, secret("foreign", "that")))
+
+	// The type changed.
+	require.NoError(t, deleteSecretOfDifferentType(ctx, f.client, owner, key("owned"), corev1.SecretTypeOpaque))
+	assert.False(t, f.exists(t, secret("owned", "this")))
+
+	// The Secret does not exist.
+	require.NoError(t, deleteSecretOfDifferentType(ctx, f.client, owner, key("missing"), corev1.SecretTypeOpaque))
+}
@@ -0,0 +1,419 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// PolicySetReconciler reconciles a PolicySet object
+type PolicySetReconciler struct {
+	client.Client
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
+}
+
+type policySetInstance struct {
+	instance appv1alpha2.PolicySet
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=policysets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=policysets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=policysets/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *PolicySetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	p := policySetInstance{}
+
+	p.log = log.Log.WithValues("policyset", req.NamespacedName)
+	p.log.Info("Policy Set Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &p.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			p.log.Info("Policy Set Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		p.log.Error(err, "Policy Set Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := p.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		p.log.Info("Policy Set Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	p.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := p.instance.ValidateSpec(); err != nil {
+		p.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&p.instance.Status.Conditions, p.instance.Generation, err)
+		if err := r.Status().Update(ctx, &p.instance); err != nil {
+			p.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
+		return doNotRequeue()
+	}
+	p.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&p.instance.Status.Conditions, p.instance.Generation, nil)
+
+	if needToAddFinalizer(&p.instance, policySetFinalizer) {
+		err := r.addFinalizer(ctx, &p.instance)
+		if err != nil {
+			p.log.Error(err, "Policy Set Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", policySetFinalizer))
+			r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", policySetFinalizer)
+			return requeueOnErr(err)
+		}
+		p.log.Info("Policy Set Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", policySetFinalizer))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", policySetFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &p)
+	if err != nil {
+		p.log.Error(err, "Policy Set Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &p.instance); err != nil {
+			p.log.Error(err, "Policy Set Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcilePolicySet(ctx, &p)
+	if err != nil {
+		p.log.Error(err, "Policy Set Controller", "msg", "reconcile policy set")
+		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to reconcile policy set")
+		setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &p.instance); err != nil {
+			p.log.Error(err, "Policy Set Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+	p.log.Info("Policy Set Controller", "msg", "successfully reconcilied policy set")
+	r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcilePolicySet", "Successfully reconcilied policy set ID %s", p.instance.Status.ID)
+
+	return requeueAfter(PolicySetSyncPeriod)
+}
+
+func (r *PolicySetReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.PolicySet) error {
+	controllerutil.AddFinalizer(instance, policySetFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *PolicySetReconciler) getTerraformClient(ctx context.Context, p *policySetInstance) error {
+	var err error
+	p.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, p.log, p.instance.Namespace, p.instance.Spec.Organization, p.instance.Spec.Token, p.instance.Spec.ConnectionRef)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PolicySetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.PolicySet{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.PolicySet{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.PolicySetList{}).Complete(r)
+}
+
+func (r *PolicySetReconciler) updateStatus(ctx context.Context, p *policySetInstance, policySet *tfc.PolicySet) error {
+	p.instance.Status.ObservedGeneration = p.instance.Generation
+	p.instance.Status.ID = policySet.ID
+	p.instance.Status.Name = policySet.Name
+	setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, "", nil)
+
+	return r.Status().Update(ctx, &p.instance)
+}
+
+func (r *PolicySetReconciler) removeFinalizer(ctx context.Context, p *policySetInstance) error {
+	controllerutil.RemoveFinalizer(&p.instance, policySetFinalizer)
+
+	err := r.Update(ctx, &p.instance)
+	if err != nil {
+		p.log.Error(err, "Reconcile Policy Set", "msg", fmt.Sprintf("failed to remove finalizer %s", policySetFinalizer))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "RemovePolicySet", "Failed to remove finalizer %s", policySetFinalizer)
+	}
+
+	return err
+}
+
+// policySetKind returns the policy framework of a given policy set spec.
+func policySetKind(spec appv1alpha2.PolicySetSpec) tfc.PolicyKind {
+	if spec.Kind == appv1alpha2.PolicySetKindOPA {
+		return tfc.OPA
+	}
+
+	return tfc.Sentinel
+}
+
+// policySetOverridable returns the overridable option of a given policy set spec.
+// Only OPA policy sets support it, the option is omitted for Sentinel policy sets.
+func policySetOverridable(spec appv1alpha2.PolicySetSpec) *bool {
+	if policySetKind(spec) != tfc.OPA {
+		return nil
+	}
+
+	return tfc.Bool(spec.Overridable)
+}
+
+// policySetVCSRepo returns the VCS repository options of a given policy set spec or nil if the policies do not come from VCS.
+func policySetVCSRepo(spec appv1alpha2.PolicySetSpec) *tfc.VCSRepoOptions {
+	vc := spec.VersionControl
+	if vc == nil {
+		return nil
+	}
+
+	return &tfc.VCSRepoOptions{
+		Branch:            tfc.String(vc.Branch),
+		Identifier:        tfc.String(vc.Repository),
+		IngressSubmodules: tfc.Bool(vc.IngressSubmodules),
+		OAuthTokenID:      tfc.String(vc.OAuthTokenID),
+	}
+}
+
+// policySetPoliciesPath returns the policies path of a given policy set spec or nil if the policies do not come from VCS.
+func policySetPoliciesPath(spec appv1alpha2.PolicySetSpec) *string {
+	if spec.VersionControl == nil {
+		return nil
+	}
+
+	return tfc.String(spec.VersionControl.PoliciesPath)
+}
+
+func needToUpdatePolicySet(instance *appv1alpha2.PolicySet, policySet *tfc.PolicySet) bool {
+	// generation changed
+	if instance.Generation != instance.Status.ObservedGeneration {
+		return true
+	}
+
+	// attributes changed
+	spec := instance.Spec
+	if spec.Name != policySet.Name ||
+		spec.Description != policySet.Description ||
+		spec.Global != policySet.Global {
+		return true
+	}
+
+	if o := policySetOverridable(spec); o != nil && (policySet.Overridable == nil || *o != *policySet.Overridable) {
+		return true
+	}
+
+	if vc := spec.VersionControl; vc != nil {
+		if policySet.VCSRepo == nil {
+			return true
+		}
+		if vc.OAuthTokenID != policySet.VCSRepo.OAuthTokenID ||
+			vc.Repository != policySet.VCSRepo.Identifier ||
+			vc.IngressSubmodules != policySet.VCSRepo.IngressSubmodules ||
+			vc.PoliciesPath != policySet.PoliciesPath {
+			return true
+		}
+		// An empty branch means the default branch of the repository, which the API returns as is.
+		if vc.Branch != "" && vc.Branch != policySet.VCSRepo.Branch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *PolicySetReconciler) createPolicySet(ctx context.Context, p *policySetInstance) (*tfc.PolicySet, error) {
+	spec := p.instance.Spec
+	options := tfc.PolicySetCreateOptions{
+		Name:         tfc.String(spec.Name),
+		Description:  tfc.String(spec.Description),
+		Global:       tfc.Bool(spec.Global),
+		Kind:         policySetKind(spec),
+		Overridable:  policySetOverridable(spec),
+		PoliciesPath: policySetPoliciesPath(spec),
+		VCSRepo:      policySetVCSRepo(spec),
+	}
+
+	policySet, err := p.tfClient.Client.PolicySets.Create(ctx, spec.Organization, options)
+	if err != nil {
+		p.log.Error(err, "Reconcile Policy Set", "msg", "failed to create a new policy set")
+		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to create a new policy set")
+		return nil, err
+	}
+
+	// Policies the operator created for the previous policy set are kept in the status,
+	// so they get added to the new policy set instead of being created again.
+	p.instance.Status.ID = policySet.ID
+
+	return policySet, nil
+}
+
+func (r *PolicySetReconciler) readPolicySet(ctx context.Context, p *policySetInstance) (*tfc.PolicySet, error) {
+	return p.tfClient.Client.PolicySets.Read(ctx, p.instance.Status.ID)
+}
+
+func (r *PolicySetReconciler) updatePolicySet(ctx context.Context, p *policySetInstance) (*tfc.PolicySet, error) {
+	spec := p.instance.Spec
+	options := tfc.PolicySetUpdateOptions{
+		Name:         tfc.String(spec.Name),
+		Description:  tfc.String(spec.Description),
+		Global:       tfc.Bool(spec.Global),
+		Overridable:  policySetOverridable(spec),
+		PoliciesPath: policySetPoliciesPath(spec),
+		VCSRepo:      policySetVCSRepo(spec),
+	}
+
+	return p.tfClient.Client.PolicySets.Update(ctx, p.instance.Status.ID, options)
+}
+
+func (r *PolicySetReconciler) reconcilePolicySet(ctx context.Context, p *policySetInstance) error {
+	p.log.Info("Reconcile Policy Set", "msg", "reconciling policy set")
+
+	var policySet *tfc.PolicySet
+	var err error
+
+	defer func() {
+		// Update the status with the Policy Set ID. This is useful if the reconciliation failed.
+		// An example here would be the case when the policy set has been created successfully,
+		// but further reconciliation steps failed.
+		if policySet != nil && policySet.ID != "" {
+			p.instance.Status.ID = policySet.ID
+			err = r.Status().Update(ctx, &p.instance)
+			if err != nil {
+				p.log.Error(err, "Policy Set Controller", "msg", "update status with policy set ID")
+				r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to update status with policy set ID")
+			}
+		}
+	}()
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the policy set
+	if isDeletionCandidate(&p.instance, policySetFinalizer) {
+		p.log.Info("Reconcile Policy Set", "msg", "object marked as deleted, need to delete policy set first")
+		r.Recorder.Event(&p.instance, corev1.EventTypeNormal, "ReconcilePolicySet", "Object marked as deleted, need to delete policy set first")
+		return r.deletePolicySet(ctx, p)
+	}
+
+	// create a new policy set if policy set ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	if p.instance.IsCreationCandidate() {
+		p.log.Info("Reconcile Policy Set", "msg", "status.ID is empty, creating a new policy set")
+		r.Recorder.Event(&p.instance, corev1.EventTypeNormal, "ReconcilePolicySet", "Status.ID is empty, creating a new policy set")
+		_, err = r.createPolicySet(ctx, p)
+		if err != nil {
+			return err
+		}
+		p.log.Info("Reconcile Policy Set", "msg", "successfully created a new policy set")
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcilePolicySet", "Successfully created a new policy set with ID %s", p.instance.Status.ID)
+	}
+
+	// read the HCP Terraform policy set to compare it with the Kubernetes object spec
+	policySet, err = r.readPolicySet(ctx, p)
+	if err != nil {
+		// 'ResourceNotFound' means that the policy set was removed from HCP Terraform bypass the operator
+		if err == tfc.ErrResourceNotFound {
+			p.log.Info("Reconcile Policy Set", "msg", "policy set not found, creating a new policy set")
+			r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Policy set ID %s not found, creating a new policy set", p.instance.Status.ID)
+			policySet, err = r.createPolicySet(ctx, p)
+			if err != nil {
+				return err
+			}
+			p.log.Info("Reconcile Policy Set", "msg", "successfully created a new policy set")
+			r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcilePolicySet", "Successfully created a new policy set with ID %s", p.instance.Status.ID)
+		} else {
+			p.log.Error(err, "Reconcile Policy Set", "msg", fmt.Sprintf("failed to read policy set ID %s", p.instance.Status.ID))
+			r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to read policy set ID %s", p.instance.Status.ID)
+			return err
+		}
+	}
+
+	// The policy framework of a policy set cannot be changed, a new object has to be created instead.
+	if policySet.Kind != policySetKind(p.instance.Spec) {
+		err = fmt.Errorf("policy set ID %s kind is %q and cannot be changed to %q", policySet.ID, policySet.Kind, policySetKind(p.instance.Spec))
+		p.log.Error(err, "Reconcile Policy Set", "msg", "policy set kind cannot be changed")
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Policy set ID %s kind cannot be changed", policySet.ID)
+		return err
+	}
+
+	// update policy set if any changes have been made in the Kubernetes object spec or HCP Terraform policy set
+	if needToUpdatePolicySet(&p.instance, policySet) {
+		p.log.Info("Reconcile Policy Set", "msg", fmt.Sprintf("observed and desired states are not matching, need to update policy set ID %s", p.instance.Status.ID))
+		// The response of the update call is not guaranteed to contain the relations of the policy set.
+		// Keep the ones read before, unless the policy set became global, in which case it is no longer applied to workspaces and projects.
+		policies, workspaces, projects := policySet.Policies, policySet.Workspaces, policySet.Projects
+		policySet, err = r.updatePolicySet(ctx, p)
+		if err != nil {
+			p.log.Error(err, "Reconcile Policy Set", "msg", fmt.Sprintf("failed to update policy set ID %s", p.instance.Status.ID))
+			r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to update policy set ID %s", p.instance.Status.ID)
+			return err
+		}
+		policySet.Policies = policies
+		if !policySet.Global {
+			policySet.Workspaces, policySet.Projects = workspaces, projects
+		}
+	} else {
+		p.log.Info("Reconcile Policy Set", "msg", fmt.Sprintf("observed and desired states are matching, no need to update policy set ID %s", p.instance.Status.ID))
+	}
+
+	// Reconcile Policies
+	err = r.reconcilePolicies(ctx, p, policySet)
+	if err != nil {
+		p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to reconcile policies of policy set ID %s", p.instance.Status.ID))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicies", "Failed to reconcile policies of policy set ID %s", p.instance.Status.ID)
+		return err
+	}
+	p.log.Info("Reconcile Policies", "msg", "successfully reconcilied policies")
+	r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcilePolicies", "Reconcilied policies of policy set ID %s", p.instance.Status.ID)
+
+	// Reconcile Parameters
+	err = r.reconcileParameters(ctx, p)
+	if err != nil {
+		p.log.Error(err, "Reconcile Parameters", "msg", fmt.Sprintf("failed to reconcile parameters of policy set ID %s", p.instance.Status.ID))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcileParameters", "Failed to reconcile parameters of policy set ID %s", p.instance.Status.ID)
+		return err
+	}
+	p.log.Info("Reconcile Parameters", "msg", "successfully reconcilied parameters")
+	r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcileParameters", "Reconcilied parameters of policy set ID %s", p.instance.Status.ID)
+
+	// Reconcile Workspaces
+	err = r.reconcileWorkspaces(ctx, p, policySet)
+	if err != nil {
+		p.log.Error(err, "Reconcile Workspaces", "msg", fmt.Sprintf("failed to reconcile workspaces of policy set ID %s", p.instance.Status.ID))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcileWorkspaces", "Failed to reconcile workspaces of policy set ID %s", p.instance.Status.ID)
+		return err
+	}
+	p.log.Info("Reconcile Workspaces", "msg", "successfully reconcilied workspaces")
+	r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcileWorkspaces", "Reconcilied workspaces of policy set ID %s", p.instance.Status.ID)
+
+	// Reconcile Projects
+	err = r.reconcileProjects(ctx, p, policySet)
+	if err != nil {
+		p.log.Error(err, "Reconcile Projects", "msg", fmt.Sprintf("failed to reconcile projects of policy set ID %s", p.instance.Status.ID))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcileProjects", "Failed to reconcile projects of policy set ID %s", p.instance.Status.ID)
+		return err
+	}
+	p.log.Info("Reconcile Projects", "msg", "successfully reconcilied projects")
+	r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcileProjects", "Reconcilied projects of policy set ID %s", p.instance.Status.ID)
+
+	return r.updateStatus(ctx, p, policySet)
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *PolicySetReconciler) deletePolicySet(ctx context.Context, p *policySetInstance) error {
+	p.log.Info("Reconcile Policy Set", "msg", fmt.Sprintf("deletion policy is %s", p.instance.Spec.DeletionPolicy))
+
+	if p.instance.Status.ID == "" {
+		p.log.Info("Reconcile Policy Set", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", policySetFinalizer))
+		return r.removeFinalizer(ctx, p)
+	}
+
+	switch p.instance.Spec.DeletionPolicy {
+	case appv1alpha2.PolicySetDeletionPolicyRetain:
+		p.log.Info("Reconcile Policy Set", "msg", fmt.Sprintf("remove finalizer %s", policySetFinalizer))
+		return r.removeFinalizer(ctx, p)
+	case appv1alpha2.PolicySetDeletionPolicyDestroy:
+		err := p.tfClient.Client.PolicySets.Delete(ctx, p.instance.Status.ID)
+		if err != nil && err != tfc.ErrResourceNotFound {
+			p.log.Error(err, "Reconcile Policy Set", "msg", fmt.Sprintf("failed to delete policy set ID %s, retry later", p.instance.Status.ID))
+			r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to delete policy set ID %s, retry later", p.instance.Status.ID)
+			return err
+		}
+		if err == tfc.ErrResourceNotFound {
+			p.log.Info("Reconcile Policy Set", "msg", "Policy set was not found")
+		} else {
+			p.log.Info("Reconcile Policy Set", "msg", fmt.Sprintf("Policy set ID %s has been deleted", p.instance.Status.ID))
+		}
+
+		// Policies are organization-level resources and outlive the policy set, delete the ones the operator created.
+		for _, s := range p.instance.Status.Policies {
+			if err := deletePolicy(ctx, p, s.ID, s.Name); err != nil {
+				r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcilePolicySet", "Failed to delete policy ID %s, retry later", s.ID)
+				return err
+			}
+		}
+
+		p.log.Info("Reconcile Policy Set", "msg", "remove finalizer")
+		return r.removeFinalizer(ctx, p)
+	}
+
+	return nil
+}
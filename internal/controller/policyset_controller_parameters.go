@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// policySetParameterValueID calculates a hash of a policy set parameter.
+func policySetParameterValueID(p tfc.PolicySetParameter) string {
+	return variableValueID(tfc.Variable{
+		Key:       p.Key,
+		Value:     p.Value,
+		Sensitive: p.Sensitive,
+	})
+}
+
+func createPolicySetParameter(ctx context.Context, p *policySetInstance, parameter tfc.PolicySetParameter) error {
+	p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("creating parameter %s", parameter.Key))
+	pp, err := p.tfClient.Client.PolicySetParameters.Create(ctx, p.instance.Status.ID, tfc.PolicySetParameterCreateOptions{
+		Key:       tfc.String(parameter.Key),
+		Value:     tfc.String(parameter.Value),
+		Category:  tfc.Category(tfc.CategoryPolicySet),
+		Sensitive: tfc.Bool(parameter.Sensitive),
+	})
+	if err != nil {
+		p.log.Error(err, "Reconcile Parameters", "msg", fmt.Sprintf("failed to create parameter %s", parameter.Key))
+		return err
+	}
+
+	p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("successfully created parameter %s", parameter.Key))
+	p.instance.Status.AddOrUpdateParameterStatus(appv1alpha2.PolicySetParameterStatus{
+		Name:    pp.Key,
+		ID:      pp.ID,
+		ValueID: policySetParameterValueID(parameter),
+	})
+
+	return nil
+}
+
+func updatePolicySetParameter(ctx context.Context, p *policySetInstance, specParameter, setParameter tfc.PolicySetParameter) error {
+	// If a parameter is marked as sensitive, it cannot be updated to become non-sensitive.
+	// In such cases, the parameter must be removed and a new one created.
+	if !specParameter.Sensitive && setParameter.Sensitive {
+		p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("updating parameter %s due to sensitivity changes", specParameter.Key))
+		if err := deletePolicySetParameter(ctx, p, setParameter); err != nil {
+			return err
+		}
+		if err := createPolicySetParameter(ctx, p, specParameter); err != nil {
+			return err
+		}
+		p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("successfully updated parameter %s", specParameter.Key))
+
+		return nil
+	}
+
+	vID := policySetParameterValueID(specParameter)
+	statusParameter := p.instance.Status.GetParameterStatus(specParameter.Key)
+	// Update a parameter if one of three conditions is true:
+	// - A parameter is not in Status, indicating that the parameter is present in the policy set but not managed by the operator yet.
+	// - A parameter's Status and spec ValueID do not match, indicating that the parameter has been updated via the spec.
+	// - A non-sensitive parameter's value does not match the spec, indicating that the parameter has been updated outside of the operator.
+	if statusParameter == nil || statusParameter.ValueID != vID ||
+		(!setParameter.Sensitive && setParameter.Value != specParameter.Value) {
+		p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("updating parameter %s", specParameter.Key))
+		pp, err := p.tfClient.Client.PolicySetParameters.Update(ctx, p.instance.Status.ID, setParameter.ID, tfc.PolicySetParameterUpdateOptions{
+			Key:       tfc.String(specParameter.Key),
+			Value:     tfc.String(specParameter.Value),
+			Sensitive: tfc.Bool(specParameter.Sensitive),
+		})
+		if err != nil {
+			p.log.Error(err, "Reconcile Parameters", "msg", fmt.Sprintf("failed to update parameter %s", specParameter.Key))
+			return err
+		}
+
+		p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("successfully updated parameter %s", specParameter.Key))
+		p.instance.Status.AddOrUpdateParameterStatus(appv1alpha2.PolicySetParameterStatus{
+			Name:    pp.Key,
+			ID:      pp.ID,
+			ValueID: vID,
+		})
+	}
+
+	return nil
+}
+
+func deletePolicySetParameter(ctx context.Context, p *policySetInstance, parameter tfc.PolicySetParameter) error {
+	p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("deleting parameter %s", parameter.Key))
+	err := p.tfClient.Client.PolicySetParameters.Delete(ctx, p.instance.Status.ID, parameter.ID)
+	if err != nil {
+		p.log.Error(err, "Reconcile Parameters", "msg", fmt.Sprintf("failed to delete parameter %s", parameter.Key))
+		return err
+	}
+
+	p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("successfully deleted parameter %s", parameter.Key))
+	p.instance.Status.DeleteParameterStatus(parameter.Key)
+
+	return nil
+}
+
+// getPolicySetParameters returns a map of all parameters of the policy set.
+func getPolicySetParameters(ctx context.Context, p *policySetInstance) (map[string]tfc.PolicySetParameter, error) {
+	parameters := make(map[string]tfc.PolicySetParameter)
+
+	p.log.Info("Reconcile Parameters", "msg", "getting policy set parameters")
+	listOpts := &tfc.PolicySetParameterListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		pp, err := p.tfClient.Client.PolicySetParameters.List(ctx, p.instance.Status.ID, listOpts)
+		if err != nil {
+			p.log.Error(err, "Reconcile Parameters", "msg", "failed to get policy set parameters")
+			return nil, err
+		}
+		for _, i := range pp.Items {
+			parameters[i.Key] = *i
+		}
+		if pp.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = pp.NextPage
+	}
+	p.log.Info("Reconcile Parameters", "msg", "successfully got policy set parameters")
+
+	return parameters, nil
+}
+
+// getSpecParameters returns a map of all instance parameters.
+func (r *PolicySetReconciler) getSpecParameters(ctx context.Context, p *policySetInstance) (map[string]tfc.PolicySetParameter, error) {
+	parameters := make(map[string]tfc.PolicySetParameter)
+
+	for _, sp := range p.instance.Spec.ParametersAsVariables() {
+		value, err := variableValue(ctx, r.Client, p.instance.Namespace, sp)
+		if err != nil {
+			p.log.Error(err, "Reconcile Parameters", "msg", fmt.Sprintf("failed to get value for the parameter %s", sp.Name))
+			r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcileParameters", fmt.Sprintf("Failed to get value for the parameter %s", sp.Name))
+			return nil, err
+		}
+		parameters[sp.Name] = tfc.PolicySetParameter{
+			Key:       sp.Name,
+			Value:     value,
+			Category:  tfc.CategoryPolicySet,
+			Sensitive: sp.Sensitive,
+		}
+	}
+
+	return parameters, nil
+}
+
+func (r *PolicySetReconciler) reconcileParameters(ctx context.Context, p *policySetInstance) error {
+	p.log.Info("Reconcile Parameters", "msg", "new reconciliation event")
+
+	setParameters, err := getPolicySetParameters(ctx, p)
+	if err != nil {
+		return err
+	}
+	specParameters, err := r.getSpecParameters(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	if len(specParameters) == 0 && len(setParameters) == 0 {
+		p.log.Info("Reconcile Parameters", "msg", "there are no parameters both in spec and policy set")
+		return nil
+	}
+
+	p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("there are %d parameters in spec", len(specParameters)))
+	p.log.Info("Reconcile Parameters", "msg", fmt.Sprintf("there are %d parameters in policy set", len(setParameters)))
+
+	// The same approach as for variable set variables:
+	// - Spec parameters that are not in the policy set get created.
+	// - Spec parameters that are in the policy set get updated.
+	// - Policy set parameters that are not in the spec get deleted.
+	for sk, sp := range specParameters {
+		if pp, ok := setParameters[sk]; ok {
+			if err := updatePolicySetParameter(ctx, p, sp, pp); err != nil {
+				return err
+			}
+			delete(setParameters, sk)
+		} else {
+			if err := createPolicySetParameter(ctx, p, sp); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, pp := range setParameters {
+		if err := deletePolicySetParameter(ctx, p, pp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
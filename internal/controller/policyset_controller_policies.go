@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// policyContentID calculates a hash of the policy code.
+func policyContentID(content string) string {
+	hash := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// policyEnforcementLevel returns the enforcement level of a given spec policy. An empty level means advisory.
+func policyEnforcementLevel(policy appv1alpha2.PolicySetPolicy) tfc.EnforcementLevel {
+	if policy.EnforcementLevel == "" {
+		return tfc.EnforcementAdvisory
+	}
+
+	return tfc.EnforcementLevel(policy.EnforcementLevel)
+}
+
+// policyQuery returns the query of a given spec policy or nil for Sentinel policies.
+func policyQuery(kind tfc.PolicyKind, policy appv1alpha2.PolicySetPolicy) *string {
+	if kind != tfc.OPA {
+		return nil
+	}
+
+	return tfc.String(policy.Query)
+}
+
+// needToUpdatePolicy reports whether the attributes of a given policy differ from the spec.
+func needToUpdatePolicy(kind tfc.PolicyKind, spec appv1alpha2.PolicySetPolicy, policy *tfc.Policy) bool {
+	if spec.Description != policy.Description || policyEnforcementLevel(spec) != policy.EnforcementLevel {
+		return true
+	}
+
+	if q := policyQuery(kind, spec); q != nil && (policy.Query == nil || *q != *policy.Query) {
+		return true
+	}
+
+	return false
+}
+
+func (r *PolicySetReconciler) getPolicyContent(ctx context.Context, p *policySetInstance, policy appv1alpha2.PolicySetPolicy) (string, error) {
+	content, err := variableValue(ctx, r.Client, p.instance.Namespace, appv1alpha2.Variable{
+		Name:      policy.Name,
+		Value:     policy.Content,
+		ValueFrom: policy.ContentFrom,
+	})
+	if err != nil {
+		p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to get content for the policy %s", policy.Name))
+		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcilePolicies", fmt.Sprintf("Failed to get content for the policy %s", policy.Name))
+		return "", err
+	}
+
+	return content, nil
+}
+
+func createPolicy(ctx context.Context, p *policySetInstance, kind tfc.PolicyKind, policy appv1alpha2.PolicySetPolicy) (*tfc.Policy, error) {
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("creating policy %s", policy.Name))
+	pol, err := p.tfClient.Client.Policies.Create(ctx, p.instance.Spec.Organization, tfc.PolicyCreateOptions{
+		Name:             tfc.String(policy.Name),
+		Kind:             kind,
+		Query:            policyQuery(kind, policy),
+		Description:      tfc.String(policy.Description),
+		EnforcementLevel: tfc.EnforcementMode(policyEnforcementLevel(policy)),
+	})
+	if err != nil {
+		p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to create policy %s", policy.Name))
+		return nil, err
+	}
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("successfully created policy %s with ID %s", policy.Name, pol.ID))
+
+	return pol, nil
+}
+
+func updatePolicy(ctx context.Context, p *policySetInstance, kind tfc.PolicyKind, id string, policy appv1alpha2.PolicySetPolicy) error {
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("updating policy %s", policy.Name))
+	_, err := p.tfClient.Client.Policies.Update(ctx, id, tfc.PolicyUpdateOptions{
+		Query:            policyQuery(kind, policy),
+		Description:      tfc.String(policy.Description),
+		EnforcementLevel: tfc.EnforcementMode(policyEnforcementLevel(policy)),
+	})
+	if err != nil {
+		p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to update policy %s", policy.Name))
+		return err
+	}
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("successfully updated policy %s", policy.Name))
+
+	return nil
+}
+
+func uploadPolicy(ctx context.Context, p *policySetInstance, id, name, content string) error {
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("uploading code of policy %s", name))
+	if err := p.tfClient.Client.Policies.Upload(ctx, id, []byte(content)); err != nil {
+		p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to upload code of policy %s", name))
+		return err
+	}
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("successfully uploaded code of policy %s", name))
+
+	return nil
+}
+
+// deletePolicy deletes the policy with a given ID. A policy that does not exist anymore is not an error.
+func deletePolicy(ctx context.Context, p *policySetInstance, id, name string) error {
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("deleting policy %s", name))
+	if err := p.tfClient.Client.Policies.Delete(ctx, id); err != nil && err != tfc.ErrResourceNotFound {
+		p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to delete policy %s", name))
+		return err
+	}
+	p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("successfully deleted policy %s", name))
+
+	return nil
+}
+
+// reconcileSpecPolicy makes sure a given spec policy exists with the desired attributes and code and returns its status.
+func (r *PolicySetReconciler) reconcileSpecPolicy(ctx context.Context, p *policySetInstance, kind tfc.PolicyKind, policy appv1alpha2.PolicySetPolicy) (appv1alpha2.PolicySetPolicyStatus, error) {
+	status := appv1alpha2.PolicySetPolicyStatus{Name: policy.Name}
+
+	content, err := r.getPolicyContent(ctx, p, policy)
+	if err != nil {
+		return status, err
+	}
+	contentID := policyContentID(content)
+
+	if s := p.instance.Status.GetPolicyStatus(policy.Name); s != nil {
+		pol, err := p.tfClient.Client.Policies.Read(ctx, s.ID)
+		switch {
+		case err == tfc.ErrResourceNotFound:
+			p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("policy %s ID %s not found, creating a new policy", policy.Name, s.ID))
+		case err != nil:
+			p.log.Error(err, "Reconcile Policies", "msg", fmt.Sprintf("failed to read policy %s ID %s", policy.Name, s.ID))
+			return status, err
+		default:
+			status.ID = s.ID
+			if needToUpdatePolicy(kind, policy, pol) {
+				if err := updatePolicy(ctx, p, kind, s.ID, policy); err != nil {
+					return status, err
+				}
+			}
+			status.ContentID = s.ContentID
+			if s.ContentID != contentID {
+				if err := uploadPolicy(ctx, p, s.ID, policy.Name, content); err != nil {
+					return status, err
+				}
+				status.ContentID = contentID
+			}
+			return status, nil
+		}
+	}
+
+	pol, err := createPolicy(ctx, p, kind, policy)
+	if err != nil {
+		return status, err
+	}
+	status.ID = pol.ID
+	if err := uploadPolicy(ctx, p, pol.ID, policy.Name, content); err != nil {
+		return status, err
+	}
+	status.ContentID = contentID
+
+	return status, nil
+}
+
+func (r *PolicySetReconciler) reconcilePolicies(ctx context.Context, p *policySetInstance, policySet *tfc.PolicySet) error {
+	p.log.Info("Reconcile Policies", "msg", "new reconciliation event")
+
+	if len(p.instance.Spec.Policies) == 0 && len(p.instance.Status.Policies) == 0 {
+		p.log.Info("Reconcile Policies", "msg", "there are no policies both in spec and status")
+		return nil
+	}
+
+	kind := policySetKind(p.instance.Spec)
+	specNames := make([]string, 0, len(p.instance.Spec.Policies))
+	for _, sp := range p.instance.Spec.Policies {
+		specNames = append(specNames, sp.Name)
+		s, err := r.reconcileSpecPolicy(ctx, p, kind, sp)
+		// Record the policy even if the reconciliation failed half way,
+		// so the next reconciliation picks it up instead of creating the policy again.
+		if s.ID != "" {
+			p.instance.Status.AddOrUpdatePolicyStatus(s)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var currentIDs []string
+	for _, pol := range policySet.Policies {
+		currentIDs = append(currentIDs, pol.ID)
+	}
+	var add []*tfc.Policy
+	for _, s := range p.instance.Status.Policies {
+		if slices.Contains(specNames, s.Name) && !slices.Contains(currentIDs, s.ID) {
+			add = append(add, &tfc.Policy{ID: s.ID})
+		}
+	}
+	if len(add) > 0 {
+		p.log.Info("Reconcile Policies", "msg", fmt.Sprintf("adding %d policies to the policy set", len(add)))
+		err := p.tfClient.Client.PolicySets.AddPolicies(ctx, policySet.ID, tfc.PolicySetAddPoliciesOptions{
+			Policies: add,
+		})
+		if err != nil {
+			p.log.Error(err, "Reconcile Policies", "msg", "failed to add policies to the policy set")
+			return err
+		}
+	}
+
+	// Policies the operator created before that are no longer in the spec get deleted, which also removes them from the policy set.
+	for _, s := range slices.Clone(p.instance.Status.Policies) {
+		if slices.Contains(specNames, s.Name) {
+			continue
+		}
+		if err := deletePolicy(ctx, p, s.ID, s.Name); err != nil {
+			return err
+		}
+		p.instance.Status.DeletePolicyStatus(s.Name)
+	}
+
+	return nil
+}
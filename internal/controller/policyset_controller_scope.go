@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+// getPolicySetWorkspaceIDs returns IDs of the workspaces the policy set should be applied to.
+func getPolicySetWorkspaceIDs(ctx context.Context, p *policySetInstance) ([]string, error) {
+	var ids []string
+
+	for _, sw := range p.instance.Spec.Workspaces {
+		if sw.ID != "" {
+			ids = append(ids, sw.ID)
+			continue
+		}
+		ws, err := p.tfClient.Client.Workspaces.Read(ctx, p.instance.Spec.Organization, sw.Name)
+		if err != nil {
+			p.log.Error(err, "Reconcile Workspaces", "msg", fmt.Sprintf("failed to get workspace ID by name %s", sw.Name))
+			return nil, err
+		}
+		ids = append(ids, ws.ID)
+	}
+
+	slices.Sort(ids)
+
+	return slices.Compact(ids), nil
+}
+
+// getPolicySetProjectIDs returns IDs of the projects the policy set should be applied to.
+func getPolicySetProjectIDs(ctx context.Context, p *policySetInstance) ([]string, error) {
+	var ids []string
+
+	for _, sp := range p.instance.Spec.Projects {
+		if sp.ID != "" {
+			ids = append(ids, sp.ID)
+			continue
+		}
+		id, err := getProjectIDByName(ctx, p.tfClient.Client, p.instance.Spec.Organization, sp.Name)
+		if err != nil {
+			p.log.Error(err, "Reconcile Projects", "msg", fmt.Sprintf("failed to get project ID by name %s", sp.Name))
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	return slices.Compact(ids), nil
+}
+
+func (r *PolicySetReconciler) reconcileWorkspaces(ctx context.Context, p *policySetInstance, policySet *tfc.PolicySet) error {
+	p.log.Info("Reconcile Workspaces", "msg", "new reconciliation event")
+
+	if policySet.Global {
+		p.log.Info("Reconcile Workspaces", "msg", "policy set is global and applies to all workspaces")
+		p.instance.Status.Workspaces = nil
+		return nil
+	}
+
+	specIDs, err := getPolicySetWorkspaceIDs(ctx, p)
+	if err != nil {
+		return err
+	}
+	var currentIDs []string
+	for _, ws := range policySet.Workspaces {
+		currentIDs = append(currentIDs, ws.ID)
+	}
+
+	apply, remove := scopeChanges(specIDs, currentIDs, p.instance.Status.Workspaces)
+	if len(apply) > 0 {
+		p.log.Info("Reconcile Workspaces", "msg", fmt.Sprintf("applying policy set to workspaces %v", apply))
+		options := tfc.PolicySetAddWorkspacesOptions{}
+		for _, id := range apply {
+			options.Workspaces = append(options.Workspaces, &tfc.Workspace{ID: id})
+		}
+		if err := p.tfClient.Client.PolicySets.AddWorkspaces(ctx, policySet.ID, options); err != nil {
+			p.log.Error(err, "Reconcile Workspaces", "msg", "failed to apply policy set to workspaces")
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		p.log.Info("Reconcile Workspaces", "msg", fmt.Sprintf("removing policy set from workspaces %v", remove))
+		options := tfc.PolicySetRemoveWorkspacesOptions{}
+		for _, id := range remove {
+			options.Workspaces = append(options.Workspaces, &tfc.Workspace{ID: id})
+		}
+		if err := p.tfClient.Client.PolicySets.RemoveWorkspaces(ctx, policySet.ID, options); err != nil {
+			p.log.Error(err, "Reconcile Workspaces", "msg", "failed to remove policy set from workspaces")
+			return err
+		}
+	}
+
+	p.instance.Status.Workspaces = specIDs
+
+	return nil
+}
+
+func (r *PolicySetReconciler) reconcileProjects(ctx context.Context, p *policySetInstance, policySet *tfc.PolicySet) error {
+	p.log.Info("Reconcile Projects", "msg", "new reconciliation event")
+
+	if policySet.Global {
+		p.log.Info("Reconcile Projects", "msg", "policy set is global and applies to all projects")
+		p.instance.Status.Projects = nil
+		return nil
+	}
+
+	specIDs, err := getPolicySetProjectIDs(ctx, p)
+	if err != nil {
+		return err
+	}
+	var currentIDs []string
+	for _, prj := range policySet.Projects {
+		currentIDs = append(currentIDs, prj.ID)
+	}
+
+	apply, remove := scopeChanges(specIDs, currentIDs, p.instance.Status.Projects)
+	if len(apply) > 0 {
+		p.log.Info("Reconcile Projects", "msg", fmt.Sprintf("applying policy set to projects %v", apply))
+		options := tfc.PolicySetAddProjectsOptions{}
+		for _, id := range apply {
+			options.Projects = append(options.Projects, &tfc.Project{ID: id})
+		}
+		if err := p.tfClient.Client.PolicySets.AddProjects(ctx, policySet.ID, options); err != nil {
+			p.log.Error(err, "Reconcile Projects", "msg", "failed to apply policy set to projects")
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		p.log.Info("Reconcile Projects", "msg", fmt.Sprintf("removing policy set from projects %v", remove))
+		options := tfc.PolicySetRemoveProjectsOptions{}
+		for _, id := range remove {
+			options.Projects = append(options.Projects, &tfc.Project{ID: id})
+		}
+		if err := p.tfClient.Client.PolicySets.RemoveProjects(ctx, policySet.ID, options); err != nil {
+			p.log.Error(err, "Reconcile Projects", "msg", "failed to remove policy set from projects")
+			return err
+		}
+	}
+
+	p.instance.Status.Projects = specIDs
+
+	return nil
+}
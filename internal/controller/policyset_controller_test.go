@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestPolicySetReconcile(t *testing.T) {
+	t.Parallel()
+
+	policySet := &appv1alpha2.PolicySet{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.PolicySetSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Kind:          appv1alpha2.PolicySetKindSentinel,
+			Policies: []appv1alpha2.PolicySetPolicy{
+				{
+					Name:             "inline",
+					EnforcementLevel: "hard-mandatory",
+					Content:          "main = true",
+				},
+				{
+					Name:             "config-map",
+					EnforcementLevel: "advisory",
+					ContentFrom: &appv1alpha2.ValueFrom{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "policies"},
+							Key:                  "policy",
+						},
+					},
+				},
+			},
+			Parameters: []appv1alpha2.PolicySetParameter{
+				{
+					Name:      "secret",
+					Sensitive: true,
+					ValueFrom: &appv1alpha2.ValueFrom{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "params"},
+							Key:                  "secret",
+						},
+					},
+				},
+			},
+			Workspaces:     []appv1alpha2.PolicySetWorkspace{{Name: "this"}},
+			Projects:       []appv1alpha2.PolicySetProject{{Name: "this"}},
+			DeletionPolicy: appv1alpha2.PolicySetDeletionPolicyDestroy,
+		},
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "policies", Namespace: fakeNamespace},
+		Data:       map[string]string{"policy": "main = false"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "params", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"secret": []byte("secret")},
+	}
+	f := newFakeAPI(t, policySet, configMap, secret)
+	r := &PolicySetReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	ctx := context.TODO()
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(ctx, fakeOrganization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	p, err := c.Projects.Create(ctx, fakeOrganization, tfc.ProjectCreateOptions{Name: "this"})
+	require.NoError(t, err)
+
+	f.reconcile(t, r, policySet)
+	requireSynced(t, policySet.Status.Conditions)
+	assert.Contains(t, policySet.Finalizers, policySetFinalizer)
+	ps := f.server.PolicySet(policySet.Status.ID)
+	require.NotNil(t, ps)
+	assert.Equal(t, "this", ps.Name)
+	assert.Equal(t, tfc.Sentinel, ps.Kind)
+	assert.Len(t, ps.Policies, 2)
+	assert.Equal(t, []string{ws.ID}, policySet.Status.Workspaces)
+	assert.Equal(t, []string{p.ID}, policySet.Status.Projects)
+	require.Len(t, policySet.Status.Policies, 2)
+	policies := map[string]string{}
+	for _, s := range policySet.Status.Policies {
+		policies[s.Name] = s.ID
+	}
+	assert.Equal(t, "main = true", f.server.PolicyContent(policies["inline"]))
+	assert.Equal(t, "main = false", f.server.PolicyContent(policies["config-map"]))
+	assert.Equal(t, tfc.EnforcementHard, f.server.Policy(policies["inline"]).EnforcementLevel)
+	params := f.server.PolicySetParameters(ps.ID)
+	require.Len(t, params, 1)
+	assert.Equal(t, "secret", params[0].Value)
+	assert.True(t, params[0].Sensitive)
+	assert.Len(t, policySet.Status.Parameters, 1)
+
+	// Change the code of one policy and drop the other one.
+	policySet.Spec.Policies = []appv1alpha2.PolicySetPolicy{
+		{
+			Name:             "inline",
+			EnforcementLevel: "soft-mandatory",
+			Content:          "main = false",
+		},
+	}
+	policySet.Spec.Parameters = nil
+	policySet.Spec.Projects = nil
+	policySet.Generation++
+	require.NoError(t, f.client.Update(ctx, policySet))
+	f.reconcile(t, r, policySet)
+	requireSynced(t, policySet.Status.Conditions)
+	ps = f.server.PolicySet(ps.ID)
+	require.Len(t, ps.Policies, 1)
+	assert.Equal(t, policies["inline"], ps.Policies[0].ID)
+	assert.Equal(t, "main = false", f.server.PolicyContent(policies["inline"]))
+	assert.Equal(t, tfc.EnforcementSoft, f.server.Policy(policies["inline"]).EnforcementLevel)
+	assert.Nil(t, f.server.Policy(policies["config-map"]))
+	assert.Len(t, policySet.Status.Policies, 1)
+	assert.Empty(t, f.server.PolicySetParameters(ps.ID))
+	assert.Empty(t, ps.Projects)
+	assert.Empty(t, policySet.Status.Projects)
+
+	f.delete(t, policySet)
+	f.reconcile(t, r, policySet)
+	assert.False(t, f.exists(t, policySet))
+	assert.Nil(t, f.server.PolicySet(ps.ID))
+	assert.Nil(t, f.server.Policy(policies["inline"]))
+}
+
+func TestPolicySetReconcileOPA(t *testing.T) {
+	t.Parallel()
+
+	policySet := &appv1alpha2.PolicySet{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.PolicySetSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Kind:          appv1alpha2.PolicySetKindOPA,
+			Overridable:   true,
+			Global:        true,
+			Policies: []appv1alpha2.PolicySetPolicy{
+				{
+					Name:             "this",
+					EnforcementLevel: "mandatory",
+					Query:            "data.this.deny",
+					Content:          "package this",
+				},
+			},
+			DeletionPolicy: appv1alpha2.PolicySetDeletionPolicyRetain,
+		},
+	}
+	f := newFakeAPI(t, policySet)
+	r := &PolicySetReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, policySet)
+	requireSynced(t, policySet.Status.Conditions)
+	ps := f.server.PolicySet(policySet.Status.ID)
+	require.NotNil(t, ps)
+	assert.Equal(t, tfc.OPA, ps.Kind)
+	assert.True(t, ps.Global)
+	require.NotNil(t, ps.Overridable)
+	assert.True(t, *ps.Overridable)
+	require.Len(t, policySet.Status.Policies, 1)
+	policy := f.server.Policy(policySet.Status.Policies[0].ID)
+	require.NotNil(t, policy)
+	require.NotNil(t, policy.Query)
+	assert.Equal(t, "data.this.deny", *policy.Query)
+
+	// The policy framework cannot be changed.
+	policySet.Spec.Kind = appv1alpha2.PolicySetKindSentinel
+	policySet.Spec.Overridable = false
+	policySet.Spec.Policies = nil
+	policySet.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), policySet))
+	f.reconcile(t, r, policySet)
+	c := meta.FindStatusCondition(policySet.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, tfc.OPA, f.server.PolicySet(ps.ID).Kind)
+
+	f.delete(t, policySet)
+	f.reconcile(t, r, policySet)
+	assert.False(t, f.exists(t, policySet))
+	assert.NotNil(t, f.server.PolicySet(ps.ID))
+	assert.NotNil(t, f.server.Policy(policy.ID))
+}
+
+func TestPolicySetReconcileCreateKeepsConditions(t *testing.T) {
+	t.Parallel()
+
+	policySet := &appv1alpha2.PolicySet{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.PolicySetSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			Kind:           appv1alpha2.PolicySetKindSentinel,
+			DeletionPolicy: appv1alpha2.PolicySetDeletionPolicyRetain,
+		},
+	}
+	// the finalizer is already set, thus the conditions set before the policy set is created are not reloaded from the object
+	policySet.Finalizers = []string{policySetFinalizer}
+	f := newFakeAPI(t, policySet)
+	r := &PolicySetReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, policySet)
+	requireSynced(t, policySet.Status.Conditions)
+	require.NotEmpty(t, policySet.Status.ID)
+	assert.True(t, meta.IsStatusConditionTrue(policySet.Status.Conditions, appv1alpha2.ConditionTypeSpecValid))
+}
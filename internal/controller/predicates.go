@@ -9,6 +9,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
 // genericPredicates return predicates that are common for all controllers.
@@ -81,7 +83,7 @@ func workspacePredicates() predicate.Predicate {
 			}
 			// Validate if a certain annotation persists in a new object and does not match the old one.
 			// In that case, it is a new or updated annotation and we need to trigger a reconciliation cycle.
-			if a, ok := e.ObjectNew.GetAnnotations()[WorkspaceAnnotationRunNew]; ok && a == MetaTrue {
+			if a, ok := e.ObjectNew.GetAnnotations()[appv1alpha2.WorkspaceAnnotationRunNew]; ok && a == MetaTrue {
 				return true
 			}
 
@@ -96,9 +98,14 @@ func deletionTimestampPredicate(o client.Object) bool {
 		agentPoolFinalizer,
 		agentTokenFinalizer,
 		moduleFinalizer,
+		organizationRunTaskFinalizer,
+		policySetFinalizer,
 		projectFinalizer,
 		runsCollectorFinalizer,
+		teamFinalizer,
+		variableSetFinalizer,
 		workspaceFinalizer,
+		workspaceRunFinalizer,
 	}
 
 	// Do not trigger reconciliation if the object was deleted with the `foreground` option.
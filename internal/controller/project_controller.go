@@ -5,34 +5,30 @@ package controller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
-	"os"
-	"strconv"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
-	"github.com/hashicorp/hcp-terraform-operator/version"
 )
 
 // ProjectReconciler reconciles a Project object
 type ProjectReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
 }
 
 type projectInstance struct {
@@ -74,9 +70,14 @@ func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if err := p.instance.ValidateSpec(); err != nil {
 		p.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
 		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&p.instance.Status.Conditions, p.instance.Generation, err)
+		if err := r.Status().Update(ctx, &p.instance); err != nil {
+			p.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
 		return doNotRequeue()
 	}
 	p.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&p.instance.Status.Conditions, p.instance.Generation, nil)
 
 	if needToAddFinalizer(&p.instance, projectFinalizer) {
 		err := r.addFinalizer(ctx, &p.instance)
@@ -93,13 +94,38 @@ func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if err != nil {
 		p.log.Error(err, "Project Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &p.instance); err != nil {
+			p.log.Error(err, "Project Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
+	if p.instance.Spec.ManagementMode == appv1alpha2.ManagementModeObserve {
+		err = r.observeProject(ctx, &p)
+		if err != nil {
+			p.log.Error(err, "Project Controller", "msg", "observe project")
+			r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcileProject", "Failed to observe project")
+			setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+			if err := r.Status().Update(ctx, &p.instance); err != nil {
+				p.log.Error(err, "Project Controller", "msg", "failed to update status conditions")
+			}
+			return requeueAfter(requeueInterval)
+		}
+		p.log.Info("Project Controller", "msg", "successfully observed project")
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeNormal, "ReconcileProject", "Successfully observed project ID %s", p.instance.Status.ID)
+
+		return requeueAfter(ProjectSyncPeriod)
+	}
+
 	err = r.reconcileProject(ctx, &p)
 	if err != nil {
 		p.log.Error(err, "Project Controller", "msg", "reconcile project")
 		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcileProject", "Failed to reconcile project")
+		setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &p.instance); err != nil {
+			p.log.Error(err, "Project Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 	p.log.Info("Project Controller", "msg", "successfully reconcilied project")
@@ -115,55 +141,30 @@ func (r *ProjectReconciler) addFinalizer(ctx context.Context, instance *appv1alp
 }
 
 func (r *ProjectReconciler) getTerraformClient(ctx context.Context, p *projectInstance) error {
-	nn := types.NamespacedName{
-		Namespace: p.instance.Namespace,
-		Name:      p.instance.Spec.Token.SecretKeyRef.Name,
-	}
-	token, err := secretKeyRef(ctx, r.Client, nn, p.instance.Spec.Token.SecretKeyRef.Key)
-	if err != nil {
-		return err
-	}
-
-	httpClient := tfc.DefaultConfig().HTTPClient
-	insecure := false
-
-	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
-		insecure, err = strconv.ParseBool(v)
-		if err != nil {
-			return err
-		}
-	}
-
-	if insecure {
-		p.log.Info("Reconcile Project", "msg", "client configured to skip TLS certificate verifications")
-	}
-
-	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
-
-	config := &tfc.Config{
-		Token:      token,
-		HTTPClient: httpClient,
-		Headers: http.Header{
-			"User-Agent": []string{version.UserAgent},
-		},
-	}
-	p.tfClient.Client, err = tfc.NewClient(config)
+	var err error
+	p.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, p.log, p.instance.Namespace, p.instance.Spec.Organization, p.instance.Spec.Token, p.instance.Spec.ConnectionRef)
 
 	return err
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.Project{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
-		Complete(r)
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.Project{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.Project{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.ProjectList{}).Complete(r)
 }
 
 func (r *ProjectReconciler) updateStatus(ctx context.Context, p *projectInstance, project *tfc.Project) error {
 	p.instance.Status.ObservedGeneration = p.instance.Generation
 	p.instance.Status.ID = project.ID
 	p.instance.Status.Name = project.Name
+	p.instance.Status.Observation = nil
+	setSyncedCondition(&p.instance.Status.Conditions, p.instance.Generation, "", nil)
 
 	return r.Status().Update(ctx, &p.instance)
 }
@@ -207,9 +208,7 @@ func (r *ProjectReconciler) createProject(ctx context.Context, p *projectInstanc
 		return nil, err
 	}
 
-	p.instance.Status = appv1alpha2.ProjectStatus{
-		ID: project.ID,
-	}
+	p.instance.Status.ID = project.ID
 
 	return project, nil
 }
@@ -246,8 +245,6 @@ func (r *ProjectReconciler) reconcileProject(ctx context.Context, p *projectInst
 		// Cannot call updateStatus method since it updated multiple fields and can break reconciliation logic.
 		//
 		// TODO:
-		// - Use conditions(https://maelvls.dev/kubernetes-conditions/)
-		// - Let Objects update their own status conditions
 		// - Simplify updateStatus method in a way it could be called anytime
 		if project != nil && project.ID != "" {
 			p.instance.Status.ID = project.ID
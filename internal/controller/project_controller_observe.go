@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// readObservedProject reads the project by the ID from the status or, if it is not known yet, by the name from the spec.
+func (r *ProjectReconciler) readObservedProject(ctx context.Context, p *projectInstance) (*tfc.Project, error) {
+	if p.instance.Status.ID != "" {
+		return r.readProject(ctx, p)
+	}
+
+	listOpts := &tfc.ProjectListOptions{
+		Name: p.instance.Spec.Name,
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		projects, err := p.tfClient.Client.Projects.List(ctx, p.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, project := range projects.Items {
+			if project.Name == p.instance.Spec.Name {
+				return project, nil
+			}
+		}
+		if projects.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = projects.NextPage
+	}
+
+	return nil, fmt.Errorf("project %q not found", p.instance.Spec.Name)
+}
+
+// observeProject reads the project and reports the differences from the spec without making any changes in HCP Terraform.
+func (r *ProjectReconciler) observeProject(ctx context.Context, p *projectInstance) error {
+	p.log.Info("Observe Project", "msg", "observing project")
+
+	// the project is kept when the object is deleted in the observe management mode
+	if isDeletionCandidate(&p.instance, projectFinalizer) {
+		p.log.Info("Observe Project", "msg", "object marked as deleted, the project is kept in the observe management mode")
+		r.Recorder.Event(&p.instance, corev1.EventTypeNormal, "ReconcileProject", "Object marked as deleted, the project is kept in the observe management mode")
+		return r.removeFinalizer(ctx, p)
+	}
+
+	project, err := r.readObservedProject(ctx, p)
+	if err != nil {
+		p.log.Error(err, "Observe Project", "msg", "failed to read project")
+		r.Recorder.Event(&p.instance, corev1.EventTypeWarning, "ReconcileProject", "Failed to read project")
+		return err
+	}
+	p.instance.Status.ID = project.ID
+	p.instance.Status.Name = project.Name
+
+	var diff []string
+	if project.Name != p.instance.Spec.Name {
+		diff = append(diff, "spec.name")
+	}
+
+	specTeamAccess, err := r.getInstanceTeamAccess(ctx, p)
+	if err != nil {
+		p.log.Error(err, "Observe Project", "msg", "failed to get instance team access")
+		return err
+	}
+	projectTeamAccess, err := r.getWorkspaceTeamAccess(ctx, p)
+	if err != nil {
+		p.log.Error(err, "Observe Project", "msg", "failed to get project team access")
+		return err
+	}
+	if len(getTeamProjectAccessToCreate(specTeamAccess, projectTeamAccess)) > 0 ||
+		len(getTeamProjectAccessToUpdate(specTeamAccess, projectTeamAccess)) > 0 ||
+		len(getTeamProjectAccessToDelete(specTeamAccess, projectTeamAccess)) > 0 {
+		diff = append(diff, "spec.teamAccess")
+	}
+
+	if len(diff) > 0 {
+		p.log.Info("Observe Project", "msg", fmt.Sprintf("observed differences from the spec in project ID %s: %s", project.ID, strings.Join(diff, ", ")))
+		r.Recorder.Eventf(&p.instance, corev1.EventTypeWarning, "ReconcileProject", "Observed differences from the spec in project ID %s: %s", project.ID, strings.Join(diff, ", "))
+	}
+
+	p.instance.Status.ObservedGeneration = p.instance.Generation
+	p.instance.Status.Observation = &appv1alpha2.ObservationStatus{
+		ObservedAt: metav1.Now(),
+		Diff:       diff,
+	}
+	setObservedCondition(&p.instance.Status.Conditions, p.instance.Generation, diff)
+
+	return r.Status().Update(ctx, &p.instance)
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestProjectReconcile(t *testing.T) {
+	t.Parallel()
+
+	project := &appv1alpha2.Project{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.ProjectSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			TeamAccess: []*appv1alpha2.ProjectTeamAccess{
+				{
+					Team:   appv1alpha2.TeamRef{Name: "team"},
+					Access: tfc.TeamProjectAccessRead,
+				},
+			},
+			DeletionPolicy: appv1alpha2.ProjectDeletionPolicySoft,
+		},
+	}
+	f := newFakeAPI(t, project)
+	team := f.server.AddTeam(fakeOrganization, "team")
+	r := &ProjectReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, project)
+	requireSynced(t, project.Status.Conditions)
+	assert.Contains(t, project.Finalizers, projectFinalizer)
+	p := f.server.Project(project.Status.ID)
+	require.NotNil(t, p)
+	assert.Equal(t, "this", p.Name)
+	access := f.server.TeamProjectAccess(p.ID)
+	require.Len(t, access, 1)
+	assert.Equal(t, team.ID, access[0].Team.ID)
+	assert.Equal(t, tfc.TeamProjectAccessRead, access[0].Access)
+
+	project.Spec.Name = "that"
+	project.Spec.TeamAccess[0].Access = tfc.TeamProjectAccessAdmin
+	project.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), project))
+	f.reconcile(t, r, project)
+	requireSynced(t, project.Status.Conditions)
+	assert.Equal(t, "that", f.server.Project(p.ID).Name)
+	access = f.server.TeamProjectAccess(p.ID)
+	require.Len(t, access, 1)
+	assert.Equal(t, tfc.TeamProjectAccessAdmin, access[0].Access)
+
+	f.delete(t, project)
+	f.reconcile(t, r, project)
+	assert.False(t, f.exists(t, project))
+	assert.Nil(t, f.server.Project(p.ID))
+}
+
+func TestProjectReconcileCreateKeepsConditions(t *testing.T) {
+	t.Parallel()
+
+	project := &appv1alpha2.Project{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.ProjectSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			DeletionPolicy: appv1alpha2.ProjectDeletionPolicyRetain,
+		},
+	}
+	// the finalizer is already set, thus the conditions set before the project is created are not reloaded from the object
+	project.Finalizers = []string{projectFinalizer}
+	f := newFakeAPI(t, project)
+	r := &ProjectReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, project)
+	requireSynced(t, project.Status.Conditions)
+	require.NotEmpty(t, project.Status.ID)
+	assert.True(t, meta.IsStatusConditionTrue(project.Status.Conditions, appv1alpha2.ConditionTypeSpecValid))
+}
+
+func TestProjectReconcileInvalidToken(t *testing.T) {
+	t.Parallel()
+
+	project := &appv1alpha2.Project{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.ProjectSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, project)
+	f.server.Token = "other"
+	r := &ProjectReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, project)
+	assert.Empty(t, project.Status.ID)
+	assert.False(t, meta.IsStatusConditionTrue(project.Status.Conditions, appv1alpha2.ConditionTypeSynced))
+}
+
+func TestProjectReconcileObserve(t *testing.T) {
+	t.Parallel()
+
+	project := &appv1alpha2.Project{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.ProjectSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			TeamAccess: []*appv1alpha2.ProjectTeamAccess{
+				{
+					Team:   appv1alpha2.TeamRef{Name: "team"},
+					Access: tfc.TeamProjectAccessRead,
+				},
+			},
+			ManagementMode: appv1alpha2.ManagementModeObserve,
+		},
+	}
+	f := newFakeAPI(t, project)
+	f.server.AddTeam(fakeOrganization, "team")
+	r := &ProjectReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	existing, err := c.Projects.Create(context.TODO(), fakeOrganization, tfc.ProjectCreateOptions{Name: "this"})
+	require.NoError(t, err)
+
+	// The project is found by name, the missing team access is reported but not created.
+	f.reconcile(t, r, project)
+	assert.Equal(t, existing.ID, project.Status.ID)
+	require.NotNil(t, project.Status.Observation)
+	assert.Equal(t, []string{"spec.teamAccess"}, project.Status.Observation.Diff)
+	c1 := meta.FindStatusCondition(project.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c1)
+	assert.Equal(t, appv1alpha2.ConditionReasonObservedDiff, c1.Reason)
+	assert.Empty(t, f.server.TeamProjectAccess(existing.ID))
+
+	// The project is kept when the object is deleted.
+	f.delete(t, project)
+	f.reconcile(t, r, project)
+	assert.False(t, f.exists(t, project))
+	assert.NotNil(t, f.server.Project(existing.ID))
+}
@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// Field indexes of the objects a custom resource refers to.
+// All referred objects are in the same namespace as the custom resource.
+const (
+	secretRefsIndexKey    = ".spec.secretRefs"
+	configMapRefsIndexKey = ".spec.configMapRefs"
+	connectionRefIndexKey = ".spec.connectionRef"
+)
+
+// objectReferences contains the names of the Kubernetes objects a custom resource refers to.
+type objectReferences struct {
+	secrets    []string
+	configMaps []string
+	connection string
+}
+
+// addToken adds the Kubernetes Secret of a given API token.
+func (r *objectReferences) addToken(token *appv1alpha2.Token) {
+	if token != nil && token.SecretKeyRef != nil {
+		r.secrets = append(r.secrets, token.SecretKeyRef.Name)
+	}
+}
+
+// addValueFrom adds the Kubernetes Secret or ConfigMap of a given ValueFrom.
+func (r *objectReferences) addValueFrom(v *appv1alpha2.ValueFrom) {
+	if v == nil {
+		return
+	}
+	if v.ConfigMapKeyRef != nil {
+		r.configMaps = append(r.configMaps, v.ConfigMapKeyRef.Name)
+	}
+	if v.SecretKeyRef != nil {
+		r.secrets = append(r.secrets, v.SecretKeyRef.Name)
+	}
+}
+
+// addConnectionRef adds a given Connection.
+func (r *objectReferences) addConnectionRef(ref *corev1.LocalObjectReference) {
+	if ref != nil {
+		r.connection = ref.Name
+	}
+}
+
+// references returns the Kubernetes Secrets, ConfigMaps, and Connection a given object refers to.
+// Secrets where the operator writes to, such as agent tokens or outputs, are not included.
+func references(o client.Object) objectReferences {
+	r := objectReferences{}
+
+	switch obj := o.(type) {
+	case *appv1alpha2.AgentPool:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	case *appv1alpha2.AgentToken:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	case *appv1alpha2.Connection:
+		r.addToken(obj.Spec.Token)
+		if obj.Spec.TLS != nil {
+			r.addValueFrom(obj.Spec.TLS.CABundle)
+			if ref := obj.Spec.TLS.ClientCertificateSecretRef; ref != nil {
+				r.secrets = append(r.secrets, ref.Name)
+			}
+		}
+	case *appv1alpha2.Module:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	case *appv1alpha2.OrganizationRunTask:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+		r.addToken(obj.Spec.HMACKey)
+	case *appv1alpha2.PolicySet:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+		for _, p := range obj.Spec.Policies {
+			r.addValueFrom(p.ContentFrom)
+		}
+		for _, p := range obj.Spec.Parameters {
+			r.addValueFrom(p.ValueFrom)
+		}
+	case *appv1alpha2.Project:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	case *appv1alpha2.RunsCollector:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	case *appv1alpha2.Team:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	case *appv1alpha2.VariableSet:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+		for _, v := range obj.Spec.TerraformVariables {
+			r.addValueFrom(v.ValueFrom)
+		}
+		for _, v := range obj.Spec.EnvironmentVariables {
+			r.addValueFrom(v.ValueFrom)
+		}
+	case *appv1alpha2.Workspace:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+		for _, v := range obj.Spec.TerraformVariables {
+			r.addValueFrom(v.ValueFrom)
+		}
+		for _, v := range obj.Spec.EnvironmentVariables {
+			r.addValueFrom(v.ValueFrom)
+		}
+	case *appv1alpha2.WorkspaceRun:
+		r.addToken(obj.Spec.Token)
+		r.addConnectionRef(obj.Spec.ConnectionRef)
+	}
+
+	slices.Sort(r.secrets)
+	r.secrets = slices.Compact(r.secrets)
+	slices.Sort(r.configMaps)
+	r.configMaps = slices.Compact(r.configMaps)
+
+	return r
+}
+
+// referenceIndexers returns field indexers of the Kubernetes Secrets, ConfigMaps, and Connection an object refers to.
+func referenceIndexers() map[string]client.IndexerFunc {
+	return map[string]client.IndexerFunc{
+		secretRefsIndexKey: func(o client.Object) []string {
+			return references(o).secrets
+		},
+		configMapRefsIndexKey: func(o client.Object) []string {
+			return references(o).configMaps
+		},
+		connectionRefIndexKey: func(o client.Object) []string {
+			if c := references(o).connection; c != "" {
+				return []string{c}
+			}
+			return nil
+		},
+	}
+}
+
+// setupReferenceIndexers registers field indexes of the Kubernetes Secrets, ConfigMaps, and Connection a given object kind refers to.
+func setupReferenceIndexers(mgr ctrl.Manager, obj client.Object) error {
+	for key, indexer := range referenceIndexers() {
+		if err := mgr.GetFieldIndexer().IndexField(context.Background(), obj, key, indexer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchReferences configures a controller to reconcile objects of the kind of a given list
+// when a Kubernetes Secret, ConfigMap, or Connection they refer to changes.
+// The field indexes of the kind must be registered with setupReferenceIndexers.
+func watchReferences(mgr ctrl.Manager, b *builder.Builder, list client.ObjectList) *builder.Builder {
+	mapFunc := handler.EnqueueRequestsFromMapFunc(referrersMapFunc(mgr.GetClient(), list))
+
+	return b.
+		Watches(&corev1.Secret{}, mapFunc, builder.WithPredicates(referencePredicates())).
+		Watches(&corev1.ConfigMap{}, mapFunc, builder.WithPredicates(referencePredicates())).
+		Watches(&appv1alpha2.Connection{}, mapFunc, builder.WithPredicates(referencePredicates()))
+}
+
+// referencePredicates returns predicates for the objects custom resources refer to.
+func referencePredicates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// ResourceVersions of new and old objects are equal on periodic resyncs.
+			return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// referrersMapFunc returns a function that maps a Kubernetes Secret, ConfigMap, or Connection to reconcile requests
+// for all objects of the kind of a given list that refer to it directly, or via a Connection.
+func referrersMapFunc(c client.Client, list client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		var key string
+		switch o.(type) {
+		case *corev1.Secret:
+			key = secretRefsIndexKey
+		case *corev1.ConfigMap:
+			key = configMapRefsIndexKey
+		case *appv1alpha2.Connection:
+			key = connectionRefIndexKey
+		default:
+			return nil
+		}
+
+		requests := referrers(ctx, c, list, o.GetNamespace(), key, o.GetName())
+		if key == connectionRefIndexKey {
+			return requests
+		}
+
+		// The Connection kind has no controller and therefore no field indexes.
+		connections := &appv1alpha2.ConnectionList{}
+		if err := c.List(ctx, connections, client.InNamespace(o.GetNamespace())); err != nil {
+			log.FromContext(ctx).Error(err, "Watch References", "msg", "failed to list connections")
+			return requests
+		}
+		for _, conn := range connections.Items {
+			r := references(&conn)
+			if (key == secretRefsIndexKey && slices.Contains(r.secrets, o.GetName())) ||
+				(key == configMapRefsIndexKey && slices.Contains(r.configMaps, o.GetName())) {
+				requests = append(requests, referrers(ctx, c, list, o.GetNamespace(), connectionRefIndexKey, conn.Name)...)
+			}
+		}
+
+		return requests
+	}
+}
+
+// referrers returns reconcile requests for all objects of the kind of a given list in a given namespace
+// whose field index matches a given value.
+func referrers(ctx context.Context, c client.Client, list client.ObjectList, namespace, key, value string) []reconcile.Request {
+	l := list.DeepCopyObject().(client.ObjectList)
+	if err := c.List(ctx, l, client.InNamespace(namespace), client.MatchingFields{key: value}); err != nil {
+		log.FromContext(ctx).Error(err, "Watch References", "msg", "failed to list referring objects")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	_ = meta.EachListItem(l, func(obj runtime.Object) error {
+		if o, ok := obj.(client.Object); ok {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()},
+			})
+		}
+		return nil
+	})
+
+	return requests
+}
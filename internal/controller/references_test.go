@@ -0,0 +1,251 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func secretToken(name string) *appv1alpha2.Token {
+	return &appv1alpha2.Token{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  "token",
+		},
+	}
+}
+
+func TestReferences(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]struct {
+		obj      client.Object
+		expected objectReferences
+	}{
+		"Token": {
+			obj: &appv1alpha2.Project{
+				Spec: appv1alpha2.ProjectSpec{Token: secretToken("token")},
+			},
+			expected: objectReferences{secrets: []string{"token"}},
+		},
+		"ConnectionRef": {
+			obj: &appv1alpha2.AgentPool{
+				Spec: appv1alpha2.AgentPoolSpec{ConnectionRef: &corev1.LocalObjectReference{Name: "this"}},
+			},
+			expected: objectReferences{connection: "this"},
+		},
+		"Connection": {
+			obj: &appv1alpha2.Connection{
+				Spec: appv1alpha2.ConnectionSpec{
+					Token: secretToken("token"),
+					TLS: &appv1alpha2.ConnectionTLS{
+						CABundle: &appv1alpha2.ValueFrom{
+							ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "ca"},
+								Key:                  "ca.crt",
+							},
+						},
+						ClientCertificateSecretRef: &corev1.LocalObjectReference{Name: "client"},
+					},
+				},
+			},
+			expected: objectReferences{secrets: []string{"client", "token"}, configMaps: []string{"ca"}},
+		},
+		"WorkspaceVariables": {
+			obj: &appv1alpha2.Workspace{
+				Spec: appv1alpha2.WorkspaceSpec{
+					Token: secretToken("token"),
+					TerraformVariables: []appv1alpha2.Variable{
+						{
+							Name: "this",
+							ValueFrom: &appv1alpha2.ValueFrom{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "token"},
+									Key:                  "this",
+								},
+							},
+						},
+						{
+							Name:  "plain",
+							Value: "plain",
+						},
+					},
+					EnvironmentVariables: []appv1alpha2.Variable{
+						{
+							Name: "that",
+							ValueFrom: &appv1alpha2.ValueFrom{
+								ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "vars"},
+									Key:                  "that",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: objectReferences{secrets: []string{"token"}, configMaps: []string{"vars"}},
+		},
+		"OrganizationRunTaskHMACKey": {
+			obj: &appv1alpha2.OrganizationRunTask{
+				Spec: appv1alpha2.OrganizationRunTaskSpec{
+					Token:   secretToken("token"),
+					HMACKey: secretToken("hmac"),
+				},
+			},
+			expected: objectReferences{secrets: []string{"hmac", "token"}},
+		},
+		"PolicySetContentAndParameters": {
+			obj: &appv1alpha2.PolicySet{
+				Spec: appv1alpha2.PolicySetSpec{
+					ConnectionRef: &corev1.LocalObjectReference{Name: "this"},
+					Policies: []appv1alpha2.PolicySetPolicy{
+						{
+							Name: "this",
+							ContentFrom: &appv1alpha2.ValueFrom{
+								ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "policies"},
+									Key:                  "this",
+								},
+							},
+						},
+					},
+					Parameters: []appv1alpha2.PolicySetParameter{
+						{
+							Name: "this",
+							ValueFrom: &appv1alpha2.ValueFrom{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "params"},
+									Key:                  "this",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: objectReferences{secrets: []string{"params"}, configMaps: []string{"policies"}, connection: "this"},
+		},
+		"TeamConnectionRef": {
+			obj: &appv1alpha2.Team{
+				Spec: appv1alpha2.TeamSpec{
+					Token:         secretToken("token"),
+					ConnectionRef: &corev1.LocalObjectReference{Name: "this"},
+				},
+			},
+			expected: objectReferences{secrets: []string{"token"}, connection: "this"},
+		},
+		"VariableSetVariables": {
+			obj: &appv1alpha2.VariableSet{
+				Spec: appv1alpha2.VariableSetSpec{
+					ConnectionRef: &corev1.LocalObjectReference{Name: "this"},
+					EnvironmentVariables: []appv1alpha2.Variable{
+						{
+							Name: "this",
+							ValueFrom: &appv1alpha2.ValueFrom{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "vars"},
+									Key:                  "this",
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: objectReferences{secrets: []string{"vars"}, connection: "this"},
+		},
+		"WorkspaceRunToken": {
+			obj: &appv1alpha2.WorkspaceRun{
+				Spec: appv1alpha2.WorkspaceRunSpec{
+					Token: secretToken("token"),
+				},
+			},
+			expected: objectReferences{secrets: []string{"token"}},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, c.expected, references(c.obj))
+		})
+	}
+}
+
+func TestReferrersMapFunc(t *testing.T) {
+	t.Parallel()
+
+	namespace := "default"
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	b := fake.NewClientBuilder().WithScheme(scheme)
+	for key, indexer := range referenceIndexers() {
+		b = b.WithIndex(&appv1alpha2.Project{}, key, indexer)
+	}
+	project := func(name string, token *appv1alpha2.Token, connectionRef *corev1.LocalObjectReference) *appv1alpha2.Project {
+		return &appv1alpha2.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: appv1alpha2.ProjectSpec{
+				Organization:  "kubernetes-operator",
+				Name:          name,
+				Token:         token,
+				ConnectionRef: connectionRef,
+			},
+		}
+	}
+	c := b.WithObjects(
+		project("token", secretToken("token"), nil),
+		project("connection", nil, &corev1.LocalObjectReference{Name: "this"}),
+		project("other", secretToken("other"), nil),
+		&appv1alpha2.Connection{
+			ObjectMeta: metav1.ObjectMeta{Name: "this", Namespace: namespace},
+			Spec:       appv1alpha2.ConnectionSpec{Token: secretToken("token")},
+		},
+	).Build()
+
+	request := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+	}
+	mapFunc := referrersMapFunc(c, &appv1alpha2.ProjectList{})
+
+	successCases := map[string]struct {
+		obj      client.Object
+		expected []reconcile.Request
+	}{
+		"Secret": {
+			obj:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: namespace}},
+			expected: []reconcile.Request{request("token"), request("connection")},
+		},
+		"SecretOtherNamespace": {
+			obj:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "other"}},
+			expected: nil,
+		},
+		"UnreferencedConfigMap": {
+			obj:      &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: namespace}},
+			expected: nil,
+		},
+		"Connection": {
+			obj:      &appv1alpha2.Connection{ObjectMeta: metav1.ObjectMeta{Name: "this", Namespace: namespace}},
+			expected: []reconcile.Request{request("connection")},
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			assert.ElementsMatch(t, c.expected, mapFunc(context.TODO(), c.obj))
+		})
+	}
+}
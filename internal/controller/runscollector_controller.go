@@ -5,27 +5,22 @@ package controller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
-	"os"
-	"strconv"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
-	"github.com/hashicorp/hcp-terraform-operator/version"
 )
 
 var runStatuses = []tfc.RunStatus{
@@ -64,8 +59,9 @@ var runStatuses = []tfc.RunStatus{
 // RunsCollectorReconciler reconciles a RunsCollector object
 type RunsCollectorReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
 }
 
 type runsCollectorInstance struct {
@@ -114,9 +110,14 @@ func (r *RunsCollectorReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if err := rc.instance.ValidateSpec(); err != nil {
 		rc.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
 		r.Recorder.Event(&rc.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&rc.instance.Status.Conditions, rc.instance.Generation, err)
+		if err := r.Status().Update(ctx, &rc.instance); err != nil {
+			rc.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
 		return doNotRequeue()
 	}
 	rc.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&rc.instance.Status.Conditions, rc.instance.Generation, nil)
 
 	if needToAddFinalizer(&rc.instance, runsCollectorFinalizer) {
 		err := r.addFinalizer(ctx, &rc.instance)
@@ -133,6 +134,10 @@ func (r *RunsCollectorReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if err != nil {
 		rc.log.Error(err, "Runs Collector Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&rc.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&rc.instance.Status.Conditions, rc.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &rc.instance); err != nil {
+			rc.log.Error(err, "Runs Collector Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
@@ -140,6 +145,10 @@ func (r *RunsCollectorReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if err != nil {
 		rc.log.Error(err, "Runs Collector Controller", "msg", "Reconcile Runs")
 		r.Recorder.Event(&rc.instance, corev1.EventTypeWarning, "ReconcileRunsCollector", "Failed to Reconcile Runs")
+		setSyncedCondition(&rc.instance.Status.Conditions, rc.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &rc.instance); err != nil {
+			rc.log.Error(err, "Runs Collector Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 	rc.log.Info("Runs Collector Controller", "msg", "successfully reconcilied runs")
@@ -155,49 +164,22 @@ func (r *RunsCollectorReconciler) addFinalizer(ctx context.Context, instance *ap
 }
 
 func (r *RunsCollectorReconciler) getTerraformClient(ctx context.Context, t *runsCollectorInstance) error {
-	nn := types.NamespacedName{
-		Namespace: t.instance.Namespace,
-		Name:      t.instance.Spec.Token.SecretKeyRef.Name,
-	}
-	token, err := secretKeyRef(ctx, r.Client, nn, t.instance.Spec.Token.SecretKeyRef.Key)
-	if err != nil {
-		return err
-	}
-
-	httpClient := tfc.DefaultConfig().HTTPClient
-	insecure := false
-
-	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
-		insecure, err = strconv.ParseBool(v)
-		if err != nil {
-			return err
-		}
-	}
-
-	if insecure {
-		t.log.Info("Reconcile Runs Collector", "msg", "client configured to skip TLS certificate verifications")
-	}
-
-	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
-
-	config := &tfc.Config{
-		Token:      token,
-		HTTPClient: httpClient,
-		Headers: http.Header{
-			"User-Agent": []string{version.UserAgent},
-		},
-	}
-	t.tfClient.Client, err = tfc.NewClient(config)
+	var err error
+	t.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, t.log, t.instance.Namespace, t.instance.Spec.Organization, t.instance.Spec.Token, t.instance.Spec.ConnectionRef)
 
 	return err
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RunsCollectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.RunsCollector{}).
-		WithEventFilter(predicate.Or(genericPredicates())).
-		Complete(r)
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.RunsCollector{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.RunsCollector{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.RunsCollectorList{}).Complete(r)
 }
 
 func (r *RunsCollectorReconciler) removeFinalizer(ctx context.Context, rc *runsCollectorInstance) error {
@@ -323,6 +305,7 @@ func (r *RunsCollectorReconciler) reconcileRuns(ctx context.Context, rc *runsCol
 	).Set(runsTotal)
 
 	rc.instance.Status.ObservedGeneration = rc.instance.Generation
+	setSyncedCondition(&rc.instance.Status.Conditions, rc.instance.Generation, "", nil)
 
 	return r.Status().Update(ctx, &rc.instance)
 }
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestRunsCollectorReconcile(t *testing.T) {
+	t.Parallel()
+
+	collector := &appv1alpha2.RunsCollector{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.RunsCollectorSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			AgentPool:     &appv1alpha2.AgentPoolRef{Name: "runs-collector"},
+		},
+	}
+	f := newFakeAPI(t, collector)
+	r := &RunsCollectorReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	ctx := context.TODO()
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	pool, err := c.AgentPools.Create(ctx, fakeOrganization, tfc.AgentPoolCreateOptions{Name: tfc.String("runs-collector")})
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(ctx, fakeOrganization, tfc.WorkspaceCreateOptions{
+		Name:          tfc.String("runs-collector"),
+		ExecutionMode: tfc.String("agent"),
+		AgentPoolID:   tfc.String(pool.ID),
+	})
+	require.NoError(t, err)
+	for _, status := range []tfc.RunStatus{tfc.RunPlanning, tfc.RunPlanning, tfc.RunApplied} {
+		run, err := c.Runs.Create(ctx, tfc.RunCreateOptions{Workspace: ws})
+		require.NoError(t, err)
+		require.NoError(t, f.server.SetRunStatus(run.ID, status))
+	}
+
+	f.reconcile(t, r, collector)
+	requireSynced(t, collector.Status.Conditions)
+	assert.Contains(t, collector.Finalizers, runsCollectorFinalizer)
+	require.NotNil(t, collector.Status.AgentPool)
+	assert.Equal(t, pool.ID, collector.Status.AgentPool.ID)
+	assert.Equal(t, float64(2), testutil.ToFloat64(MetricRunsTotal.WithLabelValues(pool.ID, pool.Name)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(MetricRuns.WithLabelValues(string(tfc.RunPlanning), pool.ID, pool.Name)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(MetricRuns.WithLabelValues(string(tfc.RunApplying), pool.ID, pool.Name)))
+
+	f.delete(t, collector)
+	f.reconcile(t, r, collector)
+	assert.False(t, f.exists(t, collector))
+}
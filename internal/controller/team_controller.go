@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// TeamReconciler reconciles a Team object
+type TeamReconciler struct {
+	client.Client
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
+}
+
+type teamInstance struct {
+	instance appv1alpha2.Team
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=teams,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=teams/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=teams/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *TeamReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	t := teamInstance{}
+
+	t.log = log.Log.WithValues("team", req.NamespacedName)
+	t.log.Info("Team Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &t.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			t.log.Info("Team Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		t.log.Error(err, "Team Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := t.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		t.log.Info("Team Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	t.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := t.instance.ValidateSpec(); err != nil {
+		t.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&t.instance.Status.Conditions, t.instance.Generation, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
+		return doNotRequeue()
+	}
+	t.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&t.instance.Status.Conditions, t.instance.Generation, nil)
+
+	if needToAddFinalizer(&t.instance, teamFinalizer) {
+		err := r.addFinalizer(ctx, &t.instance)
+		if err != nil {
+			t.log.Error(err, "Team Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", teamFinalizer))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", teamFinalizer)
+			return requeueOnErr(err)
+		}
+		t.log.Info("Team Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", teamFinalizer))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", teamFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &t)
+	if err != nil {
+		t.log.Error(err, "Team Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Team Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileTeam(ctx, &t)
+	if err != nil {
+		t.log.Error(err, "Team Controller", "msg", "reconcile team")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Failed to reconcile team")
+		setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &t.instance); err != nil {
+			t.log.Error(err, "Team Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+	t.log.Info("Team Controller", "msg", "successfully reconcilied team")
+	r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileTeam", "Successfully reconcilied team ID %s", t.instance.Status.ID)
+
+	return requeueAfter(TeamSyncPeriod)
+}
+
+func (r *TeamReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.Team) error {
+	controllerutil.AddFinalizer(instance, teamFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *TeamReconciler) getTerraformClient(ctx context.Context, t *teamInstance) error {
+	var err error
+	t.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, t.log, t.instance.Namespace, t.instance.Spec.Organization, t.instance.Spec.Token, t.instance.Spec.ConnectionRef)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TeamReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.Team{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.Team{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.TeamList{}).Complete(r)
+}
+
+func (r *TeamReconciler) updateStatus(ctx context.Context, t *teamInstance, team *tfc.Team) error {
+	t.instance.Status.ObservedGeneration = t.instance.Generation
+	t.instance.Status.ID = team.ID
+	t.instance.Status.Name = team.Name
+	setSyncedCondition(&t.instance.Status.Conditions, t.instance.Generation, "", nil)
+
+	return r.Status().Update(ctx, &t.instance)
+}
+
+func (r *TeamReconciler) removeFinalizer(ctx context.Context, t *teamInstance) error {
+	controllerutil.RemoveFinalizer(&t.instance, teamFinalizer)
+
+	err := r.Update(ctx, &t.instance)
+	if err != nil {
+		t.log.Error(err, "Reconcile Team", "msg", fmt.Sprintf("failed to remove finalizer %s", teamFinalizer))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "RemoveTeam", "Failed to remove finalizer %s", teamFinalizer)
+	}
+
+	return err
+}
+
+// teamOrganizationAccess returns the organization access of a given spec as the API represents it.
+func teamOrganizationAccess(access *appv1alpha2.TeamOrganizationAccess) tfc.OrganizationAccess {
+	if access == nil {
+		return tfc.OrganizationAccess{}
+	}
+
+	return tfc.OrganizationAccess{
+		ManagePolicies:           access.ManagePolicies,
+		ManagePolicyOverrides:    access.ManagePolicyOverrides,
+		DelegatePolicyOverrides:  access.DelegatePolicyOverrides,
+		ManageWorkspaces:         access.ManageWorkspaces,
+		ManageVCSSettings:        access.ManageVCSSettings,
+		ManageProviders:          access.ManageProviders,
+		ManageModules:            access.ManageModules,
+		ManageRunTasks:           access.ManageRunTasks,
+		ManageProjects:           access.ManageProjects,
+		ReadWorkspaces:           access.ReadWorkspaces,
+		ReadProjects:             access.ReadProjects,
+		ManageMembership:         access.ManageMembership,
+		ManageTeams:              access.ManageTeams,
+		ManageOrganizationAccess: access.ManageOrganizationAccess,
+		AccessSecretTeams:        access.AccessSecretTeams,
+		ManageAgentPools:         access.ManageAgentPools,
+	}
+}
+
+// teamOrganizationAccessOptions returns the organization access options of a given spec.
+// All permissions are set explicitly, so permissions removed from the spec get disabled.
+func teamOrganizationAccessOptions(access *appv1alpha2.TeamOrganizationAccess) *tfc.OrganizationAccessOptions {
+	a := teamOrganizationAccess(access)
+
+	return &tfc.OrganizationAccessOptions{
+		ManagePolicies:           tfc.Bool(a.ManagePolicies),
+		ManagePolicyOverrides:    tfc.Bool(a.ManagePolicyOverrides),
+		DelegatePolicyOverrides:  tfc.Bool(a.DelegatePolicyOverrides),
+		ManageWorkspaces:         tfc.Bool(a.ManageWorkspaces),
+		ManageVCSSettings:        tfc.Bool(a.ManageVCSSettings),
+		ManageProviders:          tfc.Bool(a.ManageProviders),
+		ManageModules:            tfc.Bool(a.ManageModules),
+		ManageRunTasks:           tfc.Bool(a.ManageRunTasks),
+		ManageProjects:           tfc.Bool(a.ManageProjects),
+		ReadWorkspaces:           tfc.Bool(a.ReadWorkspaces),
+		ReadProjects:             tfc.Bool(a.ReadProjects),
+		ManageMembership:         tfc.Bool(a.ManageMembership),
+		ManageTeams:              tfc.Bool(a.ManageTeams),
+		ManageOrganizationAccess: tfc.Bool(a.ManageOrganizationAccess),
+		AccessSecretTeams:        tfc.Bool(a.AccessSecretTeams),
+		ManageAgentPools:         tfc.Bool(a.ManageAgentPools),
+	}
+}
+
+func needToUpdateTeam(instance *appv1alpha2.Team, team *tfc.Team) bool {
+	// generation changed
+	if instance.Generation != instance.Status.ObservedGeneration {
+		return true
+	}
+
+	// attributes changed
+	spec := instance.Spec
+	if spec.Name != team.Name ||
+		spec.Visibility != team.Visibility ||
+		spec.SSOTeamID != team.SSOTeamID {
+		return true
+	}
+
+	var access tfc.OrganizationAccess
+	if team.OrganizationAccess != nil {
+		access = *team.OrganizationAccess
+	}
+
+	return teamOrganizationAccess(spec.OrganizationAccess) != access
+}
+
+func (r *TeamReconciler) createTeam(ctx context.Context, t *teamInstance) (*tfc.Team, error) {
+	spec := t.instance.Spec
+	options := tfc.TeamCreateOptions{
+		Name:               tfc.String(spec.Name),
+		Visibility:         tfc.String(spec.Visibility),
+		OrganizationAccess: teamOrganizationAccessOptions(spec.OrganizationAccess),
+	}
+	if spec.SSOTeamID != "" {
+		options.SSOTeamID = tfc.String(spec.SSOTeamID)
+	}
+
+	team, err := t.tfClient.Client.Teams.Create(ctx, spec.Organization, options)
+	if err != nil {
+		t.log.Error(err, "Reconcile Team", "msg", "failed to create a new team")
+		r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Failed to create a new team")
+		return nil, err
+	}
+
+	t.instance.Status.ID = team.ID
+
+	return team, nil
+}
+
+func (r *TeamReconciler) readTeam(ctx context.Context, t *teamInstance) (*tfc.Team, error) {
+	return t.tfClient.Client.Teams.Read(ctx, t.instance.Status.ID)
+}
+
+func (r *TeamReconciler) updateTeam(ctx context.Context, t *teamInstance) (*tfc.Team, error) {
+	spec := t.instance.Spec
+	options := tfc.TeamUpdateOptions{
+		Name:               tfc.String(spec.Name),
+		Visibility:         tfc.String(spec.Visibility),
+		SSOTeamID:          tfc.String(spec.SSOTeamID),
+		OrganizationAccess: teamOrganizationAccessOptions(spec.OrganizationAccess),
+	}
+
+	return t.tfClient.Client.Teams.Update(ctx, t.instance.Status.ID, options)
+}
+
+func (r *TeamReconciler) reconcileTeam(ctx context.Context, t *teamInstance) error {
+	t.log.Info("Reconcile Team", "msg", "reconciling team")
+
+	var team *tfc.Team
+	var err error
+
+	defer func() {
+		// Update the status with the Team ID. This is useful if the reconciliation failed.
+		// An example here would be the case when the team has been created successfully,
+		// but further reconciliation steps failed.
+		if team != nil && team.ID != "" {
+			t.instance.Status.ID = team.ID
+			err = r.Status().Update(ctx, &t.instance)
+			if err != nil {
+				t.log.Error(err, "Team Controller", "msg", "update status with team ID")
+				r.Recorder.Event(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Failed to update status with team ID")
+			}
+		}
+	}()
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the team
+	if isDeletionCandidate(&t.instance, teamFinalizer) {
+		t.log.Info("Reconcile Team", "msg", "object marked as deleted, need to delete team first")
+		r.Recorder.Event(&t.instance, corev1.EventTypeNormal, "ReconcileTeam", "Object marked as deleted, need to delete team first")
+		return r.deleteTeam(ctx, t)
+	}
+
+	// create a new team if team ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	if t.instance.IsCreationCandidate() {
+		t.log.Info("Reconcile Team", "msg", "status.ID is empty, creating a new team")
+		r.Recorder.Event(&t.instance, corev1.EventTypeNormal, "ReconcileTeam", "Status.ID is empty, creating a new team")
+		_, err = r.createTeam(ctx, t)
+		if err != nil {
+			return err
+		}
+		t.log.Info("Reconcile Team", "msg", "successfully created a new team")
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileTeam", "Successfully created a new team with ID %s", t.instance.Status.ID)
+	}
+
+	// read the HCP Terraform team to compare it with the Kubernetes object spec
+	team, err = r.readTeam(ctx, t)
+	if err != nil {
+		// 'ResourceNotFound' means that the team was removed from HCP Terraform bypass the operator
+		if err == tfc.ErrResourceNotFound {
+			t.log.Info("Reconcile Team", "msg", "team not found, creating a new team")
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Team ID %s not found, creating a new team", t.instance.Status.ID)
+			team, err = r.createTeam(ctx, t)
+			if err != nil {
+				return err
+			}
+			t.log.Info("Reconcile Team", "msg", "successfully created a new team")
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileTeam", "Successfully created a new team with ID %s", t.instance.Status.ID)
+		} else {
+			t.log.Error(err, "Reconcile Team", "msg", fmt.Sprintf("failed to read team ID %s", t.instance.Status.ID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Failed to read team ID %s", t.instance.Status.ID)
+			return err
+		}
+	}
+
+	// update team if any changes have been made in the Kubernetes object spec or HCP Terraform team
+	if needToUpdateTeam(&t.instance, team) {
+		t.log.Info("Reconcile Team", "msg", fmt.Sprintf("observed and desired states are not matching, need to update team ID %s", t.instance.Status.ID))
+		team, err = r.updateTeam(ctx, t)
+		if err != nil {
+			t.log.Error(err, "Reconcile Team", "msg", fmt.Sprintf("failed to update team ID %s", t.instance.Status.ID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Failed to update team ID %s", t.instance.Status.ID)
+			return err
+		}
+	} else {
+		t.log.Info("Reconcile Team", "msg", fmt.Sprintf("observed and desired states are matching, no need to update team ID %s", t.instance.Status.ID))
+	}
+
+	// Reconcile Members
+	err = r.reconcileMembers(ctx, t)
+	if err != nil {
+		t.log.Error(err, "Reconcile Members", "msg", fmt.Sprintf("failed to reconcile members of team ID %s", t.instance.Status.ID))
+		r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileMembers", "Failed to reconcile members of team ID %s", t.instance.Status.ID)
+		return err
+	}
+	t.log.Info("Reconcile Members", "msg", "successfully reconcilied members")
+	r.Recorder.Eventf(&t.instance, corev1.EventTypeNormal, "ReconcileMembers", "Reconcilied members of team ID %s", t.instance.Status.ID)
+
+	return r.updateStatus(ctx, t, team)
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *TeamReconciler) deleteTeam(ctx context.Context, t *teamInstance) error {
+	t.log.Info("Reconcile Team", "msg", fmt.Sprintf("deletion policy is %s", t.instance.Spec.DeletionPolicy))
+
+	if t.instance.Status.ID == "" {
+		t.log.Info("Reconcile Team", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", teamFinalizer))
+		return r.removeFinalizer(ctx, t)
+	}
+
+	switch t.instance.Spec.DeletionPolicy {
+	case appv1alpha2.TeamDeletionPolicyRetain:
+		t.log.Info("Reconcile Team", "msg", fmt.Sprintf("remove finalizer %s", teamFinalizer))
+		return r.removeFinalizer(ctx, t)
+	case appv1alpha2.TeamDeletionPolicyDestroy:
+		err := t.tfClient.Client.Teams.Delete(ctx, t.instance.Status.ID)
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				t.log.Info("Reconcile Team", "msg", "Team was not found, remove finalizer")
+				return r.removeFinalizer(ctx, t)
+			}
+			t.log.Error(err, "Reconcile Team", "msg", fmt.Sprintf("failed to delete team ID %s, retry later", t.instance.Status.ID))
+			r.Recorder.Eventf(&t.instance, corev1.EventTypeWarning, "ReconcileTeam", "Failed to delete team ID %s, retry later", t.instance.Status.ID)
+			return err
+		}
+
+		t.log.Info("Reconcile Team", "msg", fmt.Sprintf("Team ID %s has been deleted, remove finalizer", t.instance.Status.ID))
+		return r.removeFinalizer(ctx, t)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// getOrganizationMemberships returns a list of all organization memberships with their users.
+func getOrganizationMemberships(ctx context.Context, t *teamInstance) ([]*tfc.OrganizationMembership, error) {
+	var o []*tfc.OrganizationMembership
+
+	listOpts := &tfc.OrganizationMembershipListOptions{
+		Include: []tfc.OrgMembershipIncludeOpt{tfc.OrgMembershipUser},
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		m, err := t.tfClient.Client.OrganizationMemberships.List(ctx, t.instance.Spec.Organization, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, m.Items...)
+		if m.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = m.NextPage
+	}
+
+	return o, nil
+}
+
+// memberStatus returns the status of a given organization membership.
+func memberStatus(m *tfc.OrganizationMembership) appv1alpha2.TeamMemberStatus {
+	s := appv1alpha2.TeamMemberStatus{
+		ID:     m.ID,
+		Email:  m.Email,
+		Status: string(m.Status),
+	}
+	if m.User != nil {
+		s.Username = m.User.Username
+	}
+
+	return s
+}
+
+// findOrganizationMembership returns the organization membership of a given spec member or nil if the user is not a member of the organization.
+// Usernames match exactly, email addresses are case-insensitive.
+func findOrganizationMembership(memberships []*tfc.OrganizationMembership, member appv1alpha2.TeamMember) *tfc.OrganizationMembership {
+	for _, m := range memberships {
+		if member.Username != "" && m.User != nil && m.User.Username == member.Username {
+			return m
+		}
+		if member.Email != "" && strings.EqualFold(m.Email, member.Email) {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// getSpecMembers returns the organization memberships of the spec members and the email addresses of users that need to be invited to the organization.
+func getSpecMembers(ctx context.Context, t *teamInstance) ([]appv1alpha2.TeamMemberStatus, []string, error) {
+	if len(t.instance.Spec.Members) == 0 {
+		return nil, nil, nil
+	}
+
+	memberships, err := getOrganizationMemberships(ctx, t)
+	if err != nil {
+		t.log.Error(err, "Reconcile Members", "msg", "failed to get organization memberships")
+		return nil, nil, err
+	}
+
+	var members []appv1alpha2.TeamMemberStatus
+	var invite []string
+	for _, sm := range t.instance.Spec.Members {
+		m := findOrganizationMembership(memberships, sm)
+		if m != nil {
+			members = append(members, memberStatus(m))
+			continue
+		}
+		if sm.Username != "" {
+			return nil, nil, fmt.Errorf("user %q is not a member of organization %q", sm.Username, t.instance.Spec.Organization)
+		}
+		invite = append(invite, sm.Email)
+	}
+
+	return members, invite, nil
+}
+
+// memberChanges returns organization membership IDs to add to the team and IDs to remove from it.
+// Only members the operator added to the team before are removed, so the users added to the team
+// outside of this object stay untouched.
+func memberChanges(spec []appv1alpha2.TeamMemberStatus, current []string, status []appv1alpha2.TeamMemberStatus) ([]string, []string) {
+	var add, remove []string
+
+	specIDs := make([]string, 0, len(spec))
+	for _, m := range spec {
+		specIDs = append(specIDs, m.ID)
+		if !slices.Contains(current, m.ID) && !slices.Contains(add, m.ID) {
+			add = append(add, m.ID)
+		}
+	}
+
+	for _, m := range status {
+		if !slices.Contains(specIDs, m.ID) && slices.Contains(current, m.ID) {
+			remove = append(remove, m.ID)
+		}
+	}
+
+	return add, remove
+}
+
+func (r *TeamReconciler) reconcileMembers(ctx context.Context, t *teamInstance) error {
+	t.log.Info("Reconcile Members", "msg", "new reconciliation event")
+
+	if len(t.instance.Spec.Members) == 0 && len(t.instance.Status.Members) == 0 {
+		t.log.Info("Reconcile Members", "msg", "there are no members both in spec and status")
+		return nil
+	}
+
+	members, invite, err := getSpecMembers(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	current, err := t.tfClient.Client.TeamMembers.ListOrganizationMemberships(ctx, t.instance.Status.ID)
+	if err != nil {
+		t.log.Error(err, "Reconcile Members", "msg", "failed to get team members")
+		return err
+	}
+	var currentIDs []string
+	for _, m := range current {
+		currentIDs = append(currentIDs, m.ID)
+	}
+
+	add, remove := memberChanges(members, currentIDs, t.instance.Status.Members)
+	if len(add) > 0 {
+		t.log.Info("Reconcile Members", "msg", fmt.Sprintf("adding organization memberships %v to the team", add))
+		err := t.tfClient.Client.TeamMembers.Add(ctx, t.instance.Status.ID, tfc.TeamMemberAddOptions{
+			OrganizationMembershipIDs: add,
+		})
+		if err != nil {
+			t.log.Error(err, "Reconcile Members", "msg", "failed to add members to the team")
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		t.log.Info("Reconcile Members", "msg", fmt.Sprintf("removing organization memberships %v from the team", remove))
+		err := t.tfClient.Client.TeamMembers.Remove(ctx, t.instance.Status.ID, tfc.TeamMemberRemoveOptions{
+			OrganizationMembershipIDs: remove,
+		})
+		if err != nil {
+			t.log.Error(err, "Reconcile Members", "msg", "failed to remove members from the team")
+			return err
+		}
+	}
+
+	// Users who are not members of the organization yet get invited to it and join the team right away.
+	for _, email := range invite {
+		t.log.Info("Reconcile Members", "msg", fmt.Sprintf("inviting user %s to the organization", email))
+		m, err := t.tfClient.Client.OrganizationMemberships.Create(ctx, t.instance.Spec.Organization, tfc.OrganizationMembershipCreateOptions{
+			Email: tfc.String(email),
+			Teams: []*tfc.Team{{ID: t.instance.Status.ID}},
+		})
+		if err != nil {
+			t.log.Error(err, "Reconcile Members", "msg", fmt.Sprintf("failed to invite user %s to the organization", email))
+			return err
+		}
+		members = append(members, memberStatus(m))
+	}
+
+	t.instance.Status.Members = members
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// teamMemberIDs returns the organization membership IDs of the team with a given ID.
+func teamMemberIDs(t *testing.T, f *fakeAPI, id string) []string {
+	t.Helper()
+
+	var ids []string
+	for _, m := range f.server.Team(id).OrganizationMemberships {
+		ids = append(ids, m.ID)
+	}
+
+	return ids
+}
+
+func TestTeamReconcile(t *testing.T) {
+	t.Parallel()
+
+	team := &appv1alpha2.Team{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.TeamSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Visibility:    "organization",
+			OrganizationAccess: &appv1alpha2.TeamOrganizationAccess{
+				ManageWorkspaces: true,
+			},
+			Members: []appv1alpha2.TeamMember{
+				{Username: "this"},
+				{Email: "new@example.com"},
+			},
+			DeletionPolicy: appv1alpha2.TeamDeletionPolicyDestroy,
+		},
+	}
+	f := newFakeAPI(t, team)
+	r := &TeamReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+	this := f.server.AddOrganizationMember(fakeOrganization, "this", "this@example.com")
+	other := f.server.AddOrganizationMember(fakeOrganization, "other", "other@example.com")
+
+	f.reconcile(t, r, team)
+	requireSynced(t, team.Status.Conditions)
+	assert.Contains(t, team.Finalizers, teamFinalizer)
+	tt := f.server.Team(team.Status.ID)
+	require.NotNil(t, tt)
+	assert.Equal(t, "this", tt.Name)
+	assert.Equal(t, "organization", tt.Visibility)
+	assert.True(t, tt.OrganizationAccess.ManageWorkspaces)
+	require.Len(t, team.Status.Members, 2)
+	assert.Equal(t, this.ID, team.Status.Members[0].ID)
+	assert.Equal(t, "this", team.Status.Members[0].Username)
+	assert.Equal(t, "new@example.com", team.Status.Members[1].Email)
+	assert.Equal(t, string(tfc.OrganizationMembershipInvited), team.Status.Members[1].Status)
+	assert.ElementsMatch(t, []string{this.ID, team.Status.Members[1].ID}, teamMemberIDs(t, f, tt.ID))
+
+	// Members added to the team outside of the object must stay.
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	require.NoError(t, c.TeamMembers.Add(context.TODO(), tt.ID, tfc.TeamMemberAddOptions{OrganizationMembershipIDs: []string{other.ID}}))
+
+	team.Spec.OrganizationAccess = nil
+	team.Spec.Members = []appv1alpha2.TeamMember{{Email: "THIS@example.com"}}
+	team.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), team))
+	f.reconcile(t, r, team)
+	requireSynced(t, team.Status.Conditions)
+	tt = f.server.Team(tt.ID)
+	assert.False(t, tt.OrganizationAccess.ManageWorkspaces)
+	require.Len(t, team.Status.Members, 1)
+	assert.Equal(t, this.ID, team.Status.Members[0].ID)
+	assert.ElementsMatch(t, []string{this.ID, other.ID}, teamMemberIDs(t, f, tt.ID))
+
+	f.delete(t, team)
+	f.reconcile(t, r, team)
+	assert.False(t, f.exists(t, team))
+	assert.Nil(t, f.server.Team(tt.ID))
+}
+
+func TestTeamReconcileUnknownUser(t *testing.T) {
+	t.Parallel()
+
+	team := &appv1alpha2.Team{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.TeamSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			Members:        []appv1alpha2.TeamMember{{Username: "unknown"}},
+			DeletionPolicy: appv1alpha2.TeamDeletionPolicyRetain,
+		},
+	}
+	f := newFakeAPI(t, team)
+	r := &TeamReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, team)
+	c := meta.FindStatusCondition(team.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Contains(t, c.Message, "unknown")
+	require.NotEmpty(t, team.Status.ID)
+
+	f.delete(t, team)
+	f.reconcile(t, r, team)
+	assert.False(t, f.exists(t, team))
+	assert.NotNil(t, f.server.Team(team.Status.ID))
+}
+
+func TestTeamReconcileCreateKeepsConditions(t *testing.T) {
+	t.Parallel()
+
+	team := &appv1alpha2.Team{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.TeamSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			DeletionPolicy: appv1alpha2.TeamDeletionPolicyRetain,
+		},
+	}
+	// the finalizer is already set, thus the conditions set before the team is created are not reloaded from the object
+	team.Finalizers = []string{teamFinalizer}
+	f := newFakeAPI(t, team)
+	r := &TeamReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, team)
+	requireSynced(t, team.Status.Conditions)
+	require.NotEmpty(t, team.Status.ID)
+	assert.True(t, meta.IsStatusConditionTrue(team.Status.Conditions, appv1alpha2.ConditionTypeSpecValid))
+}
@@ -0,0 +1,314 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/version"
+)
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=connections,verbs=get;list;watch
+
+// terraformClientOptions contains everything that is required to build an HCP Terraform client.
+// Two clients built from equal options are interchangeable.
+type terraformClientOptions struct {
+	// organization is used to share the API rate limiter between clients of the same organization.
+	organization string
+	address      string
+	token        string
+	proxyURL     string
+	insecure     bool
+	caBundles    [][]byte
+	clientCert   []byte
+	clientKey    []byte
+	// refs contains references to Kubernetes Secrets and ConfigMaps the options are read from.
+	refs []objectRef
+}
+
+// newTerraformClient returns an HCP Terraform client for an object in the given namespace.
+// The API token set on the object takes precedence over the one of the referred Connection.
+// The API address, TLS, and proxy settings come from the referred Connection, if any,
+// otherwise from the operator environment variables.
+// When the client cache is set, the client is reused across reconciliations and controllers.
+// All clients of the same API endpoint and organization share the API rate limiter.
+func newTerraformClient(ctx context.Context, c client.Client, cache *TerraformClientCache, log logr.Logger, namespace, organization string, token *appv1alpha2.Token, connectionRef *corev1.LocalObjectReference) (*tfc.Client, error) {
+	o, err := loadTerraformClientOptions(ctx, c, namespace, token, connectionRef)
+	if err != nil {
+		return nil, err
+	}
+	o.organization = organization
+
+	if cache == nil {
+		return o.newClient(log)
+	}
+
+	key := o.key()
+	if tfClient, ok := cache.get(key); ok {
+		return tfClient, nil
+	}
+
+	tfClient, err := o.newClient(log)
+	if err != nil {
+		return nil, err
+	}
+	cache.add(key, o.refs, tfClient)
+
+	return tfClient, nil
+}
+
+// operatorTLSSettings contains the operator-wide TLS settings.
+type operatorTLSSettings struct {
+	insecure   bool
+	caBundle   []byte
+	clientCert []byte
+	clientKey  []byte
+}
+
+// operatorTLS contains the operator-wide TLS settings loaded by LoadOperatorTLS.
+var operatorTLS operatorTLSSettings
+
+// LoadOperatorTLS reads the operator-wide TLS settings from the following environment variables:
+//   - TFC_TLS_SKIP_VERIFY: skip TLS certificate verification.
+//   - TFC_TLS_CA_BUNDLE_FILE: path to a PEM-encoded CA bundle that extends the system certificate pool.
+//   - TFC_TLS_CLIENT_CERT_FILE and TFC_TLS_CLIENT_KEY_FILE: paths to a PEM-encoded client certificate and key for mTLS.
+//
+// The files are read once, thus it must be called at startup before the controllers start.
+func LoadOperatorTLS() error {
+	var s operatorTLSSettings
+	var err error
+
+	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
+		s.insecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if f, ok := os.LookupEnv("TFC_TLS_CA_BUNDLE_FILE"); ok && f != "" {
+		if s.caBundle, err = os.ReadFile(f); err != nil {
+			return fmt.Errorf("failed to read CA bundle file: %w", err)
+		}
+	}
+
+	certFile, keyFile := os.Getenv("TFC_TLS_CLIENT_CERT_FILE"), os.Getenv("TFC_TLS_CLIENT_KEY_FILE")
+	if certFile != "" || keyFile != "" {
+		if s.clientCert, err = os.ReadFile(certFile); err != nil {
+			return fmt.Errorf("failed to read client certificate file: %w", err)
+		}
+		if s.clientKey, err = os.ReadFile(keyFile); err != nil {
+			return fmt.Errorf("failed to read client key file: %w", err)
+		}
+	}
+
+	operatorTLS = s
+
+	return nil
+}
+
+// loadTerraformClientOptions reads the client options from the operator-wide TLS settings loaded by LoadOperatorTLS,
+// the referred Connection, and the Kubernetes Secrets and ConfigMaps they refer to.
+//
+// The settings of the given Connection, if any, extend the operator-wide ones.
+// The client certificate of the Connection takes precedence over the operator-wide one.
+func loadTerraformClientOptions(ctx context.Context, c client.Client, namespace string, token *appv1alpha2.Token, connectionRef *corev1.LocalObjectReference) (*terraformClientOptions, error) {
+	o := &terraformClientOptions{}
+
+	var conn *appv1alpha2.Connection
+	if connectionRef != nil {
+		conn = &appv1alpha2.Connection{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: connectionRef.Name}, conn); err != nil {
+			return nil, fmt.Errorf("failed to get connection %q: %w", connectionRef.Name, err)
+		}
+		if err := conn.ValidateSpec(); err != nil {
+			return nil, err
+		}
+	}
+
+	if token == nil && conn != nil {
+		token = conn.Spec.Token
+	}
+	if token == nil || token.SecretKeyRef == nil {
+		return nil, fmt.Errorf("API token is not set, either spec.token or spec.connectionRef with a token must be set")
+	}
+
+	nn := types.NamespacedName{
+		Namespace: namespace,
+		Name:      token.SecretKeyRef.Name,
+	}
+	t, err := secretKeyRef(ctx, c, nn, token.SecretKeyRef.Key)
+	if err != nil {
+		return nil, err
+	}
+	o.token = t
+	o.refs = append(o.refs, objectRef{kind: kindSecret, NamespacedName: nn})
+
+	o.insecure = operatorTLS.insecure
+	if operatorTLS.caBundle != nil {
+		o.caBundles = append(o.caBundles, operatorTLS.caBundle)
+	}
+	o.clientCert, o.clientKey = operatorTLS.clientCert, operatorTLS.clientKey
+
+	if conn == nil {
+		return o, nil
+	}
+
+	o.address = conn.Spec.Address
+	o.proxyURL = conn.Spec.ProxyURL
+
+	if conn.Spec.TLS != nil {
+		o.insecure = o.insecure || conn.Spec.TLS.SkipVerify
+
+		if b := conn.Spec.TLS.CABundle; b != nil {
+			v, ref, err := valueFrom(ctx, c, namespace, b)
+			if err != nil {
+				return nil, err
+			}
+			o.caBundles = append(o.caBundles, []byte(v))
+			o.refs = append(o.refs, ref)
+		}
+
+		if r := conn.Spec.TLS.ClientCertificateSecretRef; r != nil {
+			nn := types.NamespacedName{Namespace: namespace, Name: r.Name}
+			cert, err := secretKeyRef(ctx, c, nn, corev1.TLSCertKey)
+			if err != nil {
+				return nil, err
+			}
+			key, err := secretKeyRef(ctx, c, nn, corev1.TLSPrivateKeyKey)
+			if err != nil {
+				return nil, err
+			}
+			o.clientCert, o.clientKey = []byte(cert), []byte(key)
+			o.refs = append(o.refs, objectRef{kind: kindSecret, NamespacedName: nn})
+		}
+	}
+
+	return o, nil
+}
+
+// key returns a hash of the options that is used as the client cache key.
+func (o *terraformClientOptions) key() string {
+	h := sha256.New()
+	for _, v := range [][]byte{
+		[]byte(o.organization),
+		[]byte(o.address),
+		[]byte(o.token),
+		[]byte(o.proxyURL),
+		[]byte(strconv.FormatBool(o.insecure)),
+		o.clientCert,
+		o.clientKey,
+	} {
+		h.Write(v)
+		h.Write([]byte{0})
+	}
+	for _, b := range o.caBundles {
+		h.Write(b)
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tlsConfig returns the TLS configuration of the HCP Terraform client.
+func (o *terraformClientOptions) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: o.insecure,
+	}
+
+	if len(o.caBundles) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		for _, b := range o.caBundles {
+			if !pool.AppendCertsFromPEM(b) {
+				return nil, fmt.Errorf("failed to parse any certificate from the CA bundle")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if o.clientCert != nil || o.clientKey != nil {
+		cert, err := tls.X509KeyPair(o.clientCert, o.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newClient builds a new HCP Terraform client from the options.
+func (o *terraformClientOptions) newClient(log logr.Logger) (*tfc.Client, error) {
+	config := tfc.DefaultConfig()
+	config.Token = o.token
+	config.Headers.Set("User-Agent", version.UserAgent)
+	if o.address != "" {
+		config.Address = o.address
+	}
+
+	var err error
+	transport := config.HTTPClient.Transport.(*http.Transport)
+	transport.TLSClientConfig, err = o.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if o.proxyURL != "" {
+		proxyURL, err := url.Parse(o.proxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	endpoint, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, err
+	}
+	config.HTTPClient.Transport = &rateLimitedTransport{
+		limiter: apiRateLimiters.get(endpoint.Host, o.organization),
+		next:    transport,
+	}
+
+	if o.insecure {
+		log.Info("Terraform Client", "msg", "client configured to skip TLS certificate verifications")
+	}
+
+	return tfc.NewClient(config)
+}
+
+// valueFrom fetches a value from a Kubernetes ConfigMap or Secret referred by a given ValueFrom.
+// It also returns the reference to the object the value was read from.
+func valueFrom(ctx context.Context, c client.Client, namespace string, v *appv1alpha2.ValueFrom) (string, objectRef, error) {
+	switch {
+	case v.ConfigMapKeyRef != nil:
+		nn := types.NamespacedName{Namespace: namespace, Name: v.ConfigMapKeyRef.Name}
+		value, err := configMapKeyRef(ctx, c, nn, v.ConfigMapKeyRef.Key)
+		return value, objectRef{kind: kindConfigMap, NamespacedName: nn}, err
+	case v.SecretKeyRef != nil:
+		nn := types.NamespacedName{Namespace: namespace, Name: v.SecretKeyRef.Name}
+		value, err := secretKeyRef(ctx, c, nn, v.SecretKeyRef.Key)
+		return value, objectRef{kind: kindSecret, NamespacedName: nn}, err
+	}
+
+	return "", objectRef{}, fmt.Errorf("one of the field configMapKeyRef or secretKeyRef must be set")
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	kindConfigMap = "ConfigMap"
+	kindSecret    = "Secret"
+)
+
+// objectRef refers to a Kubernetes object of a given kind.
+type objectRef struct {
+	kind string
+	types.NamespacedName
+}
+
+// TerraformClientCache caches HCP Terraform clients across reconciliations and controllers.
+// Clients are keyed by a hash of the API address, token, TLS, and proxy settings.
+// All clients that were built from a Kubernetes Secret or ConfigMap are removed from the cache when the object changes.
+type TerraformClientCache struct {
+	mu      sync.Mutex
+	clients map[string]*tfc.Client
+	// refs maps a Kubernetes Secret or ConfigMap to the keys of the clients that were built from it.
+	refs map[objectRef]map[string]struct{}
+}
+
+// NewTerraformClientCache returns a new empty HCP Terraform client cache.
+func NewTerraformClientCache() *TerraformClientCache {
+	return &TerraformClientCache{
+		clients: make(map[string]*tfc.Client),
+		refs:    make(map[objectRef]map[string]struct{}),
+	}
+}
+
+// SetupWithManager registers handlers that invalidate cached clients when a Kubernetes Secret or ConfigMap changes.
+func (c *TerraformClientCache) SetupWithManager(mgr ctrl.Manager) error {
+	for kind, obj := range map[string]client.Object{
+		kindConfigMap: &corev1.ConfigMap{},
+		kindSecret:    &corev1.Secret{},
+	} {
+		informer, err := mgr.GetCache().GetInformer(context.Background(), obj)
+		if err != nil {
+			return err
+		}
+		_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj any) {
+				o, err := meta.Accessor(oldObj)
+				if err != nil {
+					return
+				}
+				n, err := meta.Accessor(newObj)
+				if err != nil {
+					return
+				}
+				// Periodic resync delivers update events for unchanged objects.
+				if o.GetResourceVersion() == n.GetResourceVersion() {
+					return
+				}
+				c.invalidate(objectRef{kind: kind, NamespacedName: types.NamespacedName{Namespace: n.GetNamespace(), Name: n.GetName()}})
+			},
+			DeleteFunc: func(obj any) {
+				if d, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+					obj = d.Obj
+				}
+				o, err := meta.Accessor(obj)
+				if err != nil {
+					return
+				}
+				c.invalidate(objectRef{kind: kind, NamespacedName: types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}})
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// get returns a cached client by a given key.
+func (c *TerraformClientCache) get(key string) (*tfc.Client, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	tfClient, ok := c.clients[key]
+
+	return tfClient, ok
+}
+
+// add caches a given client by a given key. The client is removed from the cache when one of the given objects changes.
+func (c *TerraformClientCache) add(key string, refs []objectRef, tfClient *tfc.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.clients[key] = tfClient
+	for _, r := range refs {
+		if _, ok := c.refs[r]; !ok {
+			c.refs[r] = make(map[string]struct{})
+		}
+		c.refs[r][key] = struct{}{}
+	}
+}
+
+// invalidate removes all clients that were built from a given object.
+func (c *TerraformClientCache) invalidate(ref objectRef) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.refs[ref] {
+		delete(c.clients, key)
+		for r, keys := range c.refs {
+			delete(keys, key)
+			if len(keys) == 0 {
+				delete(c.refs, r)
+			}
+		}
+	}
+	delete(c.refs, ref)
+}
+
+// len returns the number of cached clients.
+func (c *TerraformClientCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.clients)
+}
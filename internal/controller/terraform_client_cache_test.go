@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTerraformClientCache(t *testing.T) {
+	t.Parallel()
+
+	token := objectRef{kind: kindSecret, NamespacedName: types.NamespacedName{Namespace: "default", Name: "token"}}
+	ca := objectRef{kind: kindConfigMap, NamespacedName: types.NamespacedName{Namespace: "default", Name: "ca"}}
+	other := objectRef{kind: kindSecret, NamespacedName: types.NamespacedName{Namespace: "default", Name: "other"}}
+
+	cache := NewTerraformClientCache()
+	this, that := &tfc.Client{}, &tfc.Client{}
+	cache.add("this", []objectRef{token, ca}, this)
+	cache.add("that", []objectRef{other}, that)
+
+	c, ok := cache.get("this")
+	assert.True(t, ok)
+	assert.Same(t, this, c)
+	assert.Equal(t, 2, cache.len())
+
+	// A ConfigMap with the same name as a Secret is a different object.
+	cache.invalidate(objectRef{kind: kindConfigMap, NamespacedName: other.NamespacedName})
+	assert.Equal(t, 2, cache.len())
+
+	cache.invalidate(ca)
+	_, ok = cache.get("this")
+	assert.False(t, ok)
+	_, ok = cache.get("that")
+	assert.True(t, ok)
+	assert.NotContains(t, cache.refs, token)
+
+	cache.invalidate(other)
+	assert.Equal(t, 0, cache.len())
+	assert.Empty(t, cache.refs)
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// defaultAPIRetryAfter is the time to pause API calls for when a throttled response does not tell when to retry.
+	defaultAPIRetryAfter = 1 * time.Second
+)
+
+// apiRateLimiters contains the API rate limiters shared by all HCP Terraform clients across reconciliations and controllers.
+var apiRateLimiters = newAPIRateLimiterRegistry()
+
+// apiRateLimiterKey identifies an API rate limiter by the API endpoint host and organization name.
+type apiRateLimiterKey struct {
+	endpoint     string
+	organization string
+}
+
+// apiRateLimiterRegistry keeps one API rate limiter per API endpoint and organization.
+type apiRateLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[apiRateLimiterKey]*apiRateLimiter
+}
+
+func newAPIRateLimiterRegistry() *apiRateLimiterRegistry {
+	return &apiRateLimiterRegistry{
+		limiters: make(map[apiRateLimiterKey]*apiRateLimiter),
+	}
+}
+
+// get returns the API rate limiter of a given API endpoint and organization.
+// A new limiter is configured with the APIRateLimit and APIRateBurst values.
+func (r *apiRateLimiterRegistry) get(endpoint, organization string) *apiRateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := apiRateLimiterKey{endpoint: endpoint, organization: organization}
+	if l, ok := r.limiters[key]; ok {
+		return l
+	}
+	l := newAPIRateLimiter(endpoint, organization, APIRateLimit, APIRateBurst)
+	r.limiters[key] = l
+
+	return l
+}
+
+// apiRateLimiter is a token bucket rate limiter of HCP Terraform API calls.
+// Once the API responds with 429 Too Many Requests, all calls are paused until the API allows retrying.
+type apiRateLimiter struct {
+	endpoint     string
+	organization string
+	limiter      *rate.Limiter
+
+	mu sync.Mutex
+	// pausedUntil is the time until which all calls are paused.
+	pausedUntil time.Time
+}
+
+// newAPIRateLimiter returns a new API rate limiter that allows a given number of requests per second with a given burst.
+// The limit of 0 or below disables the token bucket, the calls are then only paused on throttled responses.
+func newAPIRateLimiter(endpoint, organization string, limit float64, burst int) *apiRateLimiter {
+	l := rate.Inf
+	if limit > 0 {
+		l = rate.Limit(limit)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &apiRateLimiter{
+		endpoint:     endpoint,
+		organization: organization,
+		limiter:      rate.NewLimiter(l, burst),
+	}
+}
+
+// wait blocks until a call is allowed or the context is done.
+func (l *apiRateLimiter) wait(ctx context.Context) error {
+	var delay time.Duration
+
+	l.mu.Lock()
+	d := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+	if d > 0 {
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+		delay += d
+	}
+
+	r := l.limiter.Reserve()
+	if d := r.Delay(); d > 0 {
+		if err := sleep(ctx, d); err != nil {
+			r.Cancel()
+			return err
+		}
+		delay += d
+	}
+
+	if delay > 0 {
+		MetricAPIRateLimitedRequests.WithLabelValues(l.endpoint, l.organization).Inc()
+		MetricAPIRateLimiterWaitSeconds.WithLabelValues(l.endpoint, l.organization).Add(delay.Seconds())
+	}
+
+	return nil
+}
+
+// pause pauses all calls for a given duration. It never shortens an ongoing pause.
+func (l *apiRateLimiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// rateLimitedTransport is an HTTP transport that passes all requests through a shared API rate limiter.
+type rateLimitedTransport struct {
+	limiter *apiRateLimiter
+	next    http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		MetricAPIThrottledRequests.WithLabelValues(t.limiter.endpoint, t.limiter.organization).Inc()
+		t.limiter.pause(retryAfter(resp.Header, time.Now()))
+	}
+
+	return resp, nil
+}
+
+// retryAfter returns the time to wait before the next API call from the headers of a throttled response.
+// It respects the `Retry-After` header, in seconds or as an HTTP date, and then the `X-RateLimit-Reset` header, in seconds.
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	if v := h.Get("Retry-After"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+			return time.Duration(s) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0)
+		}
+	}
+
+	if v := h.Get("X-RateLimit-Reset"); v != "" {
+		if s, err := strconv.ParseFloat(v, 64); err == nil && s >= 0 {
+			return time.Duration(s * float64(time.Second))
+		}
+	}
+
+	return defaultAPIRetryAfter
+}
+
+// sleep pauses the current goroutine for a given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
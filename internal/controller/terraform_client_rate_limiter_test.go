@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	successCases := map[string]struct {
+		headers  map[string]string
+		expected time.Duration
+	}{
+		"NoHeaders": {
+			headers:  map[string]string{},
+			expected: defaultAPIRetryAfter,
+		},
+		"RetryAfterSeconds": {
+			headers:  map[string]string{"Retry-After": "5"},
+			expected: 5 * time.Second,
+		},
+		"RetryAfterDate": {
+			headers:  map[string]string{"Retry-After": now.Add(10 * time.Second).Format(http.TimeFormat)},
+			expected: 10 * time.Second,
+		},
+		"RetryAfterPastDate": {
+			headers:  map[string]string{"Retry-After": now.Add(-10 * time.Second).Format(http.TimeFormat)},
+			expected: 0,
+		},
+		"RateLimitReset": {
+			headers:  map[string]string{"X-RateLimit-Reset": "0.5"},
+			expected: 500 * time.Millisecond,
+		},
+		"RetryAfterPrecedence": {
+			headers:  map[string]string{"Retry-After": "2", "X-RateLimit-Reset": "0.5"},
+			expected: 2 * time.Second,
+		},
+		"InvalidRetryAfter": {
+			headers:  map[string]string{"Retry-After": "soon", "X-RateLimit-Reset": "0.5"},
+			expected: 500 * time.Millisecond,
+		},
+		"InvalidRateLimitReset": {
+			headers:  map[string]string{"X-RateLimit-Reset": "soon"},
+			expected: defaultAPIRetryAfter,
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range c.headers {
+				h.Set(k, v)
+			}
+			assert.Equal(t, c.expected, retryAfter(h, now))
+		})
+	}
+}
+
+func TestAPIRateLimiterRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := newAPIRateLimiterRegistry()
+	l := r.get("app.terraform.io", "this")
+	assert.Same(t, l, r.get("app.terraform.io", "this"))
+	assert.NotSame(t, l, r.get("app.terraform.io", "that"))
+	assert.NotSame(t, l, r.get("tfe.example.com", "this"))
+}
+
+func TestAPIRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Burst", func(t *testing.T) {
+		l := newAPIRateLimiter("app.terraform.io", "this", 1, 2)
+		start := time.Now()
+		assert.NoError(t, l.wait(context.TODO()))
+		assert.NoError(t, l.wait(context.TODO()))
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		l := newAPIRateLimiter("app.terraform.io", "this", 1, 1)
+		assert.NoError(t, l.wait(context.TODO()))
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, l.wait(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		l := newAPIRateLimiter("app.terraform.io", "this", 0, 0)
+		start := time.Now()
+		for range 100 {
+			assert.NoError(t, l.wait(context.TODO()))
+		}
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("Pause", func(t *testing.T) {
+		l := newAPIRateLimiter("app.terraform.io", "this", 0, 0)
+		l.pause(time.Hour)
+		l.pause(time.Second)
+		ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, l.wait(ctx), context.DeadlineExceeded)
+		assert.Greater(t, time.Until(l.pausedUntil), 59*time.Minute)
+	})
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	l := newAPIRateLimiter("127.0.0.1", "this", 0, 0)
+	c := &http.Client{
+		Transport: &rateLimitedTransport{
+			limiter: l,
+			next:    http.DefaultTransport,
+		},
+	}
+
+	resp, err := c.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	// All calls to the same endpoint and organization wait until the API allows retrying.
+	start := time.Now()
+	resp, err = c.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+}
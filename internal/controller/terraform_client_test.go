@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// newTestCertificate returns a PEM-encoded self-signed certificate and its private key.
+func newTestCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "hcp-terraform-operator"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	k, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	pk := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: k})
+
+	return string(cert), string(pk)
+}
+
+// newTestClient returns a fake Kubernetes client with an API token Secret and given objects.
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, appv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "default"},
+		Data: map[string][]byte{
+			"token": []byte("this"),
+		},
+	})
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+var testToken = &appv1alpha2.Token{
+	SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "token"},
+		Key:                  "token",
+	},
+}
+
+func TestLoadTerraformClientOptions(t *testing.T) {
+	t.Parallel()
+
+	cert, key := newTestCertificate(t)
+	namespace := "default"
+
+	connection := func(name string, tls *appv1alpha2.ConnectionTLS) *appv1alpha2.Connection {
+		return &appv1alpha2.Connection{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: appv1alpha2.ConnectionSpec{
+				Address: "https://tfe.example.com",
+				Token:   testToken,
+				TLS:     tls,
+			},
+		}
+	}
+	caBundle := func(key string) *appv1alpha2.ValueFrom {
+		return &appv1alpha2.ValueFrom{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "ca"},
+				Key:                  key,
+			},
+		}
+	}
+
+	c := newTestClient(t,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace},
+			Data: map[string]string{
+				"ca.crt":  cert,
+				"invalid": "not a certificate",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: namespace},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte(cert),
+				corev1.TLSPrivateKeyKey: []byte(key),
+			},
+		},
+		connection("plain", nil),
+		connection("skip-verify", &appv1alpha2.ConnectionTLS{SkipVerify: true}),
+		connection("ca-bundle", &appv1alpha2.ConnectionTLS{CABundle: caBundle("ca.crt")}),
+		connection("invalid-ca-bundle", &appv1alpha2.ConnectionTLS{CABundle: caBundle("invalid")}),
+		connection("client-certificate", &appv1alpha2.ConnectionTLS{
+			ClientCertificateSecretRef: &corev1.LocalObjectReference{Name: "client"},
+		}),
+		connection("missing-client-certificate", &appv1alpha2.ConnectionTLS{
+			ClientCertificateSecretRef: &corev1.LocalObjectReference{Name: "missing"},
+		}),
+	)
+
+	load := func(connectionName string) (*terraformClientOptions, error) {
+		var ref *corev1.LocalObjectReference
+		if connectionName != "" {
+			ref = &corev1.LocalObjectReference{Name: connectionName}
+		}
+		return loadTerraformClientOptions(context.TODO(), c, namespace, nil, ref)
+	}
+
+	t.Run("NoToken", func(t *testing.T) {
+		_, err := loadTerraformClientOptions(context.TODO(), c, namespace, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("NoConnection", func(t *testing.T) {
+		o, err := loadTerraformClientOptions(context.TODO(), c, namespace, testToken, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "this", o.token)
+		assert.Empty(t, o.address)
+		tlsConfig, err := o.tlsConfig()
+		assert.NoError(t, err)
+		assert.False(t, tlsConfig.InsecureSkipVerify)
+		assert.Nil(t, tlsConfig.RootCAs)
+		assert.Empty(t, tlsConfig.Certificates)
+	})
+
+	t.Run("MissingConnection", func(t *testing.T) {
+		_, err := load("missing")
+		assert.Error(t, err)
+	})
+
+	t.Run("Connection", func(t *testing.T) {
+		o, err := load("plain")
+		assert.NoError(t, err)
+		assert.Equal(t, "this", o.token)
+		assert.Equal(t, "https://tfe.example.com", o.address)
+	})
+
+	t.Run("SkipVerify", func(t *testing.T) {
+		o, err := load("skip-verify")
+		assert.NoError(t, err)
+		tlsConfig, err := o.tlsConfig()
+		assert.NoError(t, err)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+	})
+
+	t.Run("CABundle", func(t *testing.T) {
+		o, err := load("ca-bundle")
+		assert.NoError(t, err)
+		tlsConfig, err := o.tlsConfig()
+		assert.NoError(t, err)
+		assert.NotNil(t, tlsConfig.RootCAs)
+		assert.Contains(t, o.refs, objectRef{kind: kindConfigMap, NamespacedName: types.NamespacedName{Namespace: namespace, Name: "ca"}})
+	})
+
+	t.Run("InvalidCABundle", func(t *testing.T) {
+		o, err := load("invalid-ca-bundle")
+		assert.NoError(t, err)
+		_, err = o.tlsConfig()
+		assert.Error(t, err)
+	})
+
+	t.Run("ClientCertificate", func(t *testing.T) {
+		o, err := load("client-certificate")
+		assert.NoError(t, err)
+		tlsConfig, err := o.tlsConfig()
+		assert.NoError(t, err)
+		assert.Len(t, tlsConfig.Certificates, 1)
+	})
+
+	t.Run("MissingClientCertificate", func(t *testing.T) {
+		_, err := load("missing-client-certificate")
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadTerraformClientOptionsFromFiles(t *testing.T) {
+	cert, key := newTestCertificate(t)
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	assert.NoError(t, os.WriteFile(certFile, []byte(cert), 0o600))
+	assert.NoError(t, os.WriteFile(keyFile, []byte(key), 0o600))
+
+	t.Setenv("TFC_TLS_CA_BUNDLE_FILE", certFile)
+	t.Setenv("TFC_TLS_CLIENT_CERT_FILE", certFile)
+	t.Setenv("TFC_TLS_CLIENT_KEY_FILE", keyFile)
+	t.Cleanup(func() { operatorTLS = operatorTLSSettings{} })
+	assert.NoError(t, LoadOperatorTLS())
+
+	c := newTestClient(t)
+	o, err := loadTerraformClientOptions(context.TODO(), c, "default", testToken, nil)
+	assert.NoError(t, err)
+	tlsConfig, err := o.tlsConfig()
+	assert.NoError(t, err)
+	assert.NotNil(t, tlsConfig.RootCAs)
+	assert.Len(t, tlsConfig.Certificates, 1)
+
+	// the files are read once at startup
+	assert.NoError(t, os.Remove(keyFile))
+	o, err = loadTerraformClientOptions(context.TODO(), c, "default", testToken, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(key), o.clientKey)
+
+	t.Setenv("TFC_TLS_CLIENT_KEY_FILE", filepath.Join(dir, "missing.key"))
+	assert.Error(t, LoadOperatorTLS())
+}
+
+func TestTerraformClientOptionsKey(t *testing.T) {
+	t.Parallel()
+
+	o := terraformClientOptions{address: "https://tfe.example.com", token: "this"}
+	assert.Equal(t, o.key(), o.key())
+
+	rotated := o
+	rotated.token = "that"
+	assert.NotEqual(t, o.key(), rotated.key())
+
+	insecure := o
+	insecure.insecure = true
+	assert.NotEqual(t, o.key(), insecure.key())
+
+	tfc := o
+	tfc.address = ""
+	assert.NotEqual(t, o.key(), tfc.key())
+}
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// VariableSetReconciler reconciles a VariableSet object
+type VariableSetReconciler struct {
+	client.Client
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
+}
+
+type variableSetInstance struct {
+	instance appv1alpha2.VariableSet
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=variablesets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=variablesets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=variablesets/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *VariableSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	v := variableSetInstance{}
+
+	v.log = log.Log.WithValues("variableset", req.NamespacedName)
+	v.log.Info("Variable Set Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &v.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			v.log.Info("Variable Set Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		v.log.Error(err, "Variable Set Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := v.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		v.log.Info("Variable Set Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	v.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := v.instance.ValidateSpec(); err != nil {
+		v.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&v.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&v.instance.Status.Conditions, v.instance.Generation, err)
+		if err := r.Status().Update(ctx, &v.instance); err != nil {
+			v.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
+		return doNotRequeue()
+	}
+	v.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&v.instance.Status.Conditions, v.instance.Generation, nil)
+
+	if needToAddFinalizer(&v.instance, variableSetFinalizer) {
+		err := r.addFinalizer(ctx, &v.instance)
+		if err != nil {
+			v.log.Error(err, "Variable Set Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", variableSetFinalizer))
+			r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", variableSetFinalizer)
+			return requeueOnErr(err)
+		}
+		v.log.Info("Variable Set Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", variableSetFinalizer))
+		r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", variableSetFinalizer)
+	}
+
+	err = r.getTerraformClient(ctx, &v)
+	if err != nil {
+		v.log.Error(err, "Variable Set Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&v.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&v.instance.Status.Conditions, v.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &v.instance); err != nil {
+			v.log.Error(err, "Variable Set Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileVariableSet(ctx, &v)
+	if err != nil {
+		v.log.Error(err, "Variable Set Controller", "msg", "reconcile variable set")
+		r.Recorder.Event(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Failed to reconcile variable set")
+		setSyncedCondition(&v.instance.Status.Conditions, v.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &v.instance); err != nil {
+			v.log.Error(err, "Variable Set Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+	v.log.Info("Variable Set Controller", "msg", "successfully reconcilied variable set")
+	r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "ReconcileVariableSet", "Successfully reconcilied variable set ID %s", v.instance.Status.ID)
+
+	return requeueAfter(VariableSetSyncPeriod)
+}
+
+func (r *VariableSetReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.VariableSet) error {
+	controllerutil.AddFinalizer(instance, variableSetFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *VariableSetReconciler) getTerraformClient(ctx context.Context, v *variableSetInstance) error {
+	var err error
+	v.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, v.log, v.instance.Namespace, v.instance.Spec.Organization, v.instance.Spec.Token, v.instance.Spec.ConnectionRef)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VariableSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.VariableSet{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.VariableSet{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.VariableSetList{}).Complete(r)
+}
+
+func (r *VariableSetReconciler) updateStatus(ctx context.Context, v *variableSetInstance, variableSet *tfc.VariableSet) error {
+	v.instance.Status.ObservedGeneration = v.instance.Generation
+	v.instance.Status.ID = variableSet.ID
+	v.instance.Status.Name = variableSet.Name
+	setSyncedCondition(&v.instance.Status.Conditions, v.instance.Generation, "", nil)
+
+	return r.Status().Update(ctx, &v.instance)
+}
+
+func (r *VariableSetReconciler) removeFinalizer(ctx context.Context, v *variableSetInstance) error {
+	controllerutil.RemoveFinalizer(&v.instance, variableSetFinalizer)
+
+	err := r.Update(ctx, &v.instance)
+	if err != nil {
+		v.log.Error(err, "Reconcile Variable Set", "msg", fmt.Sprintf("failed to remove finalizer %s", variableSetFinalizer))
+		r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "RemoveVariableSet", "Failed to remove finalizer %s", variableSetFinalizer)
+	}
+
+	return err
+}
+
+func needToUpdateVariableSet(instance *appv1alpha2.VariableSet, variableSet *tfc.VariableSet) bool {
+	// generation changed
+	if instance.Generation != instance.Status.ObservedGeneration {
+		return true
+	}
+
+	// attributes changed
+	spec := instance.Spec
+	if spec.Name != variableSet.Name ||
+		spec.Description != variableSet.Description ||
+		spec.Global != variableSet.Global ||
+		spec.Priority != variableSet.Priority {
+		return true
+	}
+
+	return false
+}
+
+func (r *VariableSetReconciler) createVariableSet(ctx context.Context, v *variableSetInstance) (*tfc.VariableSet, error) {
+	spec := v.instance.Spec
+	options := &tfc.VariableSetCreateOptions{
+		Name:        tfc.String(spec.Name),
+		Description: tfc.String(spec.Description),
+		Global:      tfc.Bool(spec.Global),
+		Priority:    tfc.Bool(spec.Priority),
+	}
+
+	variableSet, err := v.tfClient.Client.VariableSets.Create(ctx, spec.Organization, options)
+	if err != nil {
+		v.log.Error(err, "Reconcile Variable Set", "msg", "failed to create a new variable set")
+		r.Recorder.Event(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Failed to create a new variable set")
+		return nil, err
+	}
+
+	v.instance.Status.ID = variableSet.ID
+
+	return variableSet, nil
+}
+
+func (r *VariableSetReconciler) readVariableSet(ctx context.Context, v *variableSetInstance) (*tfc.VariableSet, error) {
+	return v.tfClient.Client.VariableSets.Read(ctx, v.instance.Status.ID, nil)
+}
+
+func (r *VariableSetReconciler) updateVariableSet(ctx context.Context, v *variableSetInstance) (*tfc.VariableSet, error) {
+	spec := v.instance.Spec
+	options := &tfc.VariableSetUpdateOptions{
+		Name:        tfc.String(spec.Name),
+		Description: tfc.String(spec.Description),
+		Global:      tfc.Bool(spec.Global),
+		Priority:    tfc.Bool(spec.Priority),
+	}
+
+	return v.tfClient.Client.VariableSets.Update(ctx, v.instance.Status.ID, options)
+}
+
+func (r *VariableSetReconciler) reconcileVariableSet(ctx context.Context, v *variableSetInstance) error {
+	v.log.Info("Reconcile Variable Set", "msg", "reconciling variable set")
+
+	var variableSet *tfc.VariableSet
+	var err error
+
+	defer func() {
+		// Update the status with the Variable Set ID. This is useful if the reconciliation failed.
+		// An example here would be the case when the variable set has been created successfully,
+		// but further reconciliation steps failed.
+		if variableSet != nil && variableSet.ID != "" {
+			v.instance.Status.ID = variableSet.ID
+			err = r.Status().Update(ctx, &v.instance)
+			if err != nil {
+				v.log.Error(err, "Variable Set Controller", "msg", "update status with variable set ID")
+				r.Recorder.Event(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Failed to update status with variable set ID")
+			}
+		}
+	}()
+
+	// verify whether the Kubernetes object has been marked as deleted and if so delete the variable set
+	if isDeletionCandidate(&v.instance, variableSetFinalizer) {
+		v.log.Info("Reconcile Variable Set", "msg", "object marked as deleted, need to delete variable set first")
+		r.Recorder.Event(&v.instance, corev1.EventTypeNormal, "ReconcileVariableSet", "Object marked as deleted, need to delete variable set first")
+		return r.deleteVariableSet(ctx, v)
+	}
+
+	// create a new variable set if variable set ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	if v.instance.IsCreationCandidate() {
+		v.log.Info("Reconcile Variable Set", "msg", "status.ID is empty, creating a new variable set")
+		r.Recorder.Event(&v.instance, corev1.EventTypeNormal, "ReconcileVariableSet", "Status.ID is empty, creating a new variable set")
+		_, err = r.createVariableSet(ctx, v)
+		if err != nil {
+			return err
+		}
+		v.log.Info("Reconcile Variable Set", "msg", "successfully created a new variable set")
+		r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "ReconcileVariableSet", "Successfully created a new variable set with ID %s", v.instance.Status.ID)
+	}
+
+	// read the HCP Terraform variable set to compare it with the Kubernetes object spec
+	variableSet, err = r.readVariableSet(ctx, v)
+	if err != nil {
+		// 'ResourceNotFound' means that the variable set was removed from HCP Terraform bypass the operator
+		if err == tfc.ErrResourceNotFound {
+			v.log.Info("Reconcile Variable Set", "msg", "variable set not found, creating a new variable set")
+			r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Variable set ID %s not found, creating a new variable set", v.instance.Status.ID)
+			variableSet, err = r.createVariableSet(ctx, v)
+			if err != nil {
+				return err
+			}
+			v.log.Info("Reconcile Variable Set", "msg", "successfully created a new variable set")
+			r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "ReconcileVariableSet", "Successfully created a new variable set with ID %s", v.instance.Status.ID)
+		} else {
+			v.log.Error(err, "Reconcile Variable Set", "msg", fmt.Sprintf("failed to read variable set ID %s", v.instance.Status.ID))
+			r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Failed to read variable set ID %s", v.instance.Status.ID)
+			return err
+		}
+	}
+
+	// update variable set if any changes have been made in the Kubernetes object spec or HCP Terraform variable set
+	if needToUpdateVariableSet(&v.instance, variableSet) {
+		v.log.Info("Reconcile Variable Set", "msg", fmt.Sprintf("observed and desired states are not matching, need to update variable set ID %s", v.instance.Status.ID))
+		// The response of the update call is not guaranteed to contain the workspaces and projects of the variable set.
+		// Keep the ones read before, unless the variable set became global, in which case it is no longer applied to them.
+		workspaces, projects := variableSet.Workspaces, variableSet.Projects
+		variableSet, err = r.updateVariableSet(ctx, v)
+		if err != nil {
+			v.log.Error(err, "Reconcile Variable Set", "msg", fmt.Sprintf("failed to update variable set ID %s", v.instance.Status.ID))
+			r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Failed to update variable set ID %s", v.instance.Status.ID)
+			return err
+		}
+		if !variableSet.Global {
+			variableSet.Workspaces, variableSet.Projects = workspaces, projects
+		}
+	} else {
+		v.log.Info("Reconcile Variable Set", "msg", fmt.Sprintf("observed and desired states are matching, no need to update variable set ID %s", v.instance.Status.ID))
+	}
+
+	// Reconcile Variables
+	err = r.reconcileVariables(ctx, v)
+	if err != nil {
+		v.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to reconcile variables in variable set ID %s", v.instance.Status.ID))
+		r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileVariables", "Failed to reconcile variables in variable set ID %s", v.instance.Status.ID)
+		return err
+	}
+	v.log.Info("Reconcile Variables", "msg", "successfully reconcilied variables")
+	r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "ReconcileVariables", "Reconcilied variables in variable set ID %s", v.instance.Status.ID)
+
+	// Reconcile Workspaces
+	err = r.reconcileWorkspaces(ctx, v, variableSet)
+	if err != nil {
+		v.log.Error(err, "Reconcile Workspaces", "msg", fmt.Sprintf("failed to reconcile workspaces of variable set ID %s", v.instance.Status.ID))
+		r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileWorkspaces", "Failed to reconcile workspaces of variable set ID %s", v.instance.Status.ID)
+		return err
+	}
+	v.log.Info("Reconcile Workspaces", "msg", "successfully reconcilied workspaces")
+	r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "ReconcileWorkspaces", "Reconcilied workspaces of variable set ID %s", v.instance.Status.ID)
+
+	// Reconcile Projects
+	err = r.reconcileProjects(ctx, v, variableSet)
+	if err != nil {
+		v.log.Error(err, "Reconcile Projects", "msg", fmt.Sprintf("failed to reconcile projects of variable set ID %s", v.instance.Status.ID))
+		r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileProjects", "Failed to reconcile projects of variable set ID %s", v.instance.Status.ID)
+		return err
+	}
+	v.log.Info("Reconcile Projects", "msg", "successfully reconcilied projects")
+	r.Recorder.Eventf(&v.instance, corev1.EventTypeNormal, "ReconcileProjects", "Reconcilied projects of variable set ID %s", v.instance.Status.ID)
+
+	return r.updateStatus(ctx, v, variableSet)
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *VariableSetReconciler) deleteVariableSet(ctx context.Context, v *variableSetInstance) error {
+	v.log.Info("Reconcile Variable Set", "msg", fmt.Sprintf("deletion policy is %s", v.instance.Spec.DeletionPolicy))
+
+	if v.instance.Status.ID == "" {
+		v.log.Info("Reconcile Variable Set", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", variableSetFinalizer))
+		return r.removeFinalizer(ctx, v)
+	}
+
+	switch v.instance.Spec.DeletionPolicy {
+	case appv1alpha2.VariableSetDeletionPolicyRetain:
+		v.log.Info("Reconcile Variable Set", "msg", fmt.Sprintf("remove finalizer %s", variableSetFinalizer))
+		return r.removeFinalizer(ctx, v)
+	case appv1alpha2.VariableSetDeletionPolicyDestroy:
+		err := v.tfClient.Client.VariableSets.Delete(ctx, v.instance.Status.ID)
+		if err != nil {
+			if err == tfc.ErrResourceNotFound {
+				v.log.Info("Reconcile Variable Set", "msg", "Variable set was not found, remove finalizer")
+				return r.removeFinalizer(ctx, v)
+			}
+			v.log.Error(err, "Reconcile Variable Set", "msg", fmt.Sprintf("failed to delete variable set ID %s, retry later", v.instance.Status.ID))
+			r.Recorder.Eventf(&v.instance, corev1.EventTypeWarning, "ReconcileVariableSet", "Failed to delete variable set ID %s, retry later", v.instance.Status.ID)
+			return err
+		}
+
+		v.log.Info("Reconcile Variable Set", "msg", fmt.Sprintf("Variable set ID %s has been deleted, remove finalizer", v.instance.Status.ID))
+		return r.removeFinalizer(ctx, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+// scopeChanges returns IDs to apply the variable set to and IDs to remove it from.
+// Only IDs the operator applied the variable set to before are removed, so the variable set stays applied
+// to workspaces and projects that were added outside of this object, e.g. via Workspace `spec.variableSets`.
+func scopeChanges(spec, current, status []string) ([]string, []string) {
+	var apply, remove []string
+
+	for _, id := range spec {
+		if !slices.Contains(current, id) {
+			apply = append(apply, id)
+		}
+	}
+
+	for _, id := range status {
+		if !slices.Contains(spec, id) && slices.Contains(current, id) {
+			remove = append(remove, id)
+		}
+	}
+
+	return apply, remove
+}
+
+// getWorkspaceIDs returns IDs of the workspaces the variable set should be applied to.
+func getWorkspaceIDs(ctx context.Context, v *variableSetInstance) ([]string, error) {
+	var ids []string
+
+	for _, sw := range v.instance.Spec.Workspaces {
+		if sw.ID != "" {
+			ids = append(ids, sw.ID)
+			continue
+		}
+		ws, err := v.tfClient.Client.Workspaces.Read(ctx, v.instance.Spec.Organization, sw.Name)
+		if err != nil {
+			v.log.Error(err, "Reconcile Workspaces", "msg", fmt.Sprintf("failed to get workspace ID by name %s", sw.Name))
+			return nil, err
+		}
+		ids = append(ids, ws.ID)
+	}
+
+	slices.Sort(ids)
+
+	return slices.Compact(ids), nil
+}
+
+// getProjectIDs returns IDs of the projects the variable set should be applied to.
+func getProjectIDs(ctx context.Context, v *variableSetInstance) ([]string, error) {
+	var ids []string
+
+	for _, sp := range v.instance.Spec.Projects {
+		if sp.ID != "" {
+			ids = append(ids, sp.ID)
+			continue
+		}
+		id, err := getProjectIDByName(ctx, v.tfClient.Client, v.instance.Spec.Organization, sp.Name)
+		if err != nil {
+			v.log.Error(err, "Reconcile Projects", "msg", fmt.Sprintf("failed to get project ID by name %s", sp.Name))
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	return slices.Compact(ids), nil
+}
+
+func (r *VariableSetReconciler) reconcileWorkspaces(ctx context.Context, v *variableSetInstance, variableSet *tfc.VariableSet) error {
+	v.log.Info("Reconcile Workspaces", "msg", "new reconciliation event")
+
+	if variableSet.Global {
+		v.log.Info("Reconcile Workspaces", "msg", "variable set is global and applies to all workspaces")
+		v.instance.Status.Workspaces = nil
+		return nil
+	}
+
+	specIDs, err := getWorkspaceIDs(ctx, v)
+	if err != nil {
+		return err
+	}
+	var currentIDs []string
+	for _, ws := range variableSet.Workspaces {
+		currentIDs = append(currentIDs, ws.ID)
+	}
+
+	apply, remove := scopeChanges(specIDs, currentIDs, v.instance.Status.Workspaces)
+	if len(apply) > 0 {
+		v.log.Info("Reconcile Workspaces", "msg", fmt.Sprintf("applying variable set to workspaces %v", apply))
+		options := &tfc.VariableSetApplyToWorkspacesOptions{}
+		for _, id := range apply {
+			options.Workspaces = append(options.Workspaces, &tfc.Workspace{ID: id})
+		}
+		if err := v.tfClient.Client.VariableSets.ApplyToWorkspaces(ctx, variableSet.ID, options); err != nil {
+			v.log.Error(err, "Reconcile Workspaces", "msg", "failed to apply variable set to workspaces")
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		v.log.Info("Reconcile Workspaces", "msg", fmt.Sprintf("removing variable set from workspaces %v", remove))
+		options := &tfc.VariableSetRemoveFromWorkspacesOptions{}
+		for _, id := range remove {
+			options.Workspaces = append(options.Workspaces, &tfc.Workspace{ID: id})
+		}
+		if err := v.tfClient.Client.VariableSets.RemoveFromWorkspaces(ctx, variableSet.ID, options); err != nil {
+			v.log.Error(err, "Reconcile Workspaces", "msg", "failed to remove variable set from workspaces")
+			return err
+		}
+	}
+
+	v.instance.Status.Workspaces = specIDs
+
+	return nil
+}
+
+func (r *VariableSetReconciler) reconcileProjects(ctx context.Context, v *variableSetInstance, variableSet *tfc.VariableSet) error {
+	v.log.Info("Reconcile Projects", "msg", "new reconciliation event")
+
+	if variableSet.Global {
+		v.log.Info("Reconcile Projects", "msg", "variable set is global and applies to all projects")
+		v.instance.Status.Projects = nil
+		return nil
+	}
+
+	specIDs, err := getProjectIDs(ctx, v)
+	if err != nil {
+		return err
+	}
+	var currentIDs []string
+	for _, p := range variableSet.Projects {
+		currentIDs = append(currentIDs, p.ID)
+	}
+
+	apply, remove := scopeChanges(specIDs, currentIDs, v.instance.Status.Projects)
+	if len(apply) > 0 {
+		v.log.Info("Reconcile Projects", "msg", fmt.Sprintf("applying variable set to projects %v", apply))
+		options := tfc.VariableSetApplyToProjectsOptions{}
+		for _, id := range apply {
+			options.Projects = append(options.Projects, &tfc.Project{ID: id})
+		}
+		if err := v.tfClient.Client.VariableSets.ApplyToProjects(ctx, variableSet.ID, options); err != nil {
+			v.log.Error(err, "Reconcile Projects", "msg", "failed to apply variable set to projects")
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		v.log.Info("Reconcile Projects", "msg", fmt.Sprintf("removing variable set from projects %v", remove))
+		options := tfc.VariableSetRemoveFromProjectsOptions{}
+		for _, id := range remove {
+			options.Projects = append(options.Projects, &tfc.Project{ID: id})
+		}
+		if err := v.tfClient.Client.VariableSets.RemoveFromProjects(ctx, variableSet.ID, options); err != nil {
+			v.log.Error(err, "Reconcile Projects", "msg", "failed to remove variable set from projects")
+			return err
+		}
+	}
+
+	v.instance.Status.Projects = specIDs
+
+	return nil
+}
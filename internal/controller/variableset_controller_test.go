@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestVariableSetReconcile(t *testing.T) {
+	t.Parallel()
+
+	variableSet := &appv1alpha2.VariableSet{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.VariableSetSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			TerraformVariables: []appv1alpha2.Variable{
+				{
+					Name:  "plain",
+					Value: "this",
+				},
+			},
+			EnvironmentVariables: []appv1alpha2.Variable{
+				{
+					Name:      "SECRET",
+					Sensitive: true,
+					ValueFrom: &appv1alpha2.ValueFrom{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "vars"},
+							Key:                  "secret",
+						},
+					},
+				},
+			},
+			Workspaces:     []appv1alpha2.VariableSetWorkspace{{Name: "this"}},
+			Projects:       []appv1alpha2.VariableSetProject{{Name: "this"}},
+			DeletionPolicy: appv1alpha2.VariableSetDeletionPolicyDestroy,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vars", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"secret": []byte("secret")},
+	}
+	f := newFakeAPI(t, variableSet, secret)
+	r := &VariableSetReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	ctx := context.TODO()
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(ctx, fakeOrganization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	other, err := c.Workspaces.Create(ctx, fakeOrganization, tfc.WorkspaceCreateOptions{Name: tfc.String("other")})
+	require.NoError(t, err)
+	p, err := c.Projects.Create(ctx, fakeOrganization, tfc.ProjectCreateOptions{Name: "this"})
+	require.NoError(t, err)
+
+	f.reconcile(t, r, variableSet)
+	requireSynced(t, variableSet.Status.Conditions)
+	assert.Contains(t, variableSet.Finalizers, variableSetFinalizer)
+	vs := f.server.VariableSet(variableSet.Status.ID)
+	require.NotNil(t, vs)
+	assert.Equal(t, "this", vs.Name)
+	assert.Equal(t, []string{ws.ID}, variableSet.Status.Workspaces)
+	assert.Equal(t, []string{p.ID}, variableSet.Status.Projects)
+	require.Len(t, vs.Workspaces, 1)
+	require.Len(t, vs.Projects, 1)
+	vars := map[string]*tfc.VariableSetVariable{}
+	for _, v := range f.server.VariableSetVariables(vs.ID) {
+		vars[v.Key] = v
+	}
+	require.Len(t, vars, 2)
+	assert.Equal(t, "this", vars["plain"].Value)
+	assert.Equal(t, tfc.CategoryTerraform, vars["plain"].Category)
+	assert.Equal(t, "secret", vars["SECRET"].Value)
+	assert.True(t, vars["SECRET"].Sensitive)
+	assert.Len(t, variableSet.Status.Variables, 2)
+
+	// Workspaces the variable set was applied to outside of the object must stay.
+	require.NoError(t, c.VariableSets.ApplyToWorkspaces(ctx, vs.ID, &tfc.VariableSetApplyToWorkspacesOptions{
+		Workspaces: []*tfc.Workspace{{ID: other.ID}},
+	}))
+
+	variableSet.Spec.Priority = true
+	variableSet.Spec.TerraformVariables[0].Value = "that"
+	variableSet.Spec.Workspaces = nil
+	variableSet.Spec.Projects = nil
+	variableSet.Generation++
+	require.NoError(t, f.client.Update(ctx, variableSet))
+	f.reconcile(t, r, variableSet)
+	requireSynced(t, variableSet.Status.Conditions)
+	vs = f.server.VariableSet(vs.ID)
+	assert.True(t, vs.Priority)
+	require.Len(t, vs.Workspaces, 1)
+	assert.Equal(t, other.ID, vs.Workspaces[0].ID)
+	assert.Empty(t, vs.Projects)
+	assert.Empty(t, variableSet.Status.Workspaces)
+	assert.Empty(t, variableSet.Status.Projects)
+	for _, v := range f.server.VariableSetVariables(vs.ID) {
+		if v.Key == "plain" {
+			assert.Equal(t, "that", v.Value)
+		}
+	}
+
+	f.delete(t, variableSet)
+	f.reconcile(t, r, variableSet)
+	assert.False(t, f.exists(t, variableSet))
+	assert.Nil(t, f.server.VariableSet(vs.ID))
+}
+
+func TestVariableSetReconcileRetain(t *testing.T) {
+	t.Parallel()
+
+	variableSet := &appv1alpha2.VariableSet{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.VariableSetSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			Global:         true,
+			DeletionPolicy: appv1alpha2.VariableSetDeletionPolicyRetain,
+		},
+	}
+	f := newFakeAPI(t, variableSet)
+	r := &VariableSetReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, variableSet)
+	requireSynced(t, variableSet.Status.Conditions)
+	vs := f.server.VariableSet(variableSet.Status.ID)
+	require.NotNil(t, vs)
+	assert.True(t, vs.Global)
+
+	f.delete(t, variableSet)
+	f.reconcile(t, r, variableSet)
+	assert.False(t, f.exists(t, variableSet))
+	assert.NotNil(t, f.server.VariableSet(vs.ID))
+}
+
+func TestVariableSetReconcileCreateKeepsConditions(t *testing.T) {
+	t.Parallel()
+
+	variableSet := &appv1alpha2.VariableSet{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.VariableSetSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			DeletionPolicy: appv1alpha2.VariableSetDeletionPolicyRetain,
+		},
+	}
+	// the finalizer is already set, thus the conditions set before the variable set is created are not reloaded from the object
+	variableSet.Finalizers = []string{variableSetFinalizer}
+	f := newFakeAPI(t, variableSet)
+	r := &VariableSetReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, variableSet)
+	requireSynced(t, variableSet.Status.Conditions)
+	require.NotEmpty(t, variableSet.Status.ID)
+	assert.True(t, meta.IsStatusConditionTrue(variableSet.Status.Conditions, appv1alpha2.ConditionTypeSpecValid))
+}
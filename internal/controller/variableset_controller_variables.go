@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// variableSetVariableValueID calculates a hash of a variable set variable.
+func variableSetVariableValueID(v tfc.VariableSetVariable) string {
+	return variableValueID(tfc.Variable{
+		Key:         v.Key,
+		Value:       v.Value,
+		Description: v.Description,
+		HCL:         v.HCL,
+		Sensitive:   v.Sensitive,
+	})
+}
+
+func createVariableSetVariable(ctx context.Context, v *variableSetInstance, variable tfc.VariableSetVariable) error {
+	v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("creating %s variable %s", variable.Category, variable.Key))
+	vv, err := v.tfClient.Client.VariableSetVariables.Create(ctx, v.instance.Status.ID, &tfc.VariableSetVariableCreateOptions{
+		Key:         &variable.Key,
+		Value:       &variable.Value,
+		Description: &variable.Description,
+		Category:    &variable.Category,
+		HCL:         &variable.HCL,
+		Sensitive:   &variable.Sensitive,
+	})
+	if err != nil {
+		v.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to create %s variable %s", variable.Category, variable.Key))
+		return err
+	}
+
+	v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("successfully created %s variable %s", variable.Category, variable.Key))
+	v.instance.Status.AddOrUpdateVariableStatus(appv1alpha2.VariableStatus{
+		Name:      vv.Key,
+		ID:        vv.ID,
+		VersionID: vv.VersionID,
+		ValueID:   variableSetVariableValueID(variable),
+		Category:  string(vv.Category),
+	})
+
+	return nil
+}
+
+func updateVariableSetVariable(ctx context.Context, v *variableSetInstance, specVariable, setVariable tfc.VariableSetVariable) error {
+	// If a variable is marked as sensitive, it cannot be updated to become non-sensitive.
+	// In such cases, the variable must be removed and a new one created.
+	if !specVariable.Sensitive && setVariable.Sensitive {
+		v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("updating %s variable %s due to sensitivity changes", specVariable.Category, specVariable.Key))
+		if err := deleteVariableSetVariable(ctx, v, setVariable); err != nil {
+			return err
+		}
+		if err := createVariableSetVariable(ctx, v, specVariable); err != nil {
+			return err
+		}
+		v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("successfully updated %s variable %s", specVariable.Category, specVariable.Key))
+
+		return nil
+	}
+
+	vID := variableSetVariableValueID(specVariable)
+	statusVariable := v.instance.Status.GetVariableStatus(appv1alpha2.VariableStatus{
+		Name:     specVariable.Key,
+		Category: string(specVariable.Category),
+	})
+	// Update a variable if one of three conditions is true:
+	// - A variable is not in Status, indicating that the variable is present in the variable set but not managed by the operator yet.
+	// - A variable's Status and variable set VersionID do not match, indicating that the variable has been updated outside of the operator.
+	// - A variable's Status and variable set ValueID do not match, indicating that the variable has been updated via the spec.
+	if statusVariable == nil || statusVariable.VersionID != setVariable.VersionID || statusVariable.ValueID != vID {
+		v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("updating %s variable %s", specVariable.Category, specVariable.Key))
+		vv, err := v.tfClient.Client.VariableSetVariables.Update(ctx, v.instance.Status.ID, setVariable.ID, &tfc.VariableSetVariableUpdateOptions{
+			Key:         &specVariable.Key,
+			Value:       &specVariable.Value,
+			Description: &specVariable.Description,
+			HCL:         &specVariable.HCL,
+			Sensitive:   &specVariable.Sensitive,
+		})
+		if err != nil {
+			v.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to update %s variable %s", specVariable.Category, specVariable.Key))
+			return err
+		}
+
+		v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("successfully updated %s variable %s", specVariable.Category, specVariable.Key))
+		v.instance.Status.AddOrUpdateVariableStatus(appv1alpha2.VariableStatus{
+			Name:      vv.Key,
+			ID:        vv.ID,
+			VersionID: vv.VersionID,
+			ValueID:   vID,
+			Category:  string(vv.Category),
+		})
+	}
+
+	return nil
+}
+
+func deleteVariableSetVariable(ctx context.Context, v *variableSetInstance, variable tfc.VariableSetVariable) error {
+	v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("deleting %s variable %s", variable.Category, variable.Key))
+	err := v.tfClient.Client.VariableSetVariables.Delete(ctx, v.instance.Status.ID, variable.ID)
+	if err != nil {
+		v.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to delete %s variable %s", variable.Category, variable.Key))
+		return err
+	}
+
+	v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("successfully deleted %s variable %s", variable.Category, variable.Key))
+	v.instance.Status.DeleteVariableStatus(appv1alpha2.VariableStatus{Name: variable.Key, Category: string(variable.Category)})
+
+	return nil
+}
+
+// getVariableSetVariables returns a list of all variables of the variable set.
+func getVariableSetVariables(ctx context.Context, v *variableSetInstance) ([]*tfc.VariableSetVariable, error) {
+	var o []*tfc.VariableSetVariable
+
+	v.log.Info("Reconcile Variables", "msg", "getting variable set variables")
+	listOpts := &tfc.VariableSetVariableListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	for {
+		vv, err := v.tfClient.Client.VariableSetVariables.List(ctx, v.instance.Status.ID, listOpts)
+		if err != nil {
+			v.log.Error(err, "Reconcile Variables", "msg", "failed to get variable set variables")
+			return nil, err
+		}
+		o = append(o, vv.Items...)
+		if vv.NextPage == 0 {
+			break
+		}
+		listOpts.PageNumber = vv.NextPage
+	}
+	v.log.Info("Reconcile Variables", "msg", "successfully got variable set variables")
+
+	return o, nil
+}
+
+// getVariablesByCategory returns a map of all instance variables by type.
+func (r *VariableSetReconciler) getVariablesByCategory(ctx context.Context, v *variableSetInstance, category tfc.CategoryType) (map[string]tfc.VariableSetVariable, error) {
+	variables := make(map[string]tfc.VariableSetVariable)
+	specVariables := v.instance.Spec.TerraformVariables
+	if category == tfc.CategoryEnv {
+		specVariables = v.instance.Spec.EnvironmentVariables
+	}
+
+	for _, sv := range specVariables {
+		value, err := variableValue(ctx, r.Client, v.instance.Namespace, sv)
+		if err != nil {
+			v.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to get value for the variable %s", sv.Name))
+			r.Recorder.Event(&v.instance, corev1.EventTypeWarning, "ReconcileVariables", fmt.Sprintf("Failed to get value for the variable %s", sv.Name))
+			return nil, err
+		}
+		variables[sv.Name] = tfc.VariableSetVariable{
+			Key:         sv.Name,
+			Value:       value,
+			Description: sv.Description,
+			Category:    category,
+			HCL:         sv.HCL,
+			Sensitive:   sv.Sensitive,
+		}
+	}
+
+	return variables, nil
+}
+
+// getVariableSetVariablesByCategory returns a map of all variable set variables by type.
+func getVariableSetVariablesByCategory(setVariables []*tfc.VariableSetVariable, category tfc.CategoryType) map[string]tfc.VariableSetVariable {
+	variables := make(map[string]tfc.VariableSetVariable)
+
+	for _, v := range setVariables {
+		if v.Category == category {
+			variables[v.Key] = *v
+		}
+	}
+
+	return variables
+}
+
+func (r *VariableSetReconciler) reconcileVariablesByCategory(ctx context.Context, v *variableSetInstance, variables []*tfc.VariableSetVariable, category tfc.CategoryType) error {
+	setVariables := getVariableSetVariablesByCategory(variables, category)
+	specVariables, err := r.getVariablesByCategory(ctx, v, category)
+	if err != nil {
+		return err
+	}
+
+	if len(specVariables) == 0 && len(setVariables) == 0 {
+		v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("there are no %s variables both in spec and variable set", category))
+		return nil
+	}
+
+	v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("there are %d %s variables in spec", len(specVariables), category))
+	v.log.Info("Reconcile Variables", "msg", fmt.Sprintf("there are %d %s variables in variable set", len(setVariables), category))
+
+	// The same approach as for workspace variables:
+	// - Spec variables that are not in the variable set get created.
+	// - Spec variables that are in the variable set get updated.
+	// - Variable set variables that are not in the spec get deleted.
+	for sk, sv := range specVariables {
+		if vv, ok := setVariables[sk]; ok {
+			if err := updateVariableSetVariable(ctx, v, sv, vv); err != nil {
+				return err
+			}
+			delete(setVariables, sk)
+		} else {
+			if err := createVariableSetVariable(ctx, v, sv); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, vv := range setVariables {
+		if err := deleteVariableSetVariable(ctx, v, vv); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *VariableSetReconciler) reconcileVariables(ctx context.Context, v *variableSetInstance) error {
+	v.log.Info("Reconcile Variables", "msg", "new reconciliation event")
+
+	setVariables, err := getVariableSetVariables(ctx, v)
+	if err != nil {
+		return err
+	}
+
+	if err = r.reconcileVariablesByCategory(ctx, v, setVariables, tfc.CategoryTerraform); err != nil {
+		return err
+	}
+
+	if err = r.reconcileVariablesByCategory(ctx, v, setVariables, tfc.CategoryEnv); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,27 +5,25 @@ package controller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
-	"os"
-	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
-	"github.com/hashicorp/hcp-terraform-operator/version"
 )
 
 type HCPTerraformClient struct {
@@ -35,8 +33,9 @@ type HCPTerraformClient struct {
 // WorkspaceReconciler reconciles a Workspace object
 type WorkspaceReconciler struct {
 	client.Client
-	Recorder record.EventRecorder
-	Scheme   *runtime.Scheme
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
 }
 
 type workspaceInstance struct {
@@ -50,8 +49,8 @@ type workspaceInstance struct {
 // +kubebuilder:rbac:groups=app.terraform.io,resources=workspaces/finalizers,verbs=update
 // +kubebuilder:rbac:groups=app.terraform.io,resources=workspaces/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;list;update;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;list;update;watch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;list;update;watch;delete
 
 func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	w := workspaceInstance{}
@@ -93,9 +92,14 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err := w.instance.ValidateSpec(); err != nil {
 		w.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
 		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&w.instance.Status.Conditions, w.instance.Generation, err)
+		if err := r.Status().Update(ctx, &w.instance); err != nil {
+			w.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
 		return doNotRequeue()
 	}
 	w.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&w.instance.Status.Conditions, w.instance.Generation, nil)
 
 	if needToAddFinalizer(&w.instance, workspaceFinalizer) {
 		err := r.addFinalizer(ctx, &w.instance)
@@ -112,13 +116,38 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err != nil {
 		w.log.Error(err, "Workspace Controller", "msg", "failed to get HCP Terraform client")
 		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &w.instance); err != nil {
+			w.log.Error(err, "Workspace Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 
+	if w.instance.Spec.ManagementMode == appv1alpha2.ManagementModeObserve {
+		err = r.observeWorkspace(ctx, &w)
+		if err != nil {
+			w.log.Error(err, "Workspace Controller", "msg", "observe workspace")
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to observe workspace")
+			setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+			if err := r.Status().Update(ctx, &w.instance); err != nil {
+				w.log.Error(err, "Workspace Controller", "msg", "failed to update status conditions")
+			}
+			return requeueAfter(requeueInterval)
+		}
+		w.log.Info("Workspace Controller", "msg", "successfully observed workspace")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspace", "Successfully observed workspace ID %s", w.instance.Status.WorkspaceID)
+
+		return requeueAfter(WorkspaceSyncPeriod)
+	}
+
 	err = r.reconcileWorkspace(ctx, &w)
 	if err != nil {
 		w.log.Error(err, "Workspace Controller", "msg", "reconcile workspace")
 		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to reconcile workspace")
+		setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &w.instance); err != nil {
+			w.log.Error(err, "Workspace Controller", "msg", "failed to update status conditions")
+		}
 		return requeueAfter(requeueInterval)
 	}
 	w.log.Info("Workspace Controller", "msg", "successfully reconcilied workspace")
@@ -134,51 +163,38 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return requeueAfter(requeueRunStatusInterval)
 	}
 
+	if d := w.instance.Status.DriftDetection; d != nil {
+		if d.RunID != "" && !d.RunCompleted() {
+			w.log.Info("Workspace Controller", "msg", fmt.Sprintf("drift detection run %s status %s is not completed need to requeue", d.RunID, d.RunStatus))
+			return requeueAfter(requeueRunStatusInterval)
+		}
+	}
+
+	if t := w.instance.Status.NextScheduleTime(); t != nil {
+		if next := time.Until(t.Time); next < WorkspaceSyncPeriod {
+			w.log.Info("Workspace Controller", "msg", fmt.Sprintf("next run is scheduled at %s need to requeue", t.Format(time.RFC3339)))
+			return requeueAfter(max(next, time.Second))
+		}
+	}
+
 	return requeueAfter(WorkspaceSyncPeriod)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *WorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&appv1alpha2.Workspace{}).
-		WithEventFilter(predicate.Or(genericPredicates(), workspacePredicates())).
-		Complete(r)
-}
-
-func (r *WorkspaceReconciler) getTerraformClient(ctx context.Context, w *workspaceInstance) error {
-	nn := types.NamespacedName{
-		Namespace: w.instance.Namespace,
-		Name:      w.instance.Spec.Token.SecretKeyRef.Name,
-	}
-	token, err := secretKeyRef(ctx, r.Client, nn, w.instance.Spec.Token.SecretKeyRef.Key)
-	if err != nil {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.Workspace{}); err != nil {
 		return err
 	}
 
-	httpClient := tfc.DefaultConfig().HTTPClient
-	insecure := false
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.Workspace{}, builder.WithPredicates(predicate.Or(genericPredicates(), workspacePredicates())))
 
-	if v, ok := os.LookupEnv("TFC_TLS_SKIP_VERIFY"); ok {
-		insecure, err = strconv.ParseBool(v)
-		if err != nil {
-			return err
-		}
-	}
-
-	if insecure {
-		w.log.Info("Reconcile Workspace", "msg", "client configured to skip TLS certificate verifications")
-	}
-
-	httpClient.Transport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure}
+	return watchReferences(mgr, b, &appv1alpha2.WorkspaceList{}).Complete(r)
+}
 
-	config := &tfc.Config{
-		Token:      token,
-		HTTPClient: httpClient,
-		Headers: http.Header{
-			"User-Agent": []string{version.UserAgent},
-		},
-	}
-	w.tfClient.Client, err = tfc.NewClient(config)
+func (r *WorkspaceReconciler) getTerraformClient(ctx context.Context, w *workspaceInstance) error {
+	var err error
+	w.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, w.log, w.instance.Namespace, w.instance.Spec.Organization, w.instance.Spec.Token, w.instance.Spec.ConnectionRef)
 
 	return err
 }
@@ -193,18 +209,18 @@ func needToUpdateWorkspace(instance *appv1alpha2.Workspace, workspace *tfc.Works
 		return true
 	}
 	// agent pool added
-	if instance.Spec.AgentPool != nil && workspace.AgentPool == nil {
+	if instance.Spec.AgentPool != nil && workspace.AgentPool == nil && !ignoresChanges(instance, "agentPool") {
 		return true
 	}
 	// The TFC API VCS behavior is inconsistent:
 	//   - once a VCS is attached to a Workspace, the 'UpdateAt' attribute is updated
 	//   - once attached to a Workspace VCS is updated, for instance, change a branch name, the 'UpdateAt' attribute is updated
 	//   - once a VCS is detached from a Workspace, the 'UpdateAt' attribute is not updated, because of that we have to have this condition here
-	if instance.Spec.VersionControl != nil && workspace.VCSRepo == nil {
+	if instance.Spec.VersionControl != nil && workspace.VCSRepo == nil && !ignoresChanges(instance, "versionControl") {
 		return true
 	}
 	// Terraform version
-	if instance.Spec.TerraformVersion == "" {
+	if instance.Spec.TerraformVersion == "" && !ignoresChanges(instance, "terraformVersion") {
 		if instance.Status.TerraformVersion != workspace.TerraformVersion {
 			return true
 		}
@@ -251,6 +267,9 @@ func (r *WorkspaceReconciler) updateStatus(ctx context.Context, w *workspaceInst
 	w.instance.Status.ObservedGeneration = w.instance.Generation
 	w.instance.Status.UpdateAt = workspace.UpdatedAt.Unix()
 	w.instance.Status.TerraformVersion = workspace.TerraformVersion
+	w.instance.Status.Observation = nil
+	setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, "", nil)
+	setRunSucceededCondition(&w.instance.Status.Conditions, w.instance.Generation, w.instance.Status.Run)
 
 	return r.Status().Update(ctx, &w.instance)
 }
@@ -340,53 +359,54 @@ func (r *WorkspaceReconciler) readWorkspace(ctx context.Context, w *workspaceIns
 	return w.tfClient.Client.Workspaces.ReadByID(ctx, w.instance.Status.WorkspaceID)
 }
 
-func (r *WorkspaceReconciler) updateWorkspace(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) (*tfc.Workspace, error) {
-	updateOptions := tfc.WorkspaceUpdateOptions{
-		GlobalRemoteState: tfc.Bool(false),
-	}
+func (r *WorkspaceReconciler) updateWorkspace(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace, refs workspaceRefs) (*tfc.Workspace, error) {
+	updateOptions := tfc.WorkspaceUpdateOptions{}
 	spec := w.instance.Spec
 	status := w.instance.Status
+	// changes of the ignored fields are not updated
+	ignores := func(field string) bool {
+		return ignoresChanges(&w.instance, field)
+	}
 
-	if spec.ExecutionMode == "agent" {
-		agentPoolID, err := r.getAgentPoolID(ctx, w)
-		if err != nil {
-			w.log.Error(err, "Reconcile Workspace", "msg", "failed to get agent pool ID")
-			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get agent pool ID")
-			return nil, err
-		}
-		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("agent pool ID %s will be used", agentPoolID))
-		updateOptions.AgentPoolID = tfc.String(agentPoolID)
+	if spec.ExecutionMode == "agent" && !ignores("agentPool") {
+		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("agent pool ID %s will be used", refs.agentPoolID))
+		updateOptions.AgentPoolID = tfc.String(refs.agentPoolID)
 	}
 
 	if workspace.Name != spec.Name {
 		updateOptions.Name = tfc.String(spec.Name)
 	}
 
-	if workspace.AutoApply != ApplyMethodToBool(spec.ApplyMethod) {
+	if workspace.AutoApply != ApplyMethodToBool(spec.ApplyMethod) && !ignores("applyMethod") {
 		updateOptions.AutoApply = tfc.Bool(ApplyMethodToBool(spec.ApplyMethod))
 	}
 
-	if workspace.AutoApplyRunTrigger != ApplyRunTriggerToBool(spec.ApplyRunTrigger) {
+	if workspace.AutoApplyRunTrigger != ApplyRunTriggerToBool(spec.ApplyRunTrigger) && !ignores("applyRunTrigger") {
 		updateOptions.AutoApplyRunTrigger = tfc.Bool(ApplyRunTriggerToBool(spec.ApplyRunTrigger))
 	}
 
-	if workspace.AllowDestroyPlan != spec.AllowDestroyPlan {
+	if workspace.AllowDestroyPlan != spec.AllowDestroyPlan && !ignores("allowDestroyPlan") {
 		updateOptions.AllowDestroyPlan = tfc.Bool(spec.AllowDestroyPlan)
 	}
 
-	if workspace.Description != spec.Description {
+	if workspace.Description != spec.Description && !ignores("description") {
 		updateOptions.Description = tfc.String(spec.Description)
 	}
 
-	if workspace.ExecutionMode != spec.ExecutionMode {
+	if workspace.ExecutionMode != spec.ExecutionMode && !ignores("executionMode") {
 		updateOptions.ExecutionMode = tfc.String(spec.ExecutionMode)
 	}
 
-	if spec.RemoteStateSharing != nil {
-		updateOptions.GlobalRemoteState = tfc.Bool(spec.RemoteStateSharing.AllWorkspaces)
+	if !ignores("remoteStateSharing") {
+		updateOptions.GlobalRemoteState = tfc.Bool(false)
+		if spec.RemoteStateSharing != nil {
+			updateOptions.GlobalRemoteState = tfc.Bool(spec.RemoteStateSharing.AllWorkspaces)
+		}
 	}
 
-	if spec.TerraformVersion == "" {
+	if ignores("terraformVersion") {
+		w.log.Info("Reconcile Workspace", "msg", "changes of the Terraform version are ignored")
+	} else if spec.TerraformVersion == "" {
 		if workspace.TerraformVersion != status.TerraformVersion {
 			updateOptions.TerraformVersion = tfc.String(status.TerraformVersion)
 		}
@@ -396,23 +416,23 @@ func (r *WorkspaceReconciler) updateWorkspace(ctx context.Context, w *workspaceI
 		}
 	}
 
-	if workspace.WorkingDirectory != spec.WorkingDirectory {
+	if workspace.WorkingDirectory != spec.WorkingDirectory && !ignores("workingDirectory") {
 		updateOptions.WorkingDirectory = tfc.String(spec.WorkingDirectory)
 		updateOptions.Name = tfc.String(spec.Name)
 	}
 
-	if workspace.ExecutionMode != spec.ExecutionMode {
+	if workspace.ExecutionMode != spec.ExecutionMode && !ignores("executionMode") {
 		updateOptions.ExecutionMode = tfc.String(spec.ExecutionMode)
 	}
 
-	if spec.VersionControl == nil && workspace.VCSRepo != nil {
+	if spec.VersionControl == nil && workspace.VCSRepo != nil && !ignores("versionControl") {
 		ws, err := w.tfClient.Client.Workspaces.RemoveVCSConnectionByID(ctx, workspace.ID)
 		if err != nil {
 			return ws, err
 		}
 	}
 
-	if spec.VersionControl != nil {
+	if spec.VersionControl != nil && !ignores("versionControl") {
 		updateOptions.VCSRepo = &tfc.VCSRepoOptions{
 			OAuthTokenID: tfc.String(spec.VersionControl.OAuthTokenID),
 			Identifier:   tfc.String(spec.VersionControl.Repository),
@@ -427,26 +447,20 @@ func (r *WorkspaceReconciler) updateWorkspace(ctx context.Context, w *workspaceI
 			updateOptions.FileTriggersEnabled = tfc.Bool(spec.VersionControl.EnableFileTriggers)
 		}
 
-		triggerPatternsDiff := vcsTriggersDifference(getWorkspaceTriggerPatterns(workspace), getTriggerPatterns(&w.instance))
-		if len(triggerPatternsDiff) != 0 {
+		if vcsTriggersChanged(getWorkspaceTriggerPatterns(workspace), getTriggerPatterns(&w.instance)) {
 			updateOptions.TriggerPatterns = spec.VersionControl.TriggerPatterns
 		}
 
-		triggerPrefixesDiff := vcsTriggersDifference(getWorkspaceTriggerPrefixes(workspace), getTriggerPrefixes(&w.instance))
-		if len(triggerPrefixesDiff) != 0 {
+		if vcsTriggersChanged(getWorkspaceTriggerPrefixes(workspace), getTriggerPrefixes(&w.instance)) {
 			updateOptions.TriggerPrefixes = spec.VersionControl.TriggerPrefixes
 		}
 	}
 
-	if spec.Project != nil {
-		prjID, err := w.getProjectID(ctx)
-		if err != nil {
-			w.log.Error(err, "Reconcile Workspace", "msg", "failed to get project ID")
-			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get project ID")
-			return nil, err
-		}
-		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("project ID %s will be used", prjID))
-		updateOptions.Project = &tfc.Project{ID: prjID}
+	if ignores("project") {
+		w.log.Info("Reconcile Workspace", "msg", "changes of the project are ignored")
+	} else if spec.Project != nil {
+		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("project ID %s will be used", refs.projectID))
+		updateOptions.Project = &tfc.Project{ID: refs.projectID}
 	} else {
 		if w.instance.Status.DefaultProjectID != "" {
 			updateOptions.Project = &tfc.Project{ID: w.instance.Status.DefaultProjectID}
@@ -485,8 +499,6 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 		// Cannot call updateStatus method since it updated multiple fields and can break reconciliation logic.
 		//
 		// TODO:
-		// - Use conditions(https://maelvls.dev/kubernetes-conditions/)
-		// - Let Objects update their own status conditions
 		// - Simplify updateStatus method in a way it could be called anytime
 		if workspace != nil && workspace.ID != "" {
 			patch := client.MergeFrom(w.instance.DeepCopy())
@@ -503,9 +515,24 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 	if isDeletionCandidate(&w.instance, workspaceFinalizer) {
 		w.log.Info("Reconcile Workspace", "msg", "object marked as deleted, need to delete workspace first")
 		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspace", "Object marked as deleted, need to delete workspace first")
+		deleteDriftMetric(&w.instance)
 		return r.deleteWorkspace(ctx, w)
 	}
 
+	// adopt an existing workspace instead of creating a new one if requested
+	if w.instance.IsCreationCandidate() && w.instance.Spec.Adopt != nil {
+		w.log.Info("Reconcile Workspace", "msg", "status.WorkspaceID is empty, adopting an existing workspace")
+		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspace", "Status.WorkspaceID is empty, adopting an existing workspace")
+		workspace, err = r.adoptWorkspace(ctx, w)
+		if err != nil {
+			w.log.Error(err, "Reconcile Workspace", "msg", "failed to adopt an existing workspace")
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to adopt an existing workspace: %s", err)
+			return err
+		}
+		w.log.Info("Reconcile Workspace", "msg", "successfully adopted an existing workspace")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspace", "Successfully adopted an existing workspace with ID %s", w.instance.Status.WorkspaceID)
+	}
+
 	// create a new workspace if workspace ID is unknown(means it was never created by the controller)
 	// this condition will work just one time, when a new Kubernetes object is created
 	if w.instance.IsCreationCandidate() {
@@ -521,6 +548,12 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspace", "Successfully created a new workspace with ID %s", w.instance.Status.WorkspaceID)
 	}
 
+	if err := r.verifyAdoption(ctx, w); err != nil {
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to verify the adopted workspace")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to verify the adopted workspace: %s", err)
+		return err
+	}
+
 	// read the HCP Terraform workspace to compare it with the Kubernetes object spec
 	workspace, err = r.readWorkspace(ctx, w)
 	if err != nil {
@@ -543,10 +576,31 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 		}
 	}
 
+	refs, err := r.resolveWorkspaceRefs(ctx, w)
+	if err != nil {
+		return err
+	}
+
+	// compare the workspace settings with the spec to correct changes made outside of the Operator
+	drift := workspaceSettingsDrift(w, workspace, refs)
+	switch {
+	case len(drift) == 0:
+		w.instance.Status.SettingsDrift = nil
+	case w.instance.Generation == w.instance.Status.ObservedGeneration:
+		// changes of the spec are not drift, the drift is only reported once the spec has been applied
+		fields := strings.Join(drift, ", ")
+		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("settings of workspace ID %s drifted from the spec: %s", w.instance.Status.WorkspaceID, fields))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Settings of workspace ID %s drifted from the spec: %s", w.instance.Status.WorkspaceID, fields)
+		w.instance.Status.SettingsDrift = &appv1alpha2.WorkspaceSettingsDriftStatus{
+			Fields:     drift,
+			DetectedAt: metav1.Now(),
+		}
+	}
+
 	// update workspace if any changes have been made in the Kubernetes object spec or HCP Terraform workspace
-	if needToUpdateWorkspace(&w.instance, workspace) {
+	if needToUpdateWorkspace(&w.instance, workspace) || len(drift) > 0 {
 		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("observed and desired states are not matching, need to update workspace ID %s", w.instance.Status.WorkspaceID))
-		workspace, err = r.updateWorkspace(ctx, w, workspace)
+		workspace, err = r.updateWorkspace(ctx, w, workspace, refs)
 		if err != nil {
 			w.log.Error(err, "Reconcile Workspace", "msg", fmt.Sprintf("failed to update workspace ID %s", w.instance.Status.WorkspaceID))
 			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to update workspace ID %s", w.instance.Status.WorkspaceID)
@@ -569,6 +623,15 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileSSHKey", "Successfully reconcile SSH Key")
 	}
 
+	// Reconcile Lock
+	err = r.reconcileLock(ctx, w, workspace)
+	if err != nil {
+		w.log.Error(err, "Reconcile Lock", "msg", "failed to reconcile lock")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileLock", "Failed to reconcile lock in workspace ID %s", w.instance.Status.WorkspaceID)
+		return err
+	}
+	w.log.Info("Reconcile Lock", "msg", "successfully reconcilied lock")
+
 	// Reconcile Tags
 	err = r.reconcileTags(ctx, w, workspace)
 	if err != nil {
@@ -660,6 +723,24 @@ func (r *WorkspaceReconciler) reconcileWorkspace(ctx context.Context, w *workspa
 	w.log.Info("Reconcile Runs", "msg", "successfully reconcilied runs")
 	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileRuns", "Successfully reconcilied runs in workspace ID %s", w.instance.Status.WorkspaceID)
 
+	// Reconcile Drift Detection
+	err = r.reconcileDriftDetection(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile Drift Detection", "msg", "failed to reconcile drift detection")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileDriftDetection", "Failed to reconcile drift detection in workspace ID %s", w.instance.Status.WorkspaceID)
+		return err
+	}
+	w.log.Info("Reconcile Drift Detection", "msg", "successfully reconcilied drift detection")
+
+	// Reconcile Schedules
+	err = r.reconcileSchedules(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile Schedules", "msg", "failed to reconcile schedules")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileSchedules", "Failed to reconcile schedules in workspace ID %s", w.instance.Status.WorkspaceID)
+		return err
+	}
+	w.log.Info("Reconcile Schedules", "msg", "successfully reconcilied schedules")
+
 	// Reconcile Outputs
 	// This reconciliation should always happen after `reconcileRuns`
 	// TODO:
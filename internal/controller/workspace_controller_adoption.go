@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// adoptWorkspace looks up the existing workspace to adopt by ID or name and records it in the status.
+// A workspace that another Workspace object already manages is not adopted.
+func (r *WorkspaceReconciler) adoptWorkspace(ctx context.Context, w *workspaceInstance) (*tfc.Workspace, error) {
+	spec := w.instance.Spec
+
+	var workspace *tfc.Workspace
+	var err error
+	if id := spec.Adopt.ID; id != "" {
+		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("get workspace ID %s to adopt", id))
+		workspace, err = w.tfClient.Client.Workspaces.ReadByID(ctx, id)
+	} else {
+		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("get workspace %s to adopt", spec.Name))
+		workspace, err = w.tfClient.Client.Workspaces.Read(ctx, spec.Organization, spec.Name)
+	}
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to get workspace to adopt")
+		return nil, err
+	}
+	if workspace.Organization != nil && workspace.Organization.Name != spec.Organization {
+		return nil, fmt.Errorf("workspace ID %s belongs to organization %s, not %s", workspace.ID, workspace.Organization.Name, spec.Organization)
+	}
+
+	owner, err := r.workspaceOwner(ctx, w, workspace.ID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to list workspace objects")
+		return nil, err
+	}
+	if owner != nil {
+		return nil, fmt.Errorf("workspace ID %s is already managed by %s", workspace.ID, client.ObjectKeyFromObject(owner))
+	}
+
+	org, err := w.tfClient.Client.Organizations.Read(ctx, spec.Organization)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to get organization")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get organization")
+		return nil, err
+	}
+
+	patch := client.MergeFrom(w.instance.DeepCopy())
+	if org.DefaultProject != nil {
+		w.instance.Status.DefaultProjectID = org.DefaultProject.ID
+	}
+	w.instance.Status.WorkspaceID = workspace.ID
+	w.instance.Status.Adoption = &appv1alpha2.WorkspaceAdoptionStatus{
+		AdoptedAt: metav1.Time{Time: time.Now()},
+	}
+	if spec.Adopt.Snapshot {
+		w.instance.Status.Adoption.Snapshot = workspaceSettingsSnapshot(workspace)
+	}
+	if err = r.Status().Patch(ctx, &w.instance, patch); err != nil {
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to update status with workspace ID")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to update status with workspace ID")
+		return nil, err
+	}
+	w.log.Info("Reconcile Workspace", "msg", "successfully updated status with workspace ID")
+
+	return workspace, nil
+}
+
+// verifyAdoption verifies that no older Workspace object manages the adopted workspace.
+// This protects from two objects that adopted the same workspace at the same time.
+func (r *WorkspaceReconciler) verifyAdoption(ctx context.Context, w *workspaceInstance) error {
+	if w.instance.Status.Adoption == nil {
+		return nil
+	}
+
+	owner, err := r.olderWorkspaceOwner(ctx, w)
+	if err != nil {
+		return err
+	}
+	if owner != nil {
+		return fmt.Errorf("workspace ID %s is already managed by %s", w.instance.Status.WorkspaceID, client.ObjectKeyFromObject(owner))
+	}
+
+	return nil
+}
+
+// olderWorkspaceOwner returns another Workspace object that was created before the given one and manages the same workspace or nil.
+// It happens when several objects adopt the same workspace at the same time.
+func (r *WorkspaceReconciler) olderWorkspaceOwner(ctx context.Context, w *workspaceInstance) (*appv1alpha2.Workspace, error) {
+	owner, err := r.workspaceOwner(ctx, w, w.instance.Status.WorkspaceID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace", "msg", "failed to list workspace objects")
+		return nil, err
+	}
+	if owner != nil && owner.CreationTimestamp.Before(&w.instance.CreationTimestamp) {
+		return owner, nil
+	}
+
+	return nil, nil
+}
+
+// workspaceOwner returns another Workspace object that manages the workspace with a given ID or nil.
+// When several objects claim the same workspace, the oldest one is considered to be the owner.
+func (r *WorkspaceReconciler) workspaceOwner(ctx context.Context, w *workspaceInstance, workspaceID string) (*appv1alpha2.Workspace, error) {
+	list := &appv1alpha2.WorkspaceList{}
+	if err := r.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	var owners []*appv1alpha2.Workspace
+	for i := range list.Items {
+		o := &list.Items[i]
+		if (o.Namespace == w.instance.Namespace && o.Name == w.instance.Name) || o.Status.WorkspaceID != workspaceID {
+			continue
+		}
+		owners = append(owners, o)
+	}
+	if len(owners) == 0 {
+		return nil, nil
+	}
+
+	return slices.MinFunc(owners, func(a, b *appv1alpha2.Workspace) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	}), nil
+}
+
+// workspaceSettingsSnapshot returns the settings of a given workspace.
+func workspaceSettingsSnapshot(workspace *tfc.Workspace) *appv1alpha2.WorkspaceSettingsSnapshot {
+	snapshot := &appv1alpha2.WorkspaceSettingsSnapshot{
+		Name:             workspace.Name,
+		Description:      workspace.Description,
+		ExecutionMode:    workspace.ExecutionMode,
+		AutoApply:        workspace.AutoApply,
+		AllowDestroyPlan: workspace.AllowDestroyPlan,
+		TerraformVersion: workspace.TerraformVersion,
+		WorkingDirectory: workspace.WorkingDirectory,
+		Tags:             slices.Sorted(slices.Values(workspace.TagNames)),
+	}
+	if workspace.Project != nil {
+		snapshot.ProjectID = workspace.Project.ID
+	}
+	if workspace.AgentPool != nil {
+		snapshot.AgentPoolID = workspace.AgentPool.ID
+	}
+	if workspace.VCSRepo != nil {
+		snapshot.VCSRepository = workspace.VCSRepo.Identifier
+		snapshot.VCSBranch = workspace.VCSRepo.Branch
+	}
+
+	return snapshot
+}
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+// createExistingWorkspace creates a workspace in the fake API outside of the Operator.
+func createExistingWorkspace(t *testing.T, f *fakeAPI, name string) *tfc.Workspace {
+	t.Helper()
+
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(context.TODO(), fakeOrganization, tfc.WorkspaceCreateOptions{
+		Name:             pointer.PointerOf(name),
+		Description:      pointer.PointerOf("Created manually"),
+		AutoApply:        pointer.PointerOf(true),
+		WorkingDirectory: pointer.PointerOf("infra"),
+		Tags:             []*tfc.Tag{{Name: "team-b"}, {Name: "team-a"}},
+	})
+	require.NoError(t, err)
+
+	return ws
+}
+
+func TestWorkspaceReconcileAdoptByName(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Description:   "Managed by the Operator",
+			Adopt:         &appv1alpha2.WorkspaceAdoption{Snapshot: true},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+	existing := createExistingWorkspace(t, f, "this")
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Equal(t, existing.ID, workspace.Status.WorkspaceID)
+	assert.Len(t, f.server.Workspaces(fakeOrganization), 1)
+	require.NotNil(t, workspace.Status.Adoption)
+	assert.False(t, workspace.Status.Adoption.AdoptedAt.IsZero())
+
+	// The snapshot keeps the settings the workspace had before the adoption.
+	snapshot := workspace.Status.Adoption.Snapshot
+	require.NotNil(t, snapshot)
+	assert.Equal(t, "this", snapshot.Name)
+	assert.Equal(t, "Created manually", snapshot.Description)
+	assert.Equal(t, existing.Project.ID, snapshot.ProjectID)
+	assert.True(t, snapshot.AutoApply)
+	assert.Equal(t, "infra", snapshot.WorkingDirectory)
+	assert.Equal(t, []string{"team-a", "team-b"}, snapshot.Tags)
+
+	// The Operator takes over the management of the workspace.
+	ws := f.server.Workspace(existing.ID)
+	assert.Equal(t, "Managed by the Operator", ws.Description)
+	assert.False(t, ws.AutoApply)
+	assert.Empty(t, ws.WorkingDirectory)
+}
+
+func TestWorkspaceReconcileAdoptByID(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+	existing := createExistingWorkspace(t, f, "legacy")
+
+	workspace.Spec.Adopt = &appv1alpha2.WorkspaceAdoption{ID: existing.ID}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Equal(t, existing.ID, workspace.Status.WorkspaceID)
+	require.NotNil(t, workspace.Status.Adoption)
+	assert.Nil(t, workspace.Status.Adoption.Snapshot)
+
+	// The workspace is renamed to match the spec.
+	assert.Equal(t, "this", f.server.Workspace(existing.ID).Name)
+
+	// An unknown workspace is not adopted and not created.
+	other := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("other"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "other",
+			Adopt:         &appv1alpha2.WorkspaceAdoption{},
+		},
+	}
+	require.NoError(t, f.client.Create(context.TODO(), other))
+	f.reconcile(t, r, other)
+	c := meta.FindStatusCondition(other.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Empty(t, other.Status.WorkspaceID)
+	assert.Len(t, f.server.Workspaces(fakeOrganization), 1)
+}
+
+func TestWorkspaceReconcileAdoptConflict(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	workspace.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.NotEmpty(t, workspace.Status.WorkspaceID)
+
+	// Another object cannot adopt the workspace that is already managed.
+	other := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("other"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Adopt:         &appv1alpha2.WorkspaceAdoption{},
+		},
+	}
+	other.CreationTimestamp = metav1.Now()
+	require.NoError(t, f.client.Create(context.TODO(), other))
+	f.reconcile(t, r, other)
+	c := meta.FindStatusCondition(other.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Contains(t, c.Message, "default/this")
+	assert.Empty(t, other.Status.WorkspaceID)
+
+	// An object that adopted the workspace at the same time as an older object stops managing it.
+	other.Status.WorkspaceID = workspace.Status.WorkspaceID
+	other.Status.Adoption = &appv1alpha2.WorkspaceAdoptionStatus{AdoptedAt: metav1.Now()}
+	require.NoError(t, f.client.Status().Update(context.TODO(), other))
+	other.Spec.Description = "Conflict"
+	require.NoError(t, f.client.Update(context.TODO(), other))
+	f.reconcile(t, r, other)
+	c = meta.FindStatusCondition(other.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Empty(t, f.server.Workspace(workspace.Status.WorkspaceID).Description)
+}
+
+func TestWorkspaceReconcileAdoptConflictDelete(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []appv1alpha2.DeletionPolicy{appv1alpha2.DeletionPolicySoft, appv1alpha2.DeletionPolicyDestroy} {
+		t.Run(string(policy), func(t *testing.T) {
+			t.Parallel()
+
+			workspace := &appv1alpha2.Workspace{
+				ObjectMeta: objectMeta("this"),
+				Spec: appv1alpha2.WorkspaceSpec{
+					Organization:  fakeOrganization,
+					ConnectionRef: connectionRef(),
+					Name:          "this",
+				},
+			}
+			workspace.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			f := newFakeAPI(t, workspace)
+			r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+			f.reconcile(t, r, workspace)
+			requireSynced(t, workspace.Status.Conditions)
+			require.NotEmpty(t, workspace.Status.WorkspaceID)
+
+			// A younger object that adopted the workspace at the same time as the older object does not delete it.
+			other := &appv1alpha2.Workspace{
+				ObjectMeta: objectMeta("other"),
+				Spec: appv1alpha2.WorkspaceSpec{
+					Organization:     fakeOrganization,
+					ConnectionRef:    connectionRef(),
+					Name:             "this",
+					Adopt:            &appv1alpha2.WorkspaceAdoption{},
+					AllowDestroyPlan: true,
+					DeletionPolicy:   policy,
+				},
+			}
+			other.CreationTimestamp = metav1.Now()
+			other.Finalizers = []string{workspaceFinalizer}
+			require.NoError(t, f.client.Create(context.TODO(), other))
+			other.Status.WorkspaceID = workspace.Status.WorkspaceID
+			other.Status.Adoption = &appv1alpha2.WorkspaceAdoptionStatus{AdoptedAt: metav1.Now()}
+			require.NoError(t, f.client.Status().Update(context.TODO(), other))
+
+			f.delete(t, other)
+			f.reconcile(t, r, other)
+			assert.False(t, f.exists(t, other))
+			ws := f.server.Workspace(workspace.Status.WorkspaceID)
+			require.NotNil(t, ws)
+			assert.Nil(t, ws.CurrentRun)
+		})
+	}
+}
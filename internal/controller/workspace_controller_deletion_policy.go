@@ -6,9 +6,11 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
@@ -21,6 +23,24 @@ func (r *WorkspaceReconciler) deleteWorkspace(ctx context.Context, w *workspaceI
 		return r.removeFinalizer(ctx, w)
 	}
 
+	// The workspace is left untouched when an older object manages it, e.g. when both objects adopted it at the same time.
+	owner, err := r.olderWorkspaceOwner(ctx, w)
+	if err != nil {
+		return err
+	}
+	if owner != nil {
+		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("workspace ID %s is managed by %s, remove finalizer %s", w.instance.Status.WorkspaceID, client.ObjectKeyFromObject(owner), workspaceFinalizer))
+		return r.removeFinalizer(ctx, w)
+	}
+
+	// The workspace cannot be deleted or destroyed while it is locked by the Operator.
+	if w.instance.Status.Lock != nil && w.instance.Spec.DeletionPolicy != appv1alpha2.DeletionPolicyRetain {
+		if err := r.unlockWorkspace(ctx, w); err != nil && err != tfc.ErrResourceNotFound {
+			return err
+		}
+		w.instance.Status.Lock = nil
+	}
+
 	switch w.instance.Spec.DeletionPolicy {
 	case appv1alpha2.DeletionPolicyRetain:
 		w.log.Info("Reconcile Workspace", "msg", fmt.Sprintf("remove finalizer %s", workspaceFinalizer))
@@ -105,6 +125,13 @@ func (r *WorkspaceReconciler) deleteWorkspace(ctx context.Context, w *workspaceI
 		}
 		w.log.Info("Destroy Run", "msg", fmt.Sprintf("destroy run %s is not finished", run.ID))
 
+		// The destroy run is queued behind the current run, which is stopped if it is stuck.
+		if run.Status == tfc.RunPending {
+			if err := r.reconcileBlockingRunTimeout(ctx, w, run.ID); err != nil {
+				return err
+			}
+		}
+
 		w.updateWorkspaceStatusRun(run)
 		return r.Status().Update(ctx, &w.instance)
 	case appv1alpha2.DeletionPolicyForce:
@@ -126,6 +153,36 @@ func (r *WorkspaceReconciler) deleteWorkspace(ctx context.Context, w *workspaceI
 	return nil
 }
 
+// reconcileBlockingRunTimeout stops the runs of the workspace that block a given destroy run if they exceeded the timeout.
+func (r *WorkspaceReconciler) reconcileBlockingRunTimeout(ctx context.Context, w *workspaceInstance, destroyRunID string) error {
+	if w.instance.Spec.PlanTimeoutSeconds == nil {
+		return nil
+	}
+
+	var statuses []string
+	for _, s := range runPlanTimeoutStatuses {
+		statuses = append(statuses, string(s))
+	}
+	runs, err := w.tfClient.Client.Runs.List(ctx, w.instance.Status.WorkspaceID, &tfc.RunListOptions{
+		Status: strings.Join(statuses, ","),
+	})
+	if err != nil {
+		w.log.Error(err, "Destroy Run", "msg", "failed to list runs")
+		return err
+	}
+
+	for _, run := range runs.Items {
+		if run.ID == destroyRunID {
+			continue
+		}
+		if err := r.reconcileRunTimeout(ctx, w, run); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *WorkspaceReconciler) handleWorkspaceErrorNotFound(ctx context.Context, w *workspaceInstance, err error) error {
 	if err == tfc.ErrResourceNotFound {
 		w.log.Info("Reconcile Workspace", "msg", "Workspace was not found, remove finalizer")
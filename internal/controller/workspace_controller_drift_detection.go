@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// runStatusAwaitingConfirmation are the run statuses in which non-speculative runs wait for a confirmation to apply.
+var runStatusAwaitingConfirmation = []tfc.RunStatus{
+	tfc.RunPlanned,
+	tfc.RunCostEstimated,
+	tfc.RunPolicyChecked,
+	tfc.RunPostPlanCompleted,
+}
+
+func (r *WorkspaceReconciler) reconcileDriftDetection(ctx context.Context, w *workspaceInstance) error {
+	w.log.Info("Reconcile Drift Detection", "msg", "new reconciliation event")
+
+	spec := w.instance.Spec.DriftDetection
+	if spec == nil {
+		if w.instance.Status.DriftDetection != nil {
+			w.log.Info("Reconcile Drift Detection", "msg", "drift detection is disabled, clean up status")
+			w.instance.Status.DriftDetection = nil
+			deleteDriftMetric(&w.instance)
+		}
+		return nil
+	}
+
+	if w.instance.Status.DriftDetection == nil {
+		w.instance.Status.DriftDetection = &appv1alpha2.DriftDetectionStatus{}
+	}
+	status := w.instance.Status.DriftDetection
+
+	if status.RunID != "" && !status.RunCompleted() {
+		if err := r.reconcileDriftDetectionRun(ctx, w); err != nil {
+			return err
+		}
+		if !status.RunCompleted() {
+			w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("drift detection run %s is not completed", status.RunID))
+			return nil
+		}
+	}
+
+	now := time.Now()
+	if status.NextScheduleTime != nil && !now.Before(status.NextScheduleTime.Time) {
+		if err := r.triggerDriftDetectionRun(ctx, w); err != nil {
+			return err
+		}
+		status.LastScheduleTime = &metav1.Time{Time: now}
+	}
+
+	// The next schedule time is computed again when the spec changes, since the schedule could be updated.
+	if status.NextScheduleTime == nil || !now.Before(status.NextScheduleTime.Time) || w.instance.Generation != w.instance.Status.ObservedGeneration {
+		next, err := spec.NextScheduleTime(now)
+		if err != nil {
+			w.log.Error(err, "Reconcile Drift Detection", "msg", "failed to compute the next schedule time")
+			return err
+		}
+		status.NextScheduleTime = &metav1.Time{Time: next}
+		w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("the next drift detection run is scheduled at %s", next.Format(time.RFC3339)))
+	}
+
+	return nil
+}
+
+func (r *WorkspaceReconciler) triggerDriftDetectionRun(ctx context.Context, w *workspaceInstance) error {
+	spec := w.instance.Spec.DriftDetection
+	status := w.instance.Status.DriftDetection
+
+	options := tfc.RunCreateOptions{
+		Message:   tfc.String(driftDetectionRunMessage),
+		Workspace: &tfc.Workspace{ID: w.instance.Status.WorkspaceID},
+	}
+	switch spec.RunType {
+	case appv1alpha2.DriftDetectionRunTypePlan:
+		options.PlanOnly = tfc.Bool(true)
+	default:
+		options.RefreshOnly = tfc.Bool(true)
+		options.AutoApply = tfc.Bool(spec.AutoApply)
+	}
+
+	w.log.Info("Reconcile Drift Detection", "msg", "create a new drift detection run")
+	run, err := w.tfClient.Client.Runs.Create(ctx, options)
+	if err != nil {
+		w.log.Error(err, "Reconcile Drift Detection", "msg", "failed to create a new drift detection run")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileDriftDetection", "Failed to create a new drift detection run in workspace ID %s", w.instance.Status.WorkspaceID)
+		return err
+	}
+	w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("successfully created a new drift detection run %s", run.ID))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileDriftDetection", "Successfully created a new drift detection run %s", run.ID)
+
+	status.RunID = run.ID
+	status.RunStatus = string(run.Status)
+	status.ApplyRunID = ""
+
+	return nil
+}
+
+func (r *WorkspaceReconciler) reconcileDriftDetectionRun(ctx context.Context, w *workspaceInstance) error {
+	spec := w.instance.Spec.DriftDetection
+	status := w.instance.Status.DriftDetection
+
+	w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("get the drift detection run %s status", status.RunID))
+	run, err := w.tfClient.Client.Runs.Read(ctx, status.RunID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Drift Detection", "msg", fmt.Sprintf("failed to get the drift detection run %s status", status.RunID))
+		return err
+	}
+	status.RunStatus = string(run.Status)
+
+	switch {
+	case run.Status == tfc.RunPlannedAndFinished:
+		r.setDriftDetectionResult(w, run.HasChanges)
+		if run.HasChanges && spec.RunType == appv1alpha2.DriftDetectionRunTypePlan && spec.AutoApply {
+			return r.triggerDriftApplyRun(ctx, w)
+		}
+	case run.Status == tfc.RunApplied:
+		r.setDriftDetectionResult(w, true)
+		status.ApplyRunID = run.ID
+	case run.Status == tfc.RunErrored || run.Status == tfc.RunCanceled || run.Status == tfc.RunDiscarded:
+		w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("drift detection run %s is %s", run.ID, run.Status))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileDriftDetection", "Drift detection run %s is %s", run.ID, run.Status)
+	case slices.Contains(runStatusAwaitingConfirmation, run.Status) && !spec.AutoApply:
+		// A refresh-only run waits for a confirmation only if it has changes.
+		// Discard it to unblock the workspace run queue.
+		r.setDriftDetectionResult(w, true)
+		w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("discard the drift detection run %s", run.ID))
+		err := w.tfClient.Client.Runs.Discard(ctx, run.ID, tfc.RunDiscardOptions{
+			Comment: tfc.String(driftDetectionDiscardComment),
+		})
+		if err != nil {
+			w.log.Error(err, "Reconcile Drift Detection", "msg", fmt.Sprintf("failed to discard the drift detection run %s", run.ID))
+			return err
+		}
+		status.RunStatus = string(tfc.RunDiscarded)
+	}
+
+	return nil
+}
+
+func (r *WorkspaceReconciler) triggerDriftApplyRun(ctx context.Context, w *workspaceInstance) error {
+	w.log.Info("Reconcile Drift Detection", "msg", "create a new run to apply the detected drift")
+	run, err := w.tfClient.Client.Runs.Create(ctx, tfc.RunCreateOptions{
+		Message:   tfc.String(driftDetectionApplyMessage),
+		Workspace: &tfc.Workspace{ID: w.instance.Status.WorkspaceID},
+		AutoApply: tfc.Bool(true),
+	})
+	if err != nil {
+		w.log.Error(err, "Reconcile Drift Detection", "msg", "failed to create a new run to apply the detected drift")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileDriftDetection", "Failed to create a new run to apply the detected drift in workspace ID %s", w.instance.Status.WorkspaceID)
+		return err
+	}
+	w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("successfully created a new run %s to apply the detected drift", run.ID))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileDriftDetection", "Successfully created a new run %s to apply the detected drift", run.ID)
+	w.instance.Status.DriftDetection.ApplyRunID = run.ID
+
+	return nil
+}
+
+// setDriftDetectionResult records the result of a completed drift detection run in the status and metrics.
+func (r *WorkspaceReconciler) setDriftDetectionResult(w *workspaceInstance, drifted bool) {
+	status := w.instance.Status.DriftDetection
+	status.Drifted = drifted
+	status.LastCheckTime = &metav1.Time{Time: time.Now()}
+
+	m := MetricWorkspaceDrift.WithLabelValues(w.instance.Namespace, w.instance.Name, w.instance.Status.WorkspaceID)
+	if drifted {
+		m.Set(1)
+		w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("drift detection run %s found drift", status.RunID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "DriftDetected", "Drift detection run %s found drift in workspace ID %s", status.RunID, w.instance.Status.WorkspaceID)
+		return
+	}
+	m.Set(0)
+	w.log.Info("Reconcile Drift Detection", "msg", fmt.Sprintf("drift detection run %s found no drift", status.RunID))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "NoDriftDetected", "Drift detection run %s found no drift in workspace ID %s", status.RunID, w.instance.Status.WorkspaceID)
+}
+
+// deleteDriftMetric deletes the drift metric of a given workspace.
+func deleteDriftMetric(instance *appv1alpha2.Workspace) {
+	MetricWorkspaceDrift.DeletePartialMatch(prometheus.Labels{
+		"namespace": instance.Namespace,
+		"name":      instance.Name,
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// dueDriftDetection moves the next drift detection schedule time of a given workspace to the past.
+func dueDriftDetection(t *testing.T, f *fakeAPI, workspace *appv1alpha2.Workspace) {
+	t.Helper()
+
+	require.NotNil(t, workspace.Status.DriftDetection)
+	workspace.Status.DriftDetection.NextScheduleTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	require.NoError(t, f.client.Status().Update(context.TODO(), workspace))
+}
+
+func TestWorkspaceReconcileDriftDetectionRefresh(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("drift-refresh"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "drift-refresh",
+			DriftDetection: &appv1alpha2.DriftDetection{
+				Schedule: "0 * * * *",
+				RunType:  appv1alpha2.DriftDetectionRunTypeRefresh,
+			},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.NotNil(t, workspace.Status.DriftDetection)
+	require.NotNil(t, workspace.Status.DriftDetection.NextScheduleTime)
+	assert.True(t, workspace.Status.DriftDetection.NextScheduleTime.After(time.Now()))
+	assert.Empty(t, workspace.Status.DriftDetection.RunID)
+	assert.Empty(t, f.server.Runs(workspace.Status.WorkspaceID))
+
+	// The schedule is due, a new refresh-only run is triggered.
+	dueDriftDetection(t, f, workspace)
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status := workspace.Status.DriftDetection
+	require.NotEmpty(t, status.RunID)
+	require.NotNil(t, status.LastScheduleTime)
+	assert.True(t, status.NextScheduleTime.After(time.Now()))
+	run := f.server.Run(status.RunID)
+	require.NotNil(t, run)
+	assert.True(t, run.RefreshOnly)
+	assert.False(t, run.AutoApply)
+	assert.Equal(t, driftDetectionRunMessage, run.Message)
+
+	// The run waits for a confirmation, it means drift is detected. The run is discarded.
+	require.NoError(t, f.server.SetRunStatus(run.ID, tfc.RunPlanned))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status = workspace.Status.DriftDetection
+	assert.True(t, status.Drifted)
+	assert.NotNil(t, status.LastCheckTime)
+	assert.Equal(t, string(tfc.RunDiscarded), status.RunStatus)
+	assert.Equal(t, tfc.RunDiscarded, f.server.Run(run.ID).Status)
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricWorkspaceDrift.WithLabelValues(fakeNamespace, workspace.Name, workspace.Status.WorkspaceID)))
+
+	// Drift detection is disabled.
+	workspace.Spec.DriftDetection = nil
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Nil(t, workspace.Status.DriftDetection)
+	assert.False(t, MetricWorkspaceDrift.DeleteLabelValues(fakeNamespace, workspace.Name, workspace.Status.WorkspaceID), "drift metric is not deleted")
+}
+
+func TestWorkspaceReconcileDriftDetectionPlanAutoApply(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("drift-plan"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "drift-plan",
+			DriftDetection: &appv1alpha2.DriftDetection{
+				Schedule:  "@daily",
+				TimeZone:  "Europe/Amsterdam",
+				RunType:   appv1alpha2.DriftDetectionRunTypePlan,
+				AutoApply: true,
+			},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	dueDriftDetection(t, f, workspace)
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	runID := workspace.Status.DriftDetection.RunID
+	run := f.server.Run(runID)
+	require.NotNil(t, run)
+	assert.True(t, run.PlanOnly)
+	assert.False(t, run.RefreshOnly)
+
+	// The speculative plan has changes, a new apply run is triggered.
+	require.NoError(t, f.server.SetRunStatus(runID, tfc.RunPlannedAndFinished))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status := workspace.Status.DriftDetection
+	assert.True(t, status.Drifted)
+	require.NotEmpty(t, status.ApplyRunID)
+	applyRun := f.server.Run(status.ApplyRunID)
+	require.NotNil(t, applyRun)
+	assert.False(t, applyRun.PlanOnly)
+	assert.True(t, applyRun.AutoApply)
+	assert.Equal(t, driftDetectionApplyMessage, applyRun.Message)
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricWorkspaceDrift.WithLabelValues(fakeNamespace, workspace.Name, workspace.Status.WorkspaceID)))
+
+	// The completed drift detection run is not processed again.
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Len(t, f.server.Runs(workspace.Status.WorkspaceID), 2)
+}
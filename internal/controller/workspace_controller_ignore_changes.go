@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// ignoresChanges reports whether the Operator ignores changes of a given spec field, e.g. `tags` or `environmentVariables[NAME]`.
+// The Operator applies the whole spec to a workspace it creates until the first successful reconciliation.
+// Fields of an adopted or observed workspace are ignored from the start.
+func ignoresChanges(instance *appv1alpha2.Workspace, field string) bool {
+	if !slices.Contains(instance.Spec.IgnoreChanges, field) {
+		return false
+	}
+
+	created := instance.Status.Adoption == nil && instance.Spec.ManagementMode != appv1alpha2.ManagementModeObserve
+
+	return !created || instance.Status.ObservedGeneration != 0
+}
+
+// ignoresVariableChanges reports whether the Operator ignores changes of a given variable.
+func ignoresVariableChanges(instance *appv1alpha2.Workspace, field, name string) bool {
+	return ignoresChanges(instance, field) || ignoresChanges(instance, fmt.Sprintf("%s[%s]", field, name))
+}
+
+// withoutIgnoredChanges returns the drifted spec field paths, e.g. `spec.versionControl.branch`, whose changes are not ignored.
+func withoutIgnoredChanges(instance *appv1alpha2.Workspace, drift []string) []string {
+	return slices.DeleteFunc(drift, func(path string) bool {
+		field, _, _ := strings.Cut(strings.TrimPrefix(path, "spec."), ".")
+		return ignoresChanges(instance, field)
+	})
+}
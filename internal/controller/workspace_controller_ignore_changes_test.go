@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspaceReconcileIgnoreChanges(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Description:   "Managed by the Operator",
+			Tags:          []appv1alpha2.Tag{"kubernetes"},
+			EnvironmentVariables: []appv1alpha2.Variable{
+				{
+					Name:  "TF_LOG",
+					Value: "INFO",
+				},
+				{
+					Name:  "AWS_REGION",
+					Value: "eu-central-1",
+				},
+			},
+			IgnoreChanges: []string{"description", "tags", "environmentVariables[AWS_REGION]"},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	// The ignored fields are applied to a new workspace.
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	id := workspace.Status.WorkspaceID
+	ws := f.server.Workspace(id)
+	assert.Equal(t, "Managed by the Operator", ws.Description)
+	assert.Equal(t, []string{"kubernetes"}, ws.TagNames)
+	vars := map[string]*tfc.Variable{}
+	for _, v := range f.server.Variables(id) {
+		vars[v.Key] = v
+	}
+	require.Len(t, vars, 2)
+	assert.Equal(t, "eu-central-1", vars["AWS_REGION"].Value)
+
+	// Changes made outside of the Operator are kept and not reported as a drift.
+	require.NoError(t, f.server.ModifyWorkspace(id, func(ws *tfc.Workspace) {
+		ws.Description = "Changed in the UI"
+		ws.TagNames = []string{"kubernetes", "team-a"}
+	}))
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	for _, v := range vars {
+		_, err := c.Variables.Update(context.TODO(), id, v.ID, tfc.VariableUpdateOptions{Value: tfc.String("changed")})
+		require.NoError(t, err)
+	}
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Nil(t, workspace.Status.SettingsDrift)
+	ws = f.server.Workspace(id)
+	assert.Equal(t, "Changed in the UI", ws.Description)
+	assert.ElementsMatch(t, []string{"kubernetes", "team-a"}, ws.TagNames)
+	vars = map[string]*tfc.Variable{}
+	for _, v := range f.server.Variables(id) {
+		vars[v.Key] = v
+	}
+	assert.Equal(t, "changed", vars["AWS_REGION"].Value)
+	assert.Equal(t, "INFO", vars["TF_LOG"].Value)
+
+	// Changes of the ignored fields in the spec are not applied either.
+	workspace.Spec.Description = "Updated in the spec"
+	workspace.Spec.EnvironmentVariables = workspace.Spec.EnvironmentVariables[:1]
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Equal(t, "Changed in the UI", f.server.Workspace(id).Description)
+	assert.Len(t, f.server.Variables(id), 2)
+}
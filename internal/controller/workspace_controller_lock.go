@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// reconcileLock locks or unlocks the workspace according to `spec.locked`.
+// The Operator only unlocks locks that it holds, they are recorded in `status.lock`.
+func (r *WorkspaceReconciler) reconcileLock(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
+	w.log.Info("Reconcile Lock", "msg", "new reconciliation event")
+
+	lock := w.instance.Status.Lock
+	if !w.instance.Spec.Locked {
+		if lock == nil {
+			return nil
+		}
+		if workspace.Locked {
+			if err := r.unlockWorkspace(ctx, w); err != nil {
+				return err
+			}
+		}
+		return r.patchLockStatus(ctx, w, nil)
+	}
+
+	if workspace.Locked {
+		if lock == nil {
+			w.log.Info("Reconcile Lock", "msg", "workspace is locked by someone else, retry later")
+			return nil
+		}
+		if lock.Reason == w.instance.Spec.LockReason {
+			w.log.Info("Reconcile Lock", "msg", "workspace is locked")
+			return nil
+		}
+		// The lock reason cannot be updated, therefore, the workspace is locked again.
+		w.log.Info("Reconcile Lock", "msg", "lock reason has changed, lock workspace again")
+		if err := r.unlockWorkspace(ctx, w); err != nil {
+			return err
+		}
+		if err := r.patchLockStatus(ctx, w, nil); err != nil {
+			return err
+		}
+	}
+
+	w.log.Info("Reconcile Lock", "msg", "lock workspace")
+	options := tfc.WorkspaceLockOptions{}
+	if w.instance.Spec.LockReason != "" {
+		options.Reason = tfc.String(w.instance.Spec.LockReason)
+	}
+	if _, err := w.tfClient.Client.Workspaces.Lock(ctx, w.instance.Status.WorkspaceID, options); err != nil {
+		if errors.Is(err, tfc.ErrWorkspaceLocked) {
+			w.log.Info("Reconcile Lock", "msg", "workspace is locked by someone else, retry later")
+			return nil
+		}
+		w.log.Error(err, "Reconcile Lock", "msg", "failed to lock workspace")
+		return err
+	}
+	w.log.Info("Reconcile Lock", "msg", "successfully locked workspace")
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileLock", "Successfully locked workspace ID %s", w.instance.Status.WorkspaceID)
+
+	return r.patchLockStatus(ctx, w, &appv1alpha2.WorkspaceLockStatus{
+		Reason:   w.instance.Spec.LockReason,
+		LockedAt: metav1.Time{Time: time.Now()},
+	})
+}
+
+// patchLockStatus sets `status.lock` and persists it right away.
+// The Operator only unlocks locks that it holds, thus, the lock must not be lost if the reconciliation fails afterwards.
+// Other fields of the in-memory status are kept as they are until the status is updated.
+func (r *WorkspaceReconciler) patchLockStatus(ctx context.Context, w *workspaceInstance, lock *appv1alpha2.WorkspaceLockStatus) error {
+	patch := client.MergeFrom(w.instance.DeepCopy())
+	w.instance.Status.Lock = lock
+
+	o := w.instance.DeepCopy()
+	if err := r.Status().Patch(ctx, o, patch); err != nil {
+		w.log.Error(err, "Reconcile Lock", "msg", "failed to update lock status")
+		return err
+	}
+	w.instance.ObjectMeta = o.ObjectMeta
+
+	return nil
+}
+
+// unlockWorkspace releases the lock that the Operator holds.
+// The unlock is forced when the lock is held by another user or team, for example, after the token was changed.
+func (r *WorkspaceReconciler) unlockWorkspace(ctx context.Context, w *workspaceInstance) error {
+	w.log.Info("Reconcile Lock", "msg", "unlock workspace")
+	_, err := w.tfClient.Client.Workspaces.Unlock(ctx, w.instance.Status.WorkspaceID)
+	switch {
+	case err == nil:
+	case errors.Is(err, tfc.ErrWorkspaceNotLocked), errors.Is(err, tfc.ErrWorkspaceLockedByRun):
+		// The lock of the Operator was already released.
+		w.log.Info("Reconcile Lock", "msg", fmt.Sprintf("workspace is not locked by the operator: %s", err))
+		return nil
+	case errors.Is(err, tfc.ErrWorkspaceLockedByUser), errors.Is(err, tfc.ErrWorkspaceLockedByTeam):
+		w.log.Info("Reconcile Lock", "msg", "workspace is locked by another user or team, force unlock workspace")
+		if _, err := w.tfClient.Client.Workspaces.ForceUnlock(ctx, w.instance.Status.WorkspaceID); err != nil && !errors.Is(err, tfc.ErrWorkspaceNotLocked) {
+			w.log.Error(err, "Reconcile Lock", "msg", "failed to force unlock workspace")
+			return err
+		}
+	default:
+		w.log.Error(err, "Reconcile Lock", "msg", "failed to unlock workspace")
+		return err
+	}
+	w.log.Info("Reconcile Lock", "msg", "successfully unlocked workspace")
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileLock", "Successfully unlocked workspace ID %s", w.instance.Status.WorkspaceID)
+
+	return nil
+}
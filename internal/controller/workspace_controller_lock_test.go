@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspaceReconcileLock(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Locked:        true,
+			LockReason:    "Change freeze",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	// The workspace is locked.
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.True(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	require.NotNil(t, workspace.Status.Lock)
+	assert.Equal(t, "Change freeze", workspace.Status.Lock.Reason)
+	lockedAt := workspace.Status.Lock.LockedAt
+
+	// The workspace stays locked.
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Equal(t, lockedAt, workspace.Status.Lock.LockedAt)
+
+	// The lock reason is changed, the workspace is locked again.
+	workspace.Spec.LockReason = "Incident"
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.True(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	assert.Equal(t, "Incident", workspace.Status.Lock.Reason)
+
+	// The workspace is unlocked.
+	workspace.Spec.Locked = false
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.False(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	assert.Nil(t, workspace.Status.Lock)
+
+	// The workspace is locked by a user, the Operator does not take over or release the lock.
+	require.NoError(t, f.server.SetWorkspaceLock(workspace.Status.WorkspaceID, "User alice"))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.True(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	assert.Nil(t, workspace.Status.Lock)
+
+	workspace.Spec.Locked = true
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Nil(t, workspace.Status.Lock)
+
+	// The user releases the lock, the Operator locks the workspace.
+	require.NoError(t, f.server.SetWorkspaceLock(workspace.Status.WorkspaceID, ""))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.NotNil(t, workspace.Status.Lock)
+
+	// The lock of the Operator is held by another team, for example, after the token was changed. The unlock is forced.
+	require.NoError(t, f.server.SetWorkspaceLock(workspace.Status.WorkspaceID, "Team other"))
+	workspace.Spec.Locked = false
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.False(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	assert.Nil(t, workspace.Status.Lock)
+}
+
+func TestWorkspaceReconcileLockWithNewRun(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	// The workspace is locked and a new run is triggered in the same reconciliation.
+	workspace.Spec.Locked = true
+	workspace.Annotations = map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.True(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	assert.Equal(t, metaFalse, workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunNew])
+	require.NotNil(t, workspace.Status.Run)
+	require.NotNil(t, workspace.Status.Lock)
+
+	// The lock of the Operator is kept, thus the workspace is unlocked.
+	workspace.Spec.Locked = false
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.False(t, f.server.Workspace(workspace.Status.WorkspaceID).Locked)
+	assert.Nil(t, workspace.Status.Lock)
+}
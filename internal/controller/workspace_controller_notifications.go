@@ -192,6 +192,11 @@ func (w *workspaceInstance) deleteNotification(ctx context.Context, notification
 func (r *WorkspaceReconciler) reconcileNotifications(ctx context.Context, w *workspaceInstance) error {
 	w.log.Info("Reconcile Notifications", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "notifications") {
+		w.log.Info("Reconcile Notifications", "msg", "changes of notifications are ignored")
+		return nil
+	}
+
 	workspaceNotifications, err := r.getWorkspaceNotifications(ctx, w)
 	if err != nil {
 		w.log.Error(err, "Reconcile Notifications", "msg", "failed to get workspace notifications")
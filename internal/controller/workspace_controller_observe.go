@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// readObservedWorkspace reads the workspace by the ID from the status or, if it is not known yet, by the name from the spec.
+func (r *WorkspaceReconciler) readObservedWorkspace(ctx context.Context, w *workspaceInstance) (*tfc.Workspace, error) {
+	if w.instance.Status.WorkspaceID != "" {
+		return r.readWorkspace(ctx, w)
+	}
+
+	return w.tfClient.Client.Workspaces.Read(ctx, w.instance.Spec.Organization, w.instance.Spec.Name)
+}
+
+// observeWorkspace reads the workspace and reports the differences from the spec without making any changes in HCP Terraform.
+// Runs are not triggered in the observe management mode.
+// A workspace that another Workspace object already manages is not observed, thus it cannot be taken over by switching to the enforce management mode.
+func (r *WorkspaceReconciler) observeWorkspace(ctx context.Context, w *workspaceInstance) error {
+	w.log.Info("Observe Workspace", "msg", "observing workspace")
+
+	// the workspace is kept when the object is deleted in the observe management mode
+	if isDeletionCandidate(&w.instance, workspaceFinalizer) {
+		w.log.Info("Observe Workspace", "msg", "object marked as deleted, the workspace is kept in the observe management mode")
+		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspace", "Object marked as deleted, the workspace is kept in the observe management mode")
+		deleteDriftMetric(&w.instance)
+		return r.removeFinalizer(ctx, w)
+	}
+
+	workspace, err := r.readObservedWorkspace(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Observe Workspace", "msg", "failed to read workspace")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to read workspace")
+		return err
+	}
+
+	owner, err := r.workspaceOwner(ctx, w, workspace.ID)
+	if err != nil {
+		w.log.Error(err, "Observe Workspace", "msg", "failed to list workspace objects")
+		return err
+	}
+	// once the workspace is observed, the oldest object is considered to be the owner, the same as for an adopted workspace
+	if owner != nil && (w.instance.Status.WorkspaceID == "" || owner.CreationTimestamp.Before(&w.instance.CreationTimestamp)) {
+		return fmt.Errorf("workspace ID %s is already managed by %s", workspace.ID, client.ObjectKeyFromObject(owner))
+	}
+	w.instance.Status.WorkspaceID = workspace.ID
+
+	// the default project is needed to compare the project of the workspace when the spec does not set it
+	if w.instance.Status.DefaultProjectID == "" {
+		org, err := w.tfClient.Client.Organizations.Read(ctx, w.instance.Spec.Organization)
+		if err != nil {
+			w.log.Error(err, "Observe Workspace", "msg", "failed to get organization")
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get organization")
+			return err
+		}
+		if org.DefaultProject != nil {
+			w.instance.Status.DefaultProjectID = org.DefaultProject.ID
+		}
+	}
+
+	refs, err := r.resolveWorkspaceRefs(ctx, w)
+	if err != nil {
+		return err
+	}
+	diff := workspaceSettingsDrift(w, workspace, refs)
+	instanceTags := getTags(&w.instance)
+	workspaceTags := getWorkspaceTags(workspace)
+	if !ignoresChanges(&w.instance, "tags") && (len(getTagsToAdd(instanceTags, workspaceTags)) > 0 || len(getTagsToRemove(instanceTags, workspaceTags)) > 0) {
+		diff = append(diff, "spec.tags")
+	}
+
+	if len(diff) > 0 {
+		w.log.Info("Observe Workspace", "msg", fmt.Sprintf("observed differences from the spec in workspace ID %s: %s", workspace.ID, strings.Join(diff, ", ")))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Observed differences from the spec in workspace ID %s: %s", workspace.ID, strings.Join(diff, ", "))
+	}
+
+	w.instance.Status.ObservedGeneration = w.instance.Generation
+	w.instance.Status.Observation = &appv1alpha2.ObservationStatus{
+		ObservedAt: metav1.Now(),
+		Diff:       diff,
+	}
+	setObservedCondition(&w.instance.Status.Conditions, w.instance.Generation, diff)
+
+	return r.Status().Update(ctx, &w.instance)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspaceReconcileObserve(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:     fakeOrganization,
+			ConnectionRef:    connectionRef(),
+			Name:             "this",
+			Description:      "Managed by the Operator",
+			ApplyMethod:      "auto",
+			WorkingDirectory: "infra",
+			Tags:             []appv1alpha2.Tag{"team-a", "team-b"},
+			ManagementMode:   appv1alpha2.ManagementModeObserve,
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+	existing := createExistingWorkspace(t, f, "this")
+
+	// The workspace is found by name and the differences are reported without updating the workspace.
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, existing.ID, workspace.Status.WorkspaceID)
+	require.NotNil(t, workspace.Status.Observation)
+	assert.Equal(t, []string{"spec.description"}, workspace.Status.Observation.Diff)
+	c := meta.FindStatusCondition(workspace.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, appv1alpha2.ConditionReasonObservedDiff, c.Reason)
+	assert.Equal(t, "Created manually", f.server.Workspace(existing.ID).Description)
+	assert.Empty(t, f.server.Runs(existing.ID))
+
+	// The workspace matches the spec once the spec is aligned.
+	workspace.Spec.Description = "Created manually"
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Empty(t, workspace.Status.Observation.Diff)
+	assert.Equal(t, workspace.Generation, workspace.Status.ObservedGeneration)
+
+	// The workspace is kept when the object is deleted.
+	f.delete(t, workspace)
+	f.reconcile(t, r, workspace)
+	assert.False(t, f.exists(t, workspace))
+	assert.NotNil(t, f.server.Workspace(existing.ID))
+}
+
+func TestWorkspaceReconcileObserveManagedWorkspace(t *testing.T) {
+	t.Parallel()
+
+	managed := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("managed"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:   fakeOrganization,
+			ConnectionRef:  connectionRef(),
+			Name:           "this",
+			ManagementMode: appv1alpha2.ManagementModeObserve,
+		},
+	}
+	f := newFakeAPI(t, managed, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, managed)
+	requireSynced(t, managed.Status.Conditions)
+
+	// The workspace is managed by another object, it is not observed and cannot be taken over by switching to the enforce management mode.
+	f.reconcile(t, r, workspace)
+	assert.Empty(t, workspace.Status.WorkspaceID)
+	c := meta.FindStatusCondition(workspace.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Contains(t, c.Message, "already managed by default/managed")
+}
@@ -162,6 +162,12 @@ func (r *WorkspaceReconciler) setOutputs(ctx context.Context, w *workspaceInstan
 	}
 	w.log.Info("Reconcile Outputs", "mgs", fmt.Sprintf("secret create or update result: %s", ur))
 
+	// render output targets
+	if err := setOutputTargets(ctx, r.Client, r.Scheme, &w.instance, w.instance.Spec.OutputTargets, outputs, labels); err != nil {
+		w.log.Error(err, "Reconcile Outputs", "msg", "failed to render output targets")
+		return err
+	}
+
 	return nil
 }
 
@@ -178,7 +184,8 @@ func (r *WorkspaceReconciler) reconcileOutputs(ctx context.Context, w *workspace
 		return nil
 	}
 
-	if w.instance.Status.Run.OutputRunID == w.instance.Status.Run.ID {
+	// output targets of a changed spec are rendered over the outputs of the last applied run
+	if w.instance.Status.Run.OutputRunID == w.instance.Status.Run.ID && w.instance.Generation == w.instance.Status.ObservedGeneration {
 		w.log.Info("Reconcile Outputs", "message", "no need to update outputs")
 		return nil
 	}
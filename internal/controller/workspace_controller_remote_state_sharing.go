@@ -84,6 +84,11 @@ func (r *WorkspaceReconciler) getInstanceRemoteStateSharing(ctx context.Context,
 func (r *WorkspaceReconciler) reconcileRemoteStateSharing(ctx context.Context, w *workspaceInstance) error {
 	w.log.Info("Reconcile Remote State Sharing", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "remoteStateSharing") {
+		w.log.Info("Reconcile Remote State Sharing", "msg", "changes of remote state sharing are ignored")
+		return nil
+	}
+
 	if w.instance.Spec.RemoteStateSharing == nil {
 		return nil
 	}
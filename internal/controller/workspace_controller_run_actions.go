@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// reconcileRunAction confirms, discards, cancels, or force-cancels the current run of the workspace
+// when it is requested via the annotation `workspace.app.terraform.io/run-action`.
+func (r *WorkspaceReconciler) reconcileRunAction(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
+	action, ok := w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunAction]
+	if !ok {
+		return nil
+	}
+	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("run action %s is requested", action))
+
+	if workspace.CurrentRun == nil {
+		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("there are no ongoing non-speculative runs to %s", action))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Run action %s is ignored: there are no ongoing non-speculative runs", action)
+		return r.removeRunActionAnnotations(ctx, w)
+	}
+
+	if id, ok := w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRunID]; ok && id != workspace.CurrentRun.ID {
+		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("run %s is not the current run %s", id, workspace.CurrentRun.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Run action %s is ignored: run %s is not the current run %s", action, id, workspace.CurrentRun.ID)
+		return r.removeRunActionAnnotations(ctx, w)
+	}
+
+	run, err := w.tfClient.Client.Runs.Read(ctx, workspace.CurrentRun.ID)
+	if err != nil {
+		w.log.Error(err, "Reconcile Runs", "msg", fmt.Sprintf("failed to get the run %s", workspace.CurrentRun.ID))
+		return err
+	}
+
+	requestedBy := w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy]
+	comment := runActionComment(action, requestedBy)
+
+	var available bool
+	var do func() error
+	switch action {
+	case appv1alpha2.RunActionConfirm:
+		available = run.Actions != nil && run.Actions.IsConfirmable
+		do = func() error {
+			return w.tfClient.Client.Runs.Apply(ctx, run.ID, tfc.RunApplyOptions{Comment: tfc.String(comment)})
+		}
+	case appv1alpha2.RunActionDiscard:
+		available = run.Actions != nil && run.Actions.IsDiscardable
+		do = func() error {
+			return w.tfClient.Client.Runs.Discard(ctx, run.ID, tfc.RunDiscardOptions{Comment: tfc.String(comment)})
+		}
+	case appv1alpha2.RunActionCancel:
+		available = run.Actions != nil && run.Actions.IsCancelable
+		do = func() error {
+			return w.tfClient.Client.Runs.Cancel(ctx, run.ID, tfc.RunCancelOptions{Comment: tfc.String(comment)})
+		}
+	case appv1alpha2.RunActionForceCancel:
+		available = run.Actions != nil && run.Actions.IsForceCancelable
+		do = func() error {
+			return w.tfClient.Client.Runs.ForceCancel(ctx, run.ID, tfc.RunForceCancelOptions{Comment: tfc.String(comment)})
+		}
+	default:
+		w.log.Error(fmt.Errorf("run action %q is not valid", action), "Reconcile Runs", "msg", "no run action will be taken")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Run action %q is not valid", action)
+		return r.removeRunActionAnnotations(ctx, w)
+	}
+
+	if !available {
+		if run.Actions == nil || (!run.Actions.IsConfirmable && !run.Actions.IsDiscardable && !run.Actions.IsCancelable && !run.Actions.IsForceCancelable) {
+			w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("run %s with status %s does not accept actions anymore", run.ID, run.Status))
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Run action %s is ignored: run %s with status %s does not accept actions anymore", action, run.ID, run.Status)
+			return r.removeRunActionAnnotations(ctx, w)
+		}
+		// The action can become available later, for example, a run can be confirmed once the plan is finished.
+		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("cannot %s run %s with status %s yet", action, run.ID, run.Status))
+		return nil
+	}
+
+	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("%s run %s", action, run.ID))
+	if err := do(); err != nil {
+		w.log.Error(err, "Reconcile Runs", "msg", fmt.Sprintf("failed to %s run %s", action, run.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Failed to %s run %s", action, run.ID)
+		return err
+	}
+	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully took action %s on run %s requested by %q", action, run.ID, requestedBy))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileRuns", "Successfully took action %s on run %s requested by %q", action, run.ID, requestedBy)
+
+	if err := r.removeRunActionAnnotations(ctx, w); err != nil {
+		return err
+	}
+
+	w.instance.Status.RunAction = &appv1alpha2.RunActionStatus{
+		Action:      action,
+		RunID:       run.ID,
+		RequestedBy: requestedBy,
+		Time:        metav1.Time{Time: time.Now()},
+	}
+
+	return nil
+}
+
+// removeRunActionAnnotations removes annotations of a run action once it is handled.
+func (r *WorkspaceReconciler) removeRunActionAnnotations(ctx context.Context, w *workspaceInstance) error {
+	base := w.instance.DeepCopy()
+	delete(w.instance.Annotations, appv1alpha2.WorkspaceAnnotationRunAction)
+	delete(w.instance.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRunID)
+	delete(w.instance.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRequestedBy)
+
+	if err := r.patchMetadata(ctx, w, base); err != nil {
+		w.log.Error(err, "Reconcile Runs", "msg", "failed to update instance")
+		return err
+	}
+
+	return nil
+}
+
+// runActionComment returns the comment of a given run action.
+func runActionComment(action, requestedBy string) string {
+	if requestedBy == "" {
+		return fmt.Sprintf("Run action %s taken by HCP Terraform Operator", action)
+	}
+
+	return fmt.Sprintf("Run action %s requested by %s, taken by HCP Terraform Operator", action, requestedBy)
+}
+
+// runPlanTimeoutStatuses are the run statuses before the plan is finished.
+var runPlanTimeoutStatuses = []tfc.RunStatus{
+	tfc.RunPending,
+	tfc.RunFetching,
+	tfc.RunFetchingCompleted,
+	tfc.RunPrePlanRunning,
+	tfc.RunPrePlanCompleted,
+	tfc.RunQueuing,
+	tfc.RunPlanQueued,
+	tfc.RunPlanning,
+}
+
+// reconcileRunTimeout stops a given run when it is still pending or planning once `spec.planTimeoutSeconds` have passed since its creation.
+// Pending runs are discarded and planning runs are canceled.
+// Runs that are still not stopped after twice the timeout are force-canceled once HCP Terraform allows it.
+func (r *WorkspaceReconciler) reconcileRunTimeout(ctx context.Context, w *workspaceInstance, run *tfc.Run) error {
+	if w.instance.Spec.PlanTimeoutSeconds == nil || run.Actions == nil || !slices.Contains(runPlanTimeoutStatuses, run.Status) {
+		return nil
+	}
+
+	timeout := time.Duration(*w.instance.Spec.PlanTimeoutSeconds) * time.Second
+	elapsed := time.Since(run.CreatedAt)
+	if elapsed < timeout {
+		return nil
+	}
+
+	comment := tfc.String(fmt.Sprintf("Run is still %s after %s, stopped by HCP Terraform Operator", run.Status, timeout))
+	var action string
+	var err error
+	switch {
+	case run.Actions.IsDiscardable:
+		action = appv1alpha2.RunActionDiscard
+		err = w.tfClient.Client.Runs.Discard(ctx, run.ID, tfc.RunDiscardOptions{Comment: comment})
+	case elapsed >= 2*timeout && run.Actions.IsForceCancelable:
+		action = appv1alpha2.RunActionForceCancel
+		err = w.tfClient.Client.Runs.ForceCancel(ctx, run.ID, tfc.RunForceCancelOptions{Comment: comment})
+	case run.Actions.IsCancelable:
+		action = appv1alpha2.RunActionCancel
+		err = w.tfClient.Client.Runs.Cancel(ctx, run.ID, tfc.RunCancelOptions{Comment: comment})
+	default:
+		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("run %s with status %s exceeded the timeout but cannot be stopped", run.ID, run.Status))
+		return nil
+	}
+	if err != nil {
+		w.log.Error(err, "Reconcile Runs", "msg", fmt.Sprintf("failed to %s run %s that exceeded the timeout", action, run.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Failed to %s run %s that exceeded the timeout", action, run.ID)
+		return err
+	}
+	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully took action %s on run %s with status %s that exceeded the timeout %s", action, run.ID, run.Status, timeout))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "Run %s with status %s exceeded the timeout %s, took action %s", run.ID, run.Status, timeout, action)
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+// requestRunAction sets the annotations of a given run action on a given workspace.
+func requestRunAction(t *testing.T, f *fakeAPI, workspace *appv1alpha2.Workspace, annotations map[string]string) {
+	t.Helper()
+
+	if workspace.Annotations == nil {
+		workspace.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		workspace.Annotations[k] = v
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+}
+
+func TestWorkspaceReconcileRunAction(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	// There are no runs to confirm, the run action is ignored.
+	requestRunAction(t, f, workspace, map[string]string{appv1alpha2.WorkspaceAnnotationRunAction: appv1alpha2.RunActionConfirm})
+	f.reconcile(t, r, workspace)
+	assert.NotContains(t, workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunAction)
+	assert.Nil(t, workspace.Status.RunAction)
+
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+	})
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	runID := workspace.Status.Run.ID
+
+	// The plan is not finished yet, the run action waits.
+	require.NoError(t, f.server.SetRunStatus(runID, tfc.RunPlanning))
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunAction:            appv1alpha2.RunActionConfirm,
+		appv1alpha2.WorkspaceAnnotationRunActionRequestedBy: "alice",
+	})
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, appv1alpha2.RunActionConfirm, workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunAction])
+	assert.Equal(t, tfc.RunPlanning, f.server.Run(runID).Status)
+
+	// The plan is finished, the run is confirmed.
+	require.NoError(t, f.server.SetRunStatus(runID, tfc.RunPlanned))
+	f.reconcile(t, r, workspace)
+	assert.NotContains(t, workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunAction)
+	assert.NotContains(t, workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRequestedBy)
+	assert.Equal(t, tfc.RunApplying, f.server.Run(runID).Status)
+	assert.Equal(t, runActionComment(appv1alpha2.RunActionConfirm, "alice"), f.server.RunActionComment(runID))
+	assert.Equal(t, string(tfc.RunApplying), workspace.Status.Run.Status)
+	require.NotNil(t, workspace.Status.RunAction)
+	assert.Equal(t, appv1alpha2.RunActionConfirm, workspace.Status.RunAction.Action)
+	assert.Equal(t, runID, workspace.Status.RunAction.RunID)
+	assert.Equal(t, "alice", workspace.Status.RunAction.RequestedBy)
+
+	// The run ID does not match the current run, the run action is ignored.
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunAction:      appv1alpha2.RunActionCancel,
+		appv1alpha2.WorkspaceAnnotationRunActionRunID: "run-doesnotexist",
+	})
+	f.reconcile(t, r, workspace)
+	assert.NotContains(t, workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunAction)
+	assert.NotContains(t, workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRunID)
+	assert.Equal(t, tfc.RunApplying, f.server.Run(runID).Status)
+
+	// The run is canceled.
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunAction:      appv1alpha2.RunActionCancel,
+		appv1alpha2.WorkspaceAnnotationRunActionRunID: runID,
+	})
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, tfc.RunCanceled, f.server.Run(runID).Status)
+	assert.Equal(t, appv1alpha2.RunActionCancel, workspace.Status.RunAction.Action)
+
+	// The run is completed, the run action is ignored.
+	requestRunAction(t, f, workspace, map[string]string{appv1alpha2.WorkspaceAnnotationRunAction: appv1alpha2.RunActionDiscard})
+	f.reconcile(t, r, workspace)
+	assert.NotContains(t, workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunAction)
+	assert.Equal(t, tfc.RunCanceled, f.server.Run(runID).Status)
+	assert.Equal(t, appv1alpha2.RunActionCancel, workspace.Status.RunAction.Action)
+}
+
+func TestWorkspaceReconcileRunTimeout(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:       fakeOrganization,
+			ConnectionRef:      connectionRef(),
+			Name:               "this",
+			PlanTimeoutSeconds: pointer.PointerOf(int32(600)),
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	// The speculative plan is pending within the timeout, it is kept.
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypePlan,
+	})
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Plan)
+	planID := workspace.Status.Plan.ID
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, tfc.RunPending, f.server.Run(planID).Status)
+
+	// The speculative plan is pending after the timeout, it is discarded.
+	require.NoError(t, f.server.SetRunCreatedAt(planID, time.Now().Add(-11*time.Minute)))
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, tfc.RunDiscarded, f.server.Run(planID).Status)
+
+	// The current run is planning after the timeout, it is canceled.
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+	})
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	runID := workspace.Status.Run.ID
+	require.NoError(t, f.server.SetRunStatus(runID, tfc.RunPlanning))
+	require.NoError(t, f.server.SetRunCreatedAt(runID, time.Now().Add(-11*time.Minute)))
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, tfc.RunCanceled, f.server.Run(runID).Status)
+
+	// The current run is still planning after twice the timeout, it is force-canceled.
+	requestRunAction(t, f, workspace, map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+	})
+	f.reconcile(t, r, workspace)
+	runID = workspace.Status.Run.ID
+	require.NoError(t, f.server.SetRunStatus(runID, tfc.RunPlanning))
+	require.NoError(t, f.server.SetRunCreatedAt(runID, time.Now().Add(-21*time.Minute)))
+	// The run cannot be canceled, therefore, the run is only stopped if it is force-canceled.
+	f.server.FailNext(http.MethodPost, "/api/v2/runs/"+runID+"/actions/cancel", http.StatusConflict)
+	f.reconcile(t, r, workspace)
+	assert.Equal(t, tfc.RunCanceled, f.server.Run(runID).Status)
+	assert.Contains(t, f.server.RunActionComment(runID), "stopped by HCP Terraform Operator")
+}
@@ -180,6 +180,11 @@ func (r *WorkspaceReconciler) getWorkspaceRunTasks(ctx context.Context, w *works
 func (r *WorkspaceReconciler) reconcileRunTasks(ctx context.Context, w *workspaceInstance) error {
 	w.log.Info("Reconcile Run Tasks", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "runTasks") {
+		w.log.Info("Reconcile Run Tasks", "msg", "changes of run tasks are ignored")
+		return nil
+	}
+
 	spec, err := r.getInstanceRunTasks(ctx, w)
 	if err != nil {
 		w.log.Error(err, "Reconcile Run Tasks", "msg", "failed to get instance run tasks")
@@ -119,6 +119,11 @@ func (r *WorkspaceReconciler) removeRunTriggers(ctx context.Context, w *workspac
 func (r *WorkspaceReconciler) reconcileRunTriggers(ctx context.Context, w *workspaceInstance) error {
 	w.log.Info("Reconcile Run Triggers", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "runTriggers") {
+		w.log.Info("Reconcile Run Triggers", "msg", "changes of run triggers are ignored")
+		return nil
+	}
+
 	instanceRunTriggers, err := r.getRunTriggersSources(ctx, w)
 	if err != nil {
 		return nil
@@ -5,45 +5,64 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 
+	"github.com/go-logr/logr"
 	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
 
 func (r *WorkspaceReconciler) reconcileRuns(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
 	w.log.Info("Reconcile Runs", "msg", "new reconciliation event")
 
-	if runNew, ok := w.instance.Annotations[WorkspaceAnnotationRunNew]; ok && runNew == MetaTrue {
+	if runNew, ok := w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunNew]; ok && runNew == MetaTrue {
 		w.log.Info("Reconcile Runs", "msg", "trigger a new run")
-		runType := RunTypeDefault
-		if rt, ok := w.instance.Annotations[WorkspaceAnnotationRunType]; ok {
+		runType := appv1alpha2.RunTypeDefault
+		if rt, ok := w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunType]; ok {
 			runType = rt
 		}
 		options := tfc.RunCreateOptions{
 			Message:   tfc.String(runMessage),
 			Workspace: workspace,
 		}
-
-		switch runType {
-		case RunTypePlan:
-			options.PlanOnly = tfc.Bool(true)
-			if t, ok := w.instance.Annotations[WorkspaceAnnotationRunTerraformVersion]; ok {
-				options.TerraformVersion = tfc.String(t)
-			}
-			return r.triggerPlanRun(ctx, w, options)
-		case RunTypeApply:
-			options.PlanOnly = tfc.Bool(false)
-			return r.triggerApplyRun(ctx, w, options)
-		case RunTypeRefresh:
-			options.RefreshOnly = tfc.Bool(true)
-			return r.triggerApplyRun(ctx, w, options)
-		default:
+		if err := setRunOptionsFromAnnotations(&options, runType, w.instance.Annotations); err != nil {
 			// Throw an error message here but don't return.
-			w.log.Error(fmt.Errorf("run type %q is not valid", runType), "Reconcile Runs", "msg", "no new run will be triggered")
+			w.log.Error(err, "Reconcile Runs", "msg", "no new run will be triggered")
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileRuns", "No new run will be triggered: %s", err.Error())
+		} else {
+			switch runType {
+			case appv1alpha2.RunTypePlan:
+				options.PlanOnly = tfc.Bool(true)
+				// Speculative plans cannot be applied.
+				options.AllowEmptyApply = nil
+				if t, ok := w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunTerraformVersion]; ok {
+					options.TerraformVersion = tfc.String(t)
+				}
+				return r.triggerPlanRun(ctx, w, options)
+			case appv1alpha2.RunTypeApply:
+				options.PlanOnly = tfc.Bool(false)
+				return r.triggerApplyRun(ctx, w, options)
+			case appv1alpha2.RunTypeRefresh:
+				options.RefreshOnly = tfc.Bool(true)
+				return r.triggerApplyRun(ctx, w, options)
+			default:
+				// Throw an error message here but don't return.
+				w.log.Error(fmt.Errorf("run type %q is not valid", runType), "Reconcile Runs", "msg", "no new run will be triggered")
+			}
 		}
 	}
 
+	if err := r.reconcileRunAction(ctx, w, workspace); err != nil {
+		return err
+	}
+
 	if err := r.reconcileCurrentRun(ctx, w, workspace); err != nil {
 		return err
 	}
@@ -55,6 +74,67 @@ func (r *WorkspaceReconciler) reconcileRuns(ctx context.Context, w *workspaceIns
 	return nil
 }
 
+// setRunOptionsFromAnnotations sets the run options that come from given annotations of a workspace for a run of a given type.
+// Refresh runs do not replace resources, so the replace addresses are rejected for them.
+// The admission webhook rejects them too, but it is optional.
+func setRunOptionsFromAnnotations(options *tfc.RunCreateOptions, runType string, annotations map[string]string) error {
+	if v, ok := annotations[appv1alpha2.WorkspaceAnnotationRunTargetAddrs]; ok {
+		options.TargetAddrs = appv1alpha2.RunAddrs(v)
+	}
+	if v, ok := annotations[appv1alpha2.WorkspaceAnnotationRunReplaceAddrs]; ok {
+		if runType == appv1alpha2.RunTypeRefresh {
+			return fmt.Errorf("annotation %s cannot be set when annotation %s is set to %q",
+				appv1alpha2.WorkspaceAnnotationRunReplaceAddrs, appv1alpha2.WorkspaceAnnotationRunType, appv1alpha2.RunTypeRefresh)
+		}
+		options.ReplaceAddrs = appv1alpha2.RunAddrs(v)
+	}
+	if v, ok := annotations[appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply]; ok {
+		allow, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("annotation %s must be a boolean: %w", appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply, err)
+		}
+		options.AllowEmptyApply = tfc.Bool(allow)
+	}
+	if v, ok := annotations[appv1alpha2.WorkspaceAnnotationRunVariables]; ok {
+		variables, err := appv1alpha2.RunVariables(v)
+		if err != nil {
+			return err
+		}
+		options.Variables = variables
+	}
+
+	return nil
+}
+
+// resetRunAnnotations sets the annotation `workspace.app.terraform.io/run-new` to false and removes the run option annotations
+// once a new run was successfully triggered. The run options only apply to the run they were set for.
+func (r *WorkspaceReconciler) resetRunAnnotations(ctx context.Context, w *workspaceInstance) error {
+	base := w.instance.DeepCopy()
+	w.instance.Annotations[appv1alpha2.WorkspaceAnnotationRunNew] = metaFalse
+	for _, a := range []string{
+		appv1alpha2.WorkspaceAnnotationRunTargetAddrs,
+		appv1alpha2.WorkspaceAnnotationRunReplaceAddrs,
+		appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply,
+		appv1alpha2.WorkspaceAnnotationRunVariables,
+	} {
+		delete(w.instance.Annotations, a)
+	}
+
+	return r.patchMetadata(ctx, w, base)
+}
+
+// patchMetadata persists the changes of the object metadata, e.g. annotations, made since a given base object.
+// Unlike Update, it keeps the in-memory status, which is only updated at the end of the reconciliation.
+func (r *WorkspaceReconciler) patchMetadata(ctx context.Context, w *workspaceInstance, base *appv1alpha2.Workspace) error {
+	o := w.instance.DeepCopy()
+	if err := r.Patch(ctx, o, client.MergeFrom(base)); err != nil {
+		return err
+	}
+	w.instance.ObjectMeta = o.ObjectMeta
+
+	return nil
+}
+
 func (r *WorkspaceReconciler) reconcileCurrentRun(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
 	if workspace.CurrentRun == nil {
 		w.log.Info("Reconcile Runs", "msg", "there are no ongoing non-speculative runs")
@@ -76,6 +156,10 @@ func (r *WorkspaceReconciler) reconcileCurrentRun(ctx context.Context, w *worksp
 	}
 	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully got the ongoing non-speculative run status %s", run.Status))
 
+	if err := r.reconcileRunTimeout(ctx, w, run); err != nil {
+		return err
+	}
+
 	if w.instance.Status.Run == nil {
 		w.instance.Status.Run = &appv1alpha2.RunStatus{}
 	}
@@ -84,6 +168,12 @@ func (r *WorkspaceReconciler) reconcileCurrentRun(ctx context.Context, w *worksp
 	w.instance.Status.Run.Status = string(run.Status)
 	w.instance.Status.Run.ConfigurationVersion = run.ConfigurationVersion.ID
 
+	summary, err := readPlanSummary(ctx, w.log, w.tfClient.Client, w.instance.Spec.Organization, w.instance.Spec.Name, run, w.instance.Status.Run.Summary)
+	if err != nil {
+		return err
+	}
+	w.instance.Status.Run.Summary = summary
+
 	return nil
 }
 
@@ -101,13 +191,96 @@ func (r *WorkspaceReconciler) reconcilePlanRun(ctx context.Context, w *workspace
 			return err
 		}
 		w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully got the speculative run status %s", run.Status))
+		if err := r.reconcileRunTimeout(ctx, w, run); err != nil {
+			return err
+		}
 		w.instance.Status.Plan.ID = run.ID
 		w.instance.Status.Plan.Status = string(run.Status)
+
+		summary, err := readPlanSummary(ctx, w.log, w.tfClient.Client, w.instance.Spec.Organization, w.instance.Spec.Name, run, w.instance.Status.Plan.Summary)
+		if err != nil {
+			return err
+		}
+		w.instance.Status.Plan.Summary = summary
 	}
 
 	return nil
 }
 
+// readPlanSummary returns the plan summary of a given run of a workspace with a given name.
+// The plan is not read again once a given summary of the same plan is completed.
+// It is shared by the Workspace and Module controllers.
+func readPlanSummary(ctx context.Context, log logr.Logger, tfClient *tfc.Client, organization, workspace string, run *tfc.Run, summary *appv1alpha2.PlanSummary) (*appv1alpha2.PlanSummary, error) {
+	if run.Plan == nil {
+		return summary, nil
+	}
+
+	if summary != nil && summary.PlanID == run.Plan.ID && summary.PlanCompleted() {
+		return summary, nil
+	}
+
+	log.Info("Reconcile Runs", "msg", fmt.Sprintf("get the plan %s of the run %s", run.Plan.ID, run.ID))
+	plan, err := tfClient.Plans.Read(ctx, run.Plan.ID)
+	if err != nil {
+		log.Error(err, "Reconcile Runs", "msg", fmt.Sprintf("failed to get the plan %s of the run %s", run.Plan.ID, run.ID))
+		return nil, err
+	}
+
+	s := &appv1alpha2.PlanSummary{
+		PlanID:               plan.ID,
+		Status:               string(plan.Status),
+		ResourceAdditions:    plan.ResourceAdditions,
+		ResourceChanges:      plan.ResourceChanges,
+		ResourceDestructions: plan.ResourceDestructions,
+		ResourceImports:      plan.ResourceImports,
+		RunURL:               runURL(tfClient, organization, workspace, run.ID),
+	}
+
+	if plan.Status == tfc.PlanFinished {
+		// The JSON execution plan requires admin access to the workspace.
+		// The plan summary is still valid without the changed outputs, therefore, the error is not returned.
+		output, err := tfClient.Plans.ReadJSONOutput(ctx, plan.ID)
+		if err != nil {
+			log.Info("Reconcile Runs", "msg", fmt.Sprintf("cannot get the JSON execution plan %s, changed outputs are not available: %s", plan.ID, err))
+			return s, nil
+		}
+		s.ChangedOutputs, err = changedOutputs(output)
+		if err != nil {
+			log.Error(err, "Reconcile Runs", "msg", fmt.Sprintf("failed to parse the JSON execution plan %s", plan.ID))
+		}
+	}
+
+	return s, nil
+}
+
+// runURL returns the link to a given run of a workspace with a given name in the HCP Terraform UI.
+func runURL(tfClient *tfc.Client, organization, workspace, runID string) string {
+	u := tfClient.BaseURL()
+	return fmt.Sprintf("%s://%s/app/%s/workspaces/%s/runs/%s", u.Scheme, u.Host, organization, workspace, runID)
+}
+
+// changedOutputs returns the sorted names of the outputs that a given JSON execution plan changes.
+func changedOutputs(output []byte) ([]string, error) {
+	plan := struct {
+		OutputChanges map[string]struct {
+			Actions []string `json:"actions"`
+		} `json:"output_changes"`
+	}{}
+	if err := json.Unmarshal(output, &plan); err != nil {
+		return nil, err
+	}
+
+	var outputs []string
+	for _, n := range slices.Sorted(maps.Keys(plan.OutputChanges)) {
+		if a := plan.OutputChanges[n].Actions; len(a) == 1 && a[0] == "no-op" {
+			continue
+		}
+		outputs = append(outputs, n)
+	}
+
+	return outputs, nil
+}
+
 func (r *WorkspaceReconciler) triggerApplyRun(ctx context.Context, w *workspaceInstance, options tfc.RunCreateOptions) error {
 	w.log.Info("Reconcile Runs", "msg", "create a new apply run")
 
@@ -118,10 +291,7 @@ func (r *WorkspaceReconciler) triggerApplyRun(ctx context.Context, w *workspaceI
 	}
 	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully created a new apply run %s", run.ID))
 
-	// Set annotation `workspace.app.terraform.io/run-new` to false if a new run was successfully triggered.
-	w.instance.Annotations[WorkspaceAnnotationRunNew] = metaFalse
-	err = r.Update(ctx, &w.instance)
-	if err != nil {
+	if err := r.resetRunAnnotations(ctx, w); err != nil {
 		w.log.Error(err, "Reconcile Runs", "msg", "failed to update instance")
 		return err
 	}
@@ -133,6 +303,7 @@ func (r *WorkspaceReconciler) triggerApplyRun(ctx context.Context, w *workspaceI
 	w.instance.Status.Run.ID = run.ID
 	w.instance.Status.Run.Status = string(run.Status)
 	w.instance.Status.Run.ConfigurationVersion = run.ConfigurationVersion.ID
+	w.instance.Status.Run.Summary = nil
 
 	return nil
 }
@@ -146,10 +317,7 @@ func (r *WorkspaceReconciler) triggerPlanRun(ctx context.Context, w *workspaceIn
 	}
 	w.log.Info("Reconcile Runs", "msg", fmt.Sprintf("successfully created a new plan run %s", run.ID))
 
-	// Set annotation `workspace.app.terraform.io/run-new` to false if a new run was successfully triggered.
-	w.instance.Annotations[WorkspaceAnnotationRunNew] = metaFalse
-	err = r.Update(ctx, &w.instance)
-	if err != nil {
+	if err := r.resetRunAnnotations(ctx, w); err != nil {
 		w.log.Error(err, "Reconcile Runs", "msg", "failed to update instance")
 		return err
 	}
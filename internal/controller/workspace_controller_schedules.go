@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func (r *WorkspaceReconciler) reconcileSchedules(ctx context.Context, w *workspaceInstance) error {
+	w.log.Info("Reconcile Schedules", "msg", "new reconciliation event")
+
+	// Remove the status of schedules that are not in the spec anymore.
+	w.instance.Status.Schedules = slices.DeleteFunc(w.instance.Status.Schedules, func(s appv1alpha2.WorkspaceScheduleStatus) bool {
+		return !slices.ContainsFunc(w.instance.Spec.Schedules, func(ss appv1alpha2.WorkspaceSchedule) bool {
+			return ss.Name == s.Name
+		})
+	})
+
+	now := time.Now()
+	for _, s := range w.instance.Spec.Schedules {
+		status := w.instance.Status.GetScheduleStatus(s.Name)
+
+		if status.NextScheduleTime != nil && !now.Before(status.NextScheduleTime.Time) {
+			if s.IsSuspended(now) {
+				w.log.Info("Reconcile Schedules", "msg", fmt.Sprintf("schedule %s is suspended, skip the run", s.Name))
+				r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileSchedules", "Schedule %s is suspended, skip the run", s.Name)
+				status.LastSuspendedTime = &metav1.Time{Time: now}
+			} else {
+				run, err := r.triggerScheduledRun(ctx, w, s)
+				if err != nil {
+					return err
+				}
+				// The status pointer is stale if the status of a new schedule was appended while the run was triggered.
+				status = w.instance.Status.GetScheduleStatus(s.Name)
+				status.RunID = run.ID
+				status.LastScheduleTime = &metav1.Time{Time: now}
+			}
+		}
+
+		// The next schedule time is computed again when the spec changes, since the schedule could be updated.
+		if status.NextScheduleTime == nil || !now.Before(status.NextScheduleTime.Time) || w.instance.Generation != w.instance.Status.ObservedGeneration {
+			next, err := s.NextScheduleTime(now)
+			if err != nil {
+				w.log.Error(err, "Reconcile Schedules", "msg", fmt.Sprintf("failed to compute the next schedule time of schedule %s", s.Name))
+				return err
+			}
+			status.NextScheduleTime = &metav1.Time{Time: next}
+			w.log.Info("Reconcile Schedules", "msg", fmt.Sprintf("the next run of schedule %s is scheduled at %s", s.Name, next.Format(time.RFC3339)))
+		}
+	}
+
+	return nil
+}
+
+func (r *WorkspaceReconciler) triggerScheduledRun(ctx context.Context, w *workspaceInstance, s appv1alpha2.WorkspaceSchedule) (*tfc.Run, error) {
+	options := tfc.RunCreateOptions{
+		Message:   tfc.String(fmt.Sprintf("%s: %s", scheduledRunMessage, s.Name)),
+		Workspace: &tfc.Workspace{ID: w.instance.Status.WorkspaceID},
+	}
+	switch s.RunType {
+	case appv1alpha2.WorkspaceScheduleRunTypeApply:
+		options.AutoApply = s.AutoApply
+	case appv1alpha2.WorkspaceScheduleRunTypeDestroy:
+		options.IsDestroy = tfc.Bool(true)
+		options.AutoApply = s.AutoApply
+	default:
+		options.PlanOnly = tfc.Bool(true)
+	}
+
+	w.log.Info("Reconcile Schedules", "msg", fmt.Sprintf("create a new %s run of schedule %s", s.RunType, s.Name))
+	run, err := w.tfClient.Client.Runs.Create(ctx, options)
+	if err != nil {
+		w.log.Error(err, "Reconcile Schedules", "msg", fmt.Sprintf("failed to create a new run of schedule %s", s.Name))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileSchedules", "Failed to create a new run of schedule %s", s.Name)
+		return nil, err
+	}
+	w.log.Info("Reconcile Schedules", "msg", fmt.Sprintf("successfully created a new run %s of schedule %s", run.ID, s.Name))
+	r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileSchedules", "Successfully created a new run %s of schedule %s", run.ID, s.Name)
+
+	// Non-speculative runs become the current run of the workspace.
+	if !run.PlanOnly {
+		if w.instance.Status.Run == nil {
+			w.instance.Status.Run = &appv1alpha2.RunStatus{}
+		}
+		w.instance.Status.Run.ID = run.ID
+		w.instance.Status.Run.Status = string(run.Status)
+		w.instance.Status.Run.ConfigurationVersion = run.ConfigurationVersion.ID
+		w.instance.Status.Run.Summary = nil
+	}
+
+	return run, nil
+}
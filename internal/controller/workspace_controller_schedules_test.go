@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// dueSchedule moves the next schedule time of a given schedule to the past.
+func dueSchedule(t *testing.T, f *fakeAPI, workspace *appv1alpha2.Workspace, name string) {
+	t.Helper()
+
+	status := workspace.Status.GetScheduleStatus(name)
+	require.NotNil(t, status.NextScheduleTime)
+	status.NextScheduleTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	require.NoError(t, f.client.Status().Update(context.TODO(), workspace))
+}
+
+func TestWorkspaceReconcileSchedules(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("schedules"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:     fakeOrganization,
+			ConnectionRef:    connectionRef(),
+			Name:             "schedules",
+			AllowDestroyPlan: true,
+			Schedules: []appv1alpha2.WorkspaceSchedule{
+				{
+					Name:     "nightly-plan",
+					Schedule: "0 2 * * *",
+					RunType:  appv1alpha2.WorkspaceScheduleRunTypePlan,
+				},
+				{
+					Name:      "nightly-apply",
+					Schedule:  "0 3 * * *",
+					TimeZone:  "Europe/Amsterdam",
+					RunType:   appv1alpha2.WorkspaceScheduleRunTypeApply,
+					AutoApply: tfc.Bool(true),
+				},
+				{
+					Name:     "weekend-destroy",
+					Schedule: "0 20 * * FRI",
+					RunType:  appv1alpha2.WorkspaceScheduleRunTypeDestroy,
+				},
+			},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.Len(t, workspace.Status.Schedules, 3)
+	for _, s := range workspace.Status.Schedules {
+		require.NotNil(t, s.NextScheduleTime, s.Name)
+		assert.True(t, s.NextScheduleTime.After(time.Now()), s.Name)
+		assert.Empty(t, s.RunID, s.Name)
+	}
+	assert.Empty(t, f.server.Runs(workspace.Status.WorkspaceID))
+
+	// The plan schedule is due, a new speculative plan is triggered.
+	dueSchedule(t, f, workspace, "nightly-plan")
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status := workspace.Status.GetScheduleStatus("nightly-plan")
+	require.NotEmpty(t, status.RunID)
+	require.NotNil(t, status.LastScheduleTime)
+	assert.True(t, status.NextScheduleTime.After(time.Now()))
+	run := f.server.Run(status.RunID)
+	require.NotNil(t, run)
+	assert.True(t, run.PlanOnly)
+	assert.Equal(t, scheduledRunMessage+": nightly-plan", run.Message)
+
+	// The apply schedule is due, a new auto-applied run is triggered and becomes the current run.
+	dueSchedule(t, f, workspace, "nightly-apply")
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status = workspace.Status.GetScheduleStatus("nightly-apply")
+	run = f.server.Run(status.RunID)
+	require.NotNil(t, run)
+	assert.False(t, run.PlanOnly)
+	assert.False(t, run.IsDestroy)
+	assert.True(t, run.AutoApply)
+	require.NotNil(t, workspace.Status.Run)
+	assert.Equal(t, run.ID, workspace.Status.Run.ID)
+
+	// The destroy schedule is due, a new destroy run is triggered.
+	require.NoError(t, f.server.SetRunStatus(run.ID, tfc.RunApplied))
+	dueSchedule(t, f, workspace, "weekend-destroy")
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status = workspace.Status.GetScheduleStatus("weekend-destroy")
+	run = f.server.Run(status.RunID)
+	require.NotNil(t, run)
+	assert.True(t, run.IsDestroy)
+	assert.False(t, run.AutoApply)
+
+	// The schedule is removed, its status is removed too.
+	workspace.Spec.Schedules = workspace.Spec.Schedules[:1]
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.Len(t, workspace.Status.Schedules, 1)
+	assert.Equal(t, "nightly-plan", workspace.Status.Schedules[0].Name)
+}
+
+func TestWorkspaceReconcileSchedulesSuspended(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("schedules-suspended"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "schedules-suspended",
+			Schedules: []appv1alpha2.WorkspaceSchedule{
+				{
+					Name:     "hourly",
+					Schedule: "@hourly",
+					RunType:  appv1alpha2.WorkspaceScheduleRunTypePlan,
+					SuspensionWindows: []appv1alpha2.ScheduleSuspensionWindow{
+						{
+							Start: metav1.Time{Time: now.Add(-time.Hour)},
+							End:   metav1.Time{Time: now.Add(time.Hour)},
+						},
+					},
+				},
+			},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	// The schedule is due within the suspension window, no run is triggered.
+	dueSchedule(t, f, workspace, "hourly")
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	status := workspace.Status.GetScheduleStatus("hourly")
+	assert.Empty(t, status.RunID)
+	assert.Nil(t, status.LastScheduleTime)
+	assert.NotNil(t, status.LastSuspendedTime)
+	assert.True(t, status.NextScheduleTime.After(time.Now()))
+	assert.Empty(t, f.server.Runs(workspace.Status.WorkspaceID))
+}
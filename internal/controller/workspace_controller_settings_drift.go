@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// workspaceRefs contains the IDs of the agent pool and the project that the spec refers to.
+type workspaceRefs struct {
+	agentPoolID string
+	projectID   string
+}
+
+// resolveWorkspaceRefs resolves the IDs of the agent pool and the project that the spec refers to.
+// They are resolved once per reconciliation and shared by the drift comparison and the workspace update.
+func (r *WorkspaceReconciler) resolveWorkspaceRefs(ctx context.Context, w *workspaceInstance) (workspaceRefs, error) {
+	var refs workspaceRefs
+	var err error
+
+	if w.instance.Spec.ExecutionMode == "agent" {
+		refs.agentPoolID, err = r.getAgentPoolID(ctx, w)
+		if err != nil {
+			w.log.Error(err, "Reconcile Workspace", "msg", "failed to get agent pool ID")
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get agent pool ID")
+			return refs, err
+		}
+	}
+
+	if w.instance.Spec.Project != nil {
+		refs.projectID, err = w.getProjectID(ctx)
+		if err != nil {
+			w.log.Error(err, "Reconcile Workspace", "msg", "failed to get project ID")
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspace", "Failed to get project ID")
+			return refs, err
+		}
+	}
+
+	return refs, nil
+}
+
+// workspaceSettingsDrift returns the paths of the spec fields that do not match the settings of a given workspace.
+// Settings that the spec does not manage are not compared, and changes of the ignored fields are not reported.
+func workspaceSettingsDrift(w *workspaceInstance, workspace *tfc.Workspace, refs workspaceRefs) []string {
+	spec := w.instance.Spec
+	status := w.instance.Status
+
+	var drift []string
+	diff := func(path string, changed bool) {
+		if changed {
+			drift = append(drift, path)
+		}
+	}
+
+	diff("spec.name", workspace.Name != spec.Name)
+	diff("spec.description", workspace.Description != spec.Description)
+	diff("spec.applyMethod", workspace.AutoApply != ApplyMethodToBool(spec.ApplyMethod))
+	diff("spec.applyRunTrigger", workspace.AutoApplyRunTrigger != ApplyRunTriggerToBool(spec.ApplyRunTrigger))
+	diff("spec.allowDestroyPlan", workspace.AllowDestroyPlan != spec.AllowDestroyPlan)
+	diff("spec.executionMode", spec.ExecutionMode != "" && workspace.ExecutionMode != spec.ExecutionMode)
+	diff("spec.workingDirectory", workspace.WorkingDirectory != spec.WorkingDirectory)
+
+	if spec.ExecutionMode == "agent" {
+		diff("spec.agentPool", workspace.AgentPool == nil || workspace.AgentPool.ID != refs.agentPoolID)
+	}
+
+	if spec.TerraformVersion == "" {
+		diff("spec.terraformVersion", status.TerraformVersion != "" && workspace.TerraformVersion != status.TerraformVersion)
+	} else {
+		diff("spec.terraformVersion", workspace.TerraformVersion != spec.TerraformVersion)
+	}
+
+	globalRemoteState := spec.RemoteStateSharing != nil && spec.RemoteStateSharing.AllWorkspaces
+	diff("spec.remoteStateSharing.allWorkspaces", workspace.GlobalRemoteState != globalRemoteState)
+
+	if vcs := spec.VersionControl; vcs == nil || workspace.VCSRepo == nil {
+		diff("spec.versionControl", (vcs == nil) != (workspace.VCSRepo == nil))
+	} else {
+		diff("spec.versionControl.oAuthTokenID", workspace.VCSRepo.OAuthTokenID != vcs.OAuthTokenID)
+		diff("spec.versionControl.repository", workspace.VCSRepo.Identifier != vcs.Repository)
+		diff("spec.versionControl.branch", workspace.VCSRepo.Branch != vcs.Branch)
+		diff("spec.versionControl.speculativePlans", workspace.SpeculativeEnabled != vcs.SpeculativePlans)
+		diff("spec.versionControl.enableFileTriggers", workspace.FileTriggersEnabled != vcs.EnableFileTriggers)
+		diff("spec.versionControl.triggerPatterns", vcsTriggersChanged(getWorkspaceTriggerPatterns(workspace), getTriggerPatterns(&w.instance)))
+		diff("spec.versionControl.triggerPrefixes", vcsTriggersChanged(getWorkspaceTriggerPrefixes(workspace), getTriggerPrefixes(&w.instance)))
+	}
+
+	projectID := status.DefaultProjectID
+	if spec.Project != nil {
+		projectID = refs.projectID
+	}
+	if projectID != "" {
+		diff("spec.project", workspace.Project == nil || workspace.Project.ID != projectID)
+	}
+
+	if spec.SSHKey == nil {
+		diff("spec.sshKey", workspace.SSHKey != nil)
+	} else if status.SSHKeyID != "" {
+		diff("spec.sshKey", workspace.SSHKey == nil || workspace.SSHKey.ID != status.SSHKeyID)
+	}
+
+	return withoutIgnoredChanges(&w.instance, drift)
+}
+
+// vcsTriggersChanged reports whether the observed VCS triggers differ from the desired ones in any direction.
+func vcsTriggersChanged(observed, desired map[string]struct{}) bool {
+	return len(vcsTriggersDifference(observed, desired)) != 0 || len(vcsTriggersDifference(desired, observed)) != 0
+}
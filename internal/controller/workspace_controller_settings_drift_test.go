@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspaceReconcileSettingsDrift(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Description:   "Managed by the Operator",
+			ExecutionMode: "remote",
+			VersionControl: &appv1alpha2.VersionControl{
+				OAuthTokenID:       "ot-123",
+				Repository:         "hashicorp/this",
+				Branch:             "main",
+				SpeculativePlans:   true,
+				EnableFileTriggers: true,
+				TriggerPatterns:    []string{"/modules/**/*"},
+			},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	// The spec is applied, this is not a drift.
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Nil(t, workspace.Status.SettingsDrift)
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Nil(t, workspace.Status.SettingsDrift)
+
+	// The settings are changed outside of the Operator without updating the workspace timestamp.
+	id := workspace.Status.WorkspaceID
+	require.NoError(t, f.server.ModifyWorkspace(id, func(ws *tfc.Workspace) {
+		ws.Description = "Changed in the UI"
+		ws.SpeculativeEnabled = false
+		ws.TriggerPatterns = nil
+	}))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.NotNil(t, workspace.Status.SettingsDrift)
+	assert.Equal(t, []string{
+		"spec.description",
+		"spec.versionControl.speculativePlans",
+		"spec.versionControl.triggerPatterns",
+	}, workspace.Status.SettingsDrift.Fields)
+	assert.False(t, workspace.Status.SettingsDrift.DetectedAt.IsZero())
+
+	// The drift is corrected.
+	ws := f.server.Workspace(id)
+	assert.Equal(t, "Managed by the Operator", ws.Description)
+	assert.True(t, ws.SpeculativeEnabled)
+	assert.Equal(t, []string{"/modules/**/*"}, ws.TriggerPatterns)
+
+	// The settings match the spec, the drift is cleared.
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Nil(t, workspace.Status.SettingsDrift)
+}
@@ -51,6 +51,11 @@ func (w *workspaceInstance) getSSHKeyID(ctx context.Context) (string, error) {
 func (w *workspaceInstance) reconcileSSHKey(ctx context.Context, workspace *tfc.Workspace) error {
 	w.log.Info("Reconcile SSH Key", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "sshKey") {
+		w.log.Info("Reconcile SSH Key", "msg", "changes of the SSH key are ignored")
+		return nil
+	}
+
 	if w.instance.Spec.SSHKey == nil && workspace.SSHKey != nil {
 		w.log.Info("Reconcile SSH Key", "msg", "removing the SSH key")
 		if _, err := w.tfClient.Client.Workspaces.UnassignSSHKey(ctx, workspace.ID); err != nil {
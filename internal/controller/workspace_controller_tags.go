@@ -89,6 +89,11 @@ func (r *WorkspaceReconciler) removeWorkspaceTags(ctx context.Context, w *worksp
 func (r *WorkspaceReconciler) reconcileTags(ctx context.Context, w *workspaceInstance, workspace *tfc.Workspace) error {
 	w.log.Info("Reconcile Tags", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "tags") {
+		w.log.Info("Reconcile Tags", "msg", "changes of tags are ignored")
+		return nil
+	}
+
 	instanceTags := getTags(&w.instance)
 	workspaceTags := getWorkspaceTags(workspace)
 
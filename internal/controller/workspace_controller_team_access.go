@@ -14,7 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-func getTeamID(teams map[string]*tfc.Team, instanceTeam appv1alpha2.Team) (string, error) {
+func getTeamID(teams map[string]*tfc.Team, instanceTeam appv1alpha2.TeamRef) (string, error) {
 	if instanceTeam.Name != "" {
 		if t, ok := teams[instanceTeam.Name]; ok {
 			return t.ID, nil
@@ -249,6 +249,11 @@ func (r *WorkspaceReconciler) updateTeamAccess(ctx context.Context, w *workspace
 func (r *WorkspaceReconciler) reconcileTeamAccess(ctx context.Context, w *workspaceInstance) error {
 	w.log.Info("Reconcile Team Access", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "teamAccess") {
+		w.log.Info("Reconcile Team Access", "msg", "changes of team access are ignored")
+		return nil
+	}
+
 	specTeamAccess, err := r.getInstanceTeamAccess(ctx, w)
 	if err != nil {
 		w.log.Error(err, "Reconcile Team Access", "msg", "failed to get instance team access")
@@ -0,0 +1,468 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspaceReconcile(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			Description:   "Workspace managed by the fake API test",
+			Tags:          []appv1alpha2.Tag{"kubernetes", "fake"},
+			TerraformVariables: []appv1alpha2.Variable{
+				{
+					Name:  "plain",
+					Value: "value",
+				},
+				{
+					Name:      "secret",
+					Sensitive: true,
+					ValueFrom: &appv1alpha2.ValueFrom{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "variables"},
+							Key:                  "secret",
+						},
+					},
+				},
+			},
+			EnvironmentVariables: []appv1alpha2.Variable{
+				{
+					Name:  "TF_LOG",
+					Value: "INFO",
+				},
+			},
+			TeamAccess: []*appv1alpha2.TeamAccess{
+				{
+					Team:   appv1alpha2.TeamRef{Name: "team"},
+					Access: "read",
+				},
+			},
+			Notifications: []appv1alpha2.Notification{
+				{
+					Name:     "webhook",
+					Type:     tfc.NotificationDestinationTypeSlack,
+					Enabled:  true,
+					URL:      "https://hooks.slack.com/services/fake",
+					Triggers: []appv1alpha2.NotificationTrigger{"run:completed"},
+				},
+			},
+			DeletionPolicy: appv1alpha2.DeletionPolicySoft,
+		},
+	}
+	variables := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "variables", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"secret": []byte("s3cr3t")},
+	}
+	f := newFakeAPI(t, workspace, variables)
+	team := f.server.AddTeam(fakeOrganization, "team")
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Contains(t, workspace.Finalizers, workspaceFinalizer)
+	ws := f.server.Workspace(workspace.Status.WorkspaceID)
+	require.NotNil(t, ws)
+	assert.Equal(t, "this", ws.Name)
+	assert.Equal(t, workspace.Spec.Description, ws.Description)
+	assert.ElementsMatch(t, []string{"kubernetes", "fake"}, ws.TagNames)
+
+	vars := map[string]*tfc.Variable{}
+	for _, v := range f.server.Variables(ws.ID) {
+		vars[v.Key] = v
+	}
+	require.Len(t, vars, 3)
+	assert.Equal(t, "value", vars["plain"].Value)
+	assert.Equal(t, tfc.CategoryTerraform, vars["plain"].Category)
+	assert.Equal(t, "s3cr3t", vars["secret"].Value)
+	assert.True(t, vars["secret"].Sensitive)
+	assert.Equal(t, tfc.CategoryEnv, vars["TF_LOG"].Category)
+
+	access := f.server.TeamAccess(ws.ID)
+	require.Len(t, access, 1)
+	assert.Equal(t, team.ID, access[0].Team.ID)
+	assert.Equal(t, tfc.AccessRead, access[0].Access)
+
+	notifications := f.server.Notifications(ws.ID)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "webhook", notifications[0].Name)
+	assert.Equal(t, "https://hooks.slack.com/services/fake", notifications[0].URL)
+
+	workspace.Spec.Description = "Updated by the fake API test"
+	workspace.Spec.Tags = []appv1alpha2.Tag{"kubernetes"}
+	workspace.Spec.EnvironmentVariables = nil
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	ws = f.server.Workspace(ws.ID)
+	assert.Equal(t, workspace.Spec.Description, ws.Description)
+	assert.Equal(t, []string{"kubernetes"}, ws.TagNames)
+	assert.Len(t, f.server.Variables(ws.ID), 2)
+
+	f.delete(t, workspace)
+	f.reconcile(t, r, workspace)
+	assert.False(t, f.exists(t, workspace))
+	assert.Nil(t, f.server.Workspace(ws.ID))
+	assert.Empty(t, f.server.Variables(ws.ID))
+}
+
+func TestWorkspaceReconcileRunOptions(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	workspace.Annotations = map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:             MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType:            appv1alpha2.RunTypeApply,
+		appv1alpha2.WorkspaceAnnotationRunTargetAddrs:     "random_pet.this, random_pet.that",
+		appv1alpha2.WorkspaceAnnotationRunReplaceAddrs:    "random_pet.this",
+		appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply: MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunVariables:       `{"prefix": "\"dev\"", "length": "3"}`,
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	run := f.server.Run(workspace.Status.Run.ID)
+	require.NotNil(t, run)
+	assert.Equal(t, []string{"random_pet.this", "random_pet.that"}, run.TargetAddrs)
+	assert.Equal(t, []string{"random_pet.this"}, run.ReplaceAddrs)
+	assert.True(t, run.AllowEmptyApply)
+	assert.Equal(t, []*tfc.RunVariableAttr{
+		{Key: "length", Value: "3"},
+		{Key: "prefix", Value: `"dev"`},
+	}, run.Variables)
+
+	// The run options only apply to the run they were set for.
+	assert.Equal(t, metaFalse, workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunNew])
+	for _, a := range []string{
+		appv1alpha2.WorkspaceAnnotationRunTargetAddrs,
+		appv1alpha2.WorkspaceAnnotationRunReplaceAddrs,
+		appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply,
+		appv1alpha2.WorkspaceAnnotationRunVariables,
+	} {
+		assert.NotContains(t, workspace.Annotations, a)
+	}
+
+	workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunNew] = MetaTrue
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	require.NotEqual(t, run.ID, workspace.Status.Run.ID)
+	run = f.server.Run(workspace.Status.Run.ID)
+	require.NotNil(t, run)
+	assert.Empty(t, run.TargetAddrs)
+	assert.Empty(t, run.ReplaceAddrs)
+	assert.False(t, run.AllowEmptyApply)
+	assert.Empty(t, run.Variables)
+}
+
+func TestWorkspaceReconcileRefreshRunReplaceAddrs(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	// refresh runs cannot replace resources, thus no run is triggered even if the webhook does not reject the annotations
+	workspace.Annotations = map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:          MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType:         appv1alpha2.RunTypeRefresh,
+		appv1alpha2.WorkspaceAnnotationRunReplaceAddrs: "random_pet.this",
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	assert.Nil(t, workspace.Status.Run)
+	assert.Equal(t, MetaTrue, workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunNew])
+
+	delete(workspace.Annotations, appv1alpha2.WorkspaceAnnotationRunReplaceAddrs)
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	run := f.server.Run(workspace.Status.Run.ID)
+	require.NotNil(t, run)
+	assert.True(t, run.RefreshOnly)
+	assert.Empty(t, run.ReplaceAddrs)
+}
+
+func TestWorkspaceReconcilePlanSummary(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	// Speculative plan with the JSON execution plan.
+	workspace.Annotations = map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypePlan,
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Plan)
+	planRunID := workspace.Status.Plan.ID
+
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Plan.Summary)
+	assert.Equal(t, string(tfc.PlanPending), workspace.Status.Plan.Summary.Status)
+
+	require.NoError(t, f.server.SetPlan(planRunID, &tfc.Plan{
+		Status:               tfc.PlanFinished,
+		ResourceAdditions:    1,
+		ResourceChanges:      2,
+		ResourceDestructions: 3,
+		ResourceImports:      4,
+	}))
+	require.NoError(t, f.server.SetPlanJSONOutput(planRunID, []byte(`{
+		"output_changes": {
+			"name": {"actions": ["update"]},
+			"id": {"actions": ["no-op"]},
+			"arn": {"actions": ["create"]}
+		}
+	}`)))
+	require.NoError(t, f.server.SetRunStatus(planRunID, tfc.RunPlannedAndFinished))
+	f.reconcile(t, r, workspace)
+	summary := workspace.Status.Plan.Summary
+	require.NotNil(t, summary)
+	assert.Equal(t, f.server.Run(planRunID).Plan.ID, summary.PlanID)
+	assert.Equal(t, string(tfc.PlanFinished), summary.Status)
+	assert.Equal(t, 1, summary.ResourceAdditions)
+	assert.Equal(t, 2, summary.ResourceChanges)
+	assert.Equal(t, 3, summary.ResourceDestructions)
+	assert.Equal(t, 4, summary.ResourceImports)
+	assert.Equal(t, []string{"arn", "name"}, summary.ChangedOutputs)
+	assert.Equal(t, fmt.Sprintf("%s/app/%s/workspaces/%s/runs/%s", f.server.URL(), fakeOrganization, workspace.Spec.Name, planRunID), summary.RunURL)
+
+	// Current run without the JSON execution plan.
+	workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunNew] = MetaTrue
+	workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunType] = appv1alpha2.RunTypeApply
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	assert.Nil(t, workspace.Status.Run.Summary)
+	runID := workspace.Status.Run.ID
+
+	require.NoError(t, f.server.SetPlan(runID, &tfc.Plan{Status: tfc.PlanFinished, ResourceAdditions: 5}))
+	require.NoError(t, f.server.SetRunStatus(runID, tfc.RunPlanned))
+	f.reconcile(t, r, workspace)
+	summary = workspace.Status.Run.Summary
+	require.NotNil(t, summary)
+	assert.Equal(t, string(tfc.PlanFinished), summary.Status)
+	assert.Equal(t, 5, summary.ResourceAdditions)
+	assert.Empty(t, summary.ChangedOutputs)
+	assert.Contains(t, summary.RunURL, runID)
+}
+
+func TestWorkspaceReconcileRunOutputs(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	workspace.Annotations = map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+	run := f.server.Run(workspace.Status.Run.ID)
+	require.NotNil(t, run)
+	assert.False(t, run.PlanOnly)
+	assert.Equal(t, metaFalse, workspace.Annotations[appv1alpha2.WorkspaceAnnotationRunNew])
+
+	require.NoError(t, f.server.SetRunStatus(run.ID, tfc.RunApplied))
+	require.NoError(t, f.server.SetOutputs(workspace.Status.WorkspaceID, []*tfc.StateVersionOutput{
+		{Name: "plain", Value: "value"},
+		{Name: "secret", Value: "s3cr3t", Sensitive: true},
+	}))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	assert.Equal(t, string(tfc.RunApplied), workspace.Status.Run.Status)
+
+	key := types.NamespacedName{Namespace: fakeNamespace, Name: OutputObjectName(workspace.Name)}
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, f.client.Get(context.TODO(), key, cm))
+	assert.Equal(t, "value", cm.Data["plain"])
+	secret := &corev1.Secret{}
+	require.NoError(t, f.client.Get(context.TODO(), key, secret))
+	assert.Equal(t, []byte("s3cr3t"), secret.Data["secret"])
+}
+
+func TestWorkspaceReconcileOutputTargets(t *testing.T) {
+	t.Parallel()
+
+	workspace := &appv1alpha2.Workspace{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Name:          "this",
+			OutputTargets: []appv1alpha2.OutputTarget{
+				{
+					Kind: appv1alpha2.OutputTargetKindConfigMap,
+					Name: "app-config",
+					Data: map[string]string{"DB_ENDPOINT": "{{ .db_endpoint }}"},
+				},
+				{
+					Kind: appv1alpha2.OutputTargetKindSecret,
+					Name: "app-db",
+					Type: corev1.SecretTypeBasicAuth,
+					Data: map[string]string{
+						corev1.BasicAuthUsernameKey: "{{ .db_username }}",
+						corev1.BasicAuthPasswordKey: "{{ .db_password }}",
+					},
+				},
+			},
+		},
+	}
+	f := newFakeAPI(t, workspace)
+	r := &WorkspaceReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	workspace.Annotations = map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunNew:  MetaTrue,
+		appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+	}
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	require.NotNil(t, workspace.Status.Run)
+
+	require.NoError(t, f.server.SetRunStatus(workspace.Status.Run.ID, tfc.RunApplied))
+	require.NoError(t, f.server.SetOutputs(workspace.Status.WorkspaceID, []*tfc.StateVersionOutput{
+		{Name: "db_endpoint", Value: "db.example.com:5432"},
+		{Name: "db_username", Value: "app"},
+		{Name: "db_password", Value: "s3cr3t", Sensitive: true},
+	}))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, f.client.Get(context.TODO(), types.NamespacedName{Namespace: fakeNamespace, Name: "app-config"}, cm))
+	assert.Equal(t, map[string]string{"DB_ENDPOINT": "db.example.com:5432"}, cm.Data)
+	secret := &corev1.Secret{}
+	require.NoError(t, f.client.Get(context.TODO(), types.NamespacedName{Namespace: fakeNamespace, Name: "app-db"}, secret))
+	assert.Equal(t, corev1.SecretTypeBasicAuth, secret.Type)
+	assert.Equal(t, []byte("app"), secret.Data[corev1.BasicAuthUsernameKey])
+	assert.Equal(t, []byte("s3cr3t"), secret.Data[corev1.BasicAuthPasswordKey])
+
+	// A changed template is rendered without a new run.
+	workspace.Spec.OutputTargets[0].Data = map[string]string{"DB_URL": "postgres://{{ .db_endpoint }}"}
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.NoError(t, f.client.Get(context.TODO(), types.NamespacedName{Namespace: fakeNamespace, Name: "app-config"}, cm))
+	assert.Equal(t, map[string]string{"DB_URL": "postgres://db.example.com:5432"}, cm.Data)
+
+	// The type of a Secret is immutable, the Secret is created again.
+	workspace.Spec.OutputTargets[1].Type = ""
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	secret = &corev1.Secret{}
+	require.NoError(t, f.client.Get(context.TODO(), types.NamespacedName{Namespace: fakeNamespace, Name: "app-db"}, secret))
+	assert.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+	assert.Equal(t, []byte("s3cr3t"), secret.Data[corev1.BasicAuthPasswordKey])
+	assert.Equal(t, MetaTrue, secret.Labels[labelOutputTarget])
+
+	// The objects of renamed and removed targets are deleted, the objects that store all outputs are kept.
+	workspace.Spec.OutputTargets = []appv1alpha2.OutputTarget{
+		{
+			Kind: appv1alpha2.OutputTargetKindConfigMap,
+			Name: "app-settings",
+			Data: map[string]string{"DB_ENDPOINT": "{{ .db_endpoint }}"},
+		},
+	}
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	requireSynced(t, workspace.Status.Conditions)
+	require.NoError(t, f.client.Get(context.TODO(), types.NamespacedName{Namespace: fakeNamespace, Name: "app-settings"}, cm))
+	assert.Equal(t, map[string]string{"DB_ENDPOINT": "db.example.com:5432"}, cm.Data)
+	assert.False(t, f.exists(t, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: fakeNamespace}}))
+	assert.False(t, f.exists(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "app-db", Namespace: fakeNamespace}}))
+	assert.True(t, f.exists(t, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: OutputObjectName(workspace.Name), Namespace: fakeNamespace}}))
+	assert.True(t, f.exists(t, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: OutputObjectName(workspace.Name), Namespace: fakeNamespace}}))
+
+	// An object that is not owned by the Workspace is not overwritten.
+	require.NoError(t, f.client.Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: fakeNamespace},
+		Data:       map[string]string{"key": "value"},
+	}))
+	workspace.Spec.OutputTargets[0].Name = "foreign"
+	workspace.Generation++
+	require.NoError(t, f.client.Update(context.TODO(), workspace))
+	f.reconcile(t, r, workspace)
+	c := meta.FindStatusCondition(workspace.Status.Conditions, appv1alpha2.ConditionTypeSynced)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	require.NoError(t, f.client.Get(context.TODO(), types.NamespacedName{Namespace: fakeNamespace, Name: "foreign"}, cm))
+	assert.Equal(t, map[string]string{"key": "value"}, cm.Data)
+}
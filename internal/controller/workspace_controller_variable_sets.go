@@ -63,8 +63,8 @@ func (w *workspaceInstance) applyVariableSetsToWorkspace(ctx context.Context, vs
 
 }
 
-func (w *workspaceInstance) addOrUpdateVariableSetsStatus(vs appv1alpha2.VariableSetStatus) {
-	if slices.ContainsFunc(w.instance.Status.VariableSets, func(v appv1alpha2.VariableSetStatus) bool {
+func (w *workspaceInstance) addOrUpdateVariableSetsStatus(vs appv1alpha2.WorkspaceVariableSetStatus) {
+	if slices.ContainsFunc(w.instance.Status.VariableSets, func(v appv1alpha2.WorkspaceVariableSetStatus) bool {
 		return v.ID == vs.ID
 	}) {
 		return
@@ -73,7 +73,7 @@ func (w *workspaceInstance) addOrUpdateVariableSetsStatus(vs appv1alpha2.Variabl
 }
 
 func (w *workspaceInstance) deleteVariableSetsStatus(id string) {
-	w.instance.Status.VariableSets = slices.DeleteFunc(w.instance.Status.VariableSets, func(vs appv1alpha2.VariableSetStatus) bool {
+	w.instance.Status.VariableSets = slices.DeleteFunc(w.instance.Status.VariableSets, func(vs appv1alpha2.WorkspaceVariableSetStatus) bool {
 		return vs.ID == id
 	})
 }
@@ -81,6 +81,11 @@ func (w *workspaceInstance) deleteVariableSetsStatus(id string) {
 func (r *WorkspaceReconciler) reconcileVariableSets(ctx context.Context, w *workspaceInstance) error {
 	w.log.Info("Reconcile Variable Sets", "msg", "new reconciliation event")
 
+	if ignoresChanges(&w.instance, "variableSets") {
+		w.log.Info("Reconcile Variable Sets", "msg", "changes of variable sets are ignored")
+		return nil
+	}
+
 	if len(w.instance.Spec.VariableSets) == 0 && len(w.instance.Status.VariableSets) == 0 {
 		return nil
 	}
@@ -133,7 +138,7 @@ func (r *WorkspaceReconciler) reconcileVariableSets(ctx context.Context, w *work
 			w.log.Info("Reconcile Variable Sets", "msg", fmt.Sprintf("failed to apply variable set %s", id))
 			return err
 		}
-		w.addOrUpdateVariableSetsStatus(appv1alpha2.VariableSetStatus{ID: vs.ID, Name: vs.Name})
+		w.addOrUpdateVariableSetsStatus(appv1alpha2.WorkspaceVariableSetStatus{ID: vs.ID, Name: vs.Name})
 		delete(statusVariableSets, id)
 	}
 
@@ -9,10 +9,10 @@ import (
 	"encoding/gob"
 	"encoding/hex"
 	"fmt"
+	"maps"
 
 	tfc "github.com/hashicorp/go-tfe"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 
 	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
 )
@@ -175,25 +175,11 @@ func (r *WorkspaceReconciler) getVariablesByCategory(ctx context.Context, w *wor
 	}
 
 	for _, v := range specVariables {
-		value := v.Value
-		if v.ValueFrom != nil {
-			var err error
-			objectKey := types.NamespacedName{
-				Namespace: w.instance.Namespace,
-			}
-			if cm := v.ValueFrom.ConfigMapKeyRef; cm != nil {
-				objectKey.Name = cm.Name
-				value, err = configMapKeyRef(ctx, r.Client, objectKey, cm.Key)
-			}
-			if s := v.ValueFrom.SecretKeyRef; s != nil {
-				objectKey.Name = s.Name
-				value, err = secretKeyRef(ctx, r.Client, objectKey, s.Key)
-			}
-			if err != nil {
-				w.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to get value for the variable %s", v.Name))
-				r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileVariables", fmt.Sprintf("Failed to get value for the variable %s", v.Name))
-				return nil, err
-			}
+		value, err := variableValue(ctx, r.Client, w.instance.Namespace, v)
+		if err != nil {
+			w.log.Error(err, "Reconcile Variables", "msg", fmt.Sprintf("failed to get value for the variable %s", v.Name))
+			r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileVariables", fmt.Sprintf("Failed to get value for the variable %s", v.Name))
+			return nil, err
 		}
 		variables[v.Name] = tfc.Variable{
 			Key:         v.Name,
@@ -223,12 +209,29 @@ func getWorkspaceVariablesByCategory(workspaceVariables []*tfc.Variable, categor
 }
 
 func (r *WorkspaceReconciler) reconcileVariablesByCategory(ctx context.Context, w *workspaceInstance, variables []*tfc.Variable, category tfc.CategoryType) error {
+	field := "terraformVariables"
+	if category == tfc.CategoryEnv {
+		field = "environmentVariables"
+	}
+	if ignoresChanges(&w.instance, field) {
+		w.log.Info("Reconcile Variables", "msg", fmt.Sprintf("changes of %s variables are ignored", category))
+		return nil
+	}
+
 	workspaceVariables := getWorkspaceVariablesByCategory(variables, category)
 	specVariables, err := r.getVariablesByCategory(ctx, w, category)
 	if err != nil {
 		return err
 	}
 
+	// variables whose changes are ignored are neither created, updated, nor deleted
+	maps.DeleteFunc(specVariables, func(k string, _ tfc.Variable) bool {
+		return ignoresVariableChanges(&w.instance, field, k)
+	})
+	maps.DeleteFunc(workspaceVariables, func(k string, _ tfc.Variable) bool {
+		return ignoresVariableChanges(&w.instance, field, k)
+	})
+
 	if len(specVariables) == 0 && len(workspaceVariables) == 0 {
 		w.log.Info("Reconcile Variables", "msg", fmt.Sprintf("there are no %s variables both in spec and workspace", category))
 		return nil
@@ -0,0 +1,424 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// WorkspaceRunReconciler reconciles a WorkspaceRun object
+type WorkspaceRunReconciler struct {
+	client.Client
+	Recorder    record.EventRecorder
+	Scheme      *runtime.Scheme
+	ClientCache *TerraformClientCache
+}
+
+type workspaceRunInstance struct {
+	instance appv1alpha2.WorkspaceRun
+
+	log      logr.Logger
+	tfClient HCPTerraformClient
+}
+
+//+kubebuilder:rbac:groups=app.terraform.io,resources=workspaceruns,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.terraform.io,resources=workspaceruns/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.terraform.io,resources=workspaceruns/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *WorkspaceRunReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	w := workspaceRunInstance{}
+
+	w.log = log.Log.WithValues("workspacerun", req.NamespacedName)
+	w.log.Info("Workspace Run Controller", "msg", "new reconciliation event")
+
+	err := r.Client.Get(ctx, req.NamespacedName, &w.instance)
+	if err != nil {
+		// 'Not found' error occurs when an object is removed from the Kubernetes
+		// No actions are required in this case
+		if kerrors.IsNotFound(err) {
+			w.log.Info("Workspace Run Controller", "msg", "the instance was removed no further action is required")
+			return doNotRequeue()
+		}
+		w.log.Error(err, "Workspace Run Controller", "msg", "get instance object")
+		return requeueAfter(requeueInterval)
+	}
+
+	if a, ok := w.instance.GetAnnotations()[annotationPaused]; ok && a == MetaTrue {
+		w.log.Info("Workspace Run Controller", "msg", "reconciliation is paused for this resource")
+		return doNotRequeue()
+	}
+
+	w.log.Info("Spec Validation", "msg", "validating instance object spec")
+	if err := w.instance.ValidateSpec(); err != nil {
+		w.log.Error(err, "Spec Validation", "msg", "spec is invalid, exit from reconciliation")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "SpecValidation", err.Error())
+		setSpecValidCondition(&w.instance.Status.Conditions, w.instance.Generation, err)
+		if err := r.Status().Update(ctx, &w.instance); err != nil {
+			w.log.Error(err, "Spec Validation", "msg", "failed to update status conditions")
+		}
+		return doNotRequeue()
+	}
+	w.log.Info("Spec Validation", "msg", "spec is valid")
+	setSpecValidCondition(&w.instance.Status.Conditions, w.instance.Generation, nil)
+
+	if needToAddFinalizer(&w.instance, workspaceRunFinalizer) {
+		err := r.addFinalizer(ctx, &w.instance)
+		if err != nil {
+			w.log.Error(err, "Workspace Run Controller", "msg", fmt.Sprintf("failed to add finalizer %s to the object", workspaceRunFinalizer))
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "AddFinalizer", "Failed to add finalizer %s to the object", workspaceRunFinalizer)
+			return requeueOnErr(err)
+		}
+		w.log.Info("Workspace Run Controller", "msg", fmt.Sprintf("successfully added finalizer %s to the object", workspaceRunFinalizer))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "AddFinalizer", "Successfully added finalizer %s to the object", workspaceRunFinalizer)
+	}
+
+	// the run is completed, there is nothing to reconcile but the deletion and the garbage collection
+	if w.instance.RunCompleted() {
+		if isDeletionCandidate(&w.instance, workspaceRunFinalizer) {
+			w.log.Info("Workspace Run Controller", "msg", fmt.Sprintf("run is completed, remove finalizer %s", workspaceRunFinalizer))
+			return requeueOnErr(r.removeFinalizer(ctx, &w))
+		}
+		return r.reconcileTTL(ctx, &w)
+	}
+
+	err = r.getTerraformClient(ctx, &w)
+	if err != nil {
+		w.log.Error(err, "Workspace Run Controller", "msg", "failed to get HCP Terraform client")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "TerraformClient", "Failed to get HCP Terraform Client")
+		setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, appv1alpha2.ConditionReasonTerraformClient, err)
+		if err := r.Status().Update(ctx, &w.instance); err != nil {
+			w.log.Error(err, "Workspace Run Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+
+	err = r.reconcileWorkspaceRun(ctx, &w)
+	if err != nil {
+		w.log.Error(err, "Workspace Run Controller", "msg", "reconcile workspace run")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to reconcile workspace run")
+		setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, appv1alpha2.ConditionReasonReconcileFailed, err)
+		if err := r.Status().Update(ctx, &w.instance); err != nil {
+			w.log.Error(err, "Workspace Run Controller", "msg", "failed to update status conditions")
+		}
+		return requeueAfter(requeueInterval)
+	}
+	w.log.Info("Workspace Run Controller", "msg", "successfully reconcilied workspace run")
+
+	if w.instance.RunCompleted() {
+		return r.reconcileTTL(ctx, &w)
+	}
+
+	return requeueAfter(WorkspaceRunSyncPeriod)
+}
+
+func (r *WorkspaceRunReconciler) addFinalizer(ctx context.Context, instance *appv1alpha2.WorkspaceRun) error {
+	controllerutil.AddFinalizer(instance, workspaceRunFinalizer)
+
+	return r.Update(ctx, instance)
+}
+
+func (r *WorkspaceRunReconciler) getTerraformClient(ctx context.Context, w *workspaceRunInstance) error {
+	var err error
+	w.tfClient.Client, err = newTerraformClient(ctx, r.Client, r.ClientCache, w.log, w.instance.Namespace, w.instance.Spec.Organization, w.instance.Spec.Token, w.instance.Spec.ConnectionRef)
+
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *WorkspaceRunReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := setupReferenceIndexers(mgr, &appv1alpha2.WorkspaceRun{}); err != nil {
+		return err
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&appv1alpha2.WorkspaceRun{}, builder.WithPredicates(predicate.Or(genericPredicates())))
+
+	return watchReferences(mgr, b, &appv1alpha2.WorkspaceRunList{}).Complete(r)
+}
+
+func (r *WorkspaceRunReconciler) removeFinalizer(ctx context.Context, w *workspaceRunInstance) error {
+	controllerutil.RemoveFinalizer(&w.instance, workspaceRunFinalizer)
+
+	err := r.Update(ctx, &w.instance)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to remove finalizer %s", workspaceRunFinalizer))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "RemoveWorkspaceRun", "Failed to remove finalizer %s", workspaceRunFinalizer)
+	}
+
+	return err
+}
+
+// reconcileTTL deletes the object once the TTL after the run completion expires.
+// Otherwise, it requeues the object when the TTL expires.
+func (r *WorkspaceRunReconciler) reconcileTTL(ctx context.Context, w *workspaceRunInstance) (ctrl.Result, error) {
+	ttl := w.instance.Spec.TTLSecondsAfterFinished
+	if ttl == nil || w.instance.Status.CompletionTime == nil {
+		return doNotRequeue()
+	}
+
+	expireAt := w.instance.Status.CompletionTime.Add(time.Duration(*ttl) * time.Second)
+	if d := time.Until(expireAt); d > 0 {
+		w.log.Info("Workspace Run Controller", "msg", fmt.Sprintf("the object will be deleted in %s", d.Round(time.Second)))
+		return requeueAfter(d)
+	}
+
+	w.log.Info("Workspace Run Controller", "msg", "TTL after the run completion expired, deleting the object")
+	r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspaceRun", "TTL after the run completion expired, deleting the object")
+	if err := r.Delete(ctx, &w.instance); err != nil && !kerrors.IsNotFound(err) {
+		w.log.Error(err, "Workspace Run Controller", "msg", "failed to delete the object")
+		return requeueOnErr(err)
+	}
+
+	return doNotRequeue()
+}
+
+func (r *WorkspaceRunReconciler) getWorkspace(ctx context.Context, w *workspaceRunInstance) (*tfc.Workspace, error) {
+	spec := w.instance.Spec.Workspace
+
+	if spec.Name != "" {
+		w.log.Info("Reconcile Workspace Run", "msg", "getting workspace by name")
+		return w.tfClient.Client.Workspaces.Read(ctx, w.instance.Spec.Organization, spec.Name)
+	}
+
+	w.log.Info("Reconcile Workspace Run", "msg", "getting workspace by ID")
+	return w.tfClient.Client.Workspaces.ReadByID(ctx, spec.ID)
+}
+
+// workspaceRunCreateOptions returns the options to create a run of a given spec in a given workspace.
+func workspaceRunCreateOptions(spec appv1alpha2.WorkspaceRunSpec, workspaceID string) tfc.RunCreateOptions {
+	options := tfc.RunCreateOptions{
+		Workspace:    &tfc.Workspace{ID: workspaceID},
+		Message:      tfc.String(runMessage),
+		TargetAddrs:  spec.Targets,
+		ReplaceAddrs: spec.ReplaceAddrs,
+		AutoApply:    spec.AutoApply,
+	}
+	if spec.Message != "" {
+		options.Message = tfc.String(spec.Message)
+	}
+	if spec.AllowEmptyApply {
+		options.AllowEmptyApply = tfc.Bool(true)
+	}
+
+	switch spec.Type {
+	case appv1alpha2.WorkspaceRunTypePlan:
+		options.PlanOnly = tfc.Bool(true)
+	case appv1alpha2.WorkspaceRunTypeRefresh:
+		options.RefreshOnly = tfc.Bool(true)
+	case appv1alpha2.WorkspaceRunTypeDestroy:
+		options.IsDestroy = tfc.Bool(true)
+	}
+
+	for _, v := range spec.Variables {
+		options.Variables = append(options.Variables, &tfc.RunVariable{
+			Key:   v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return options
+}
+
+func (r *WorkspaceRunReconciler) createRun(ctx context.Context, w *workspaceRunInstance) (*tfc.Run, error) {
+	workspace, err := r.getWorkspace(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace Run", "msg", "failed to get workspace")
+		r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to get workspace")
+		return nil, err
+	}
+
+	run, err := w.tfClient.Client.Runs.Create(ctx, workspaceRunCreateOptions(w.instance.Spec, workspace.ID))
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace Run", "msg", "failed to create a new run")
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to create a new run in workspace ID %s", workspace.ID)
+		return nil, err
+	}
+
+	w.instance.Status.WorkspaceID = workspace.ID
+
+	return run, nil
+}
+
+func (r *WorkspaceRunReconciler) readRun(ctx context.Context, w *workspaceRunInstance) (*tfc.Run, error) {
+	return w.tfClient.Client.Runs.Read(ctx, w.instance.Status.ID)
+}
+
+func (r *WorkspaceRunReconciler) readPlan(ctx context.Context, w *workspaceRunInstance, run *tfc.Run) (*appv1alpha2.WorkspaceRunPlanStatus, error) {
+	if run.Plan == nil {
+		return nil, nil
+	}
+
+	plan, err := w.tfClient.Client.Plans.Read(ctx, run.Plan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appv1alpha2.WorkspaceRunPlanStatus{
+		ID:                   plan.ID,
+		Status:               string(plan.Status),
+		ResourceAdditions:    plan.ResourceAdditions,
+		ResourceChanges:      plan.ResourceChanges,
+		ResourceDestructions: plan.ResourceDestructions,
+		ResourceImports:      plan.ResourceImports,
+	}, nil
+}
+
+// readOutputs returns the outputs of the workspace state created by a given run.
+// It returns no outputs when the run is not the current run of the workspace anymore, since the state is not created by the run.
+func (r *WorkspaceRunReconciler) readOutputs(ctx context.Context, w *workspaceRunInstance, run *tfc.Run) ([]appv1alpha2.WorkspaceRunOutput, error) {
+	workspace, err := w.tfClient.Client.Workspaces.ReadByID(ctx, w.instance.Status.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace.CurrentRun == nil || workspace.CurrentRun.ID != run.ID || workspace.CurrentStateVersion == nil {
+		w.log.Info("Reconcile Workspace Run", "msg", "the current workspace state is not created by the run, skip outputs")
+		return nil, nil
+	}
+
+	opts := &tfc.StateVersionOutputsListOptions{
+		ListOptions: tfc.ListOptions{
+			PageSize: MaxPageSize,
+		},
+	}
+	var outputs []appv1alpha2.WorkspaceRunOutput
+	for {
+		resp, err := w.tfClient.Client.StateVersions.ListOutputs(ctx, workspace.CurrentStateVersion.ID, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range resp.Items {
+			output := appv1alpha2.WorkspaceRunOutput{
+				Name:      o.Name,
+				Sensitive: o.Sensitive,
+			}
+			if !o.Sensitive {
+				output.Value, err = formatOutput(o)
+				if err != nil {
+					w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to marshal JSON for %q", o.Name))
+					r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "failed to marshal JSON")
+					continue
+				}
+			}
+			outputs = append(outputs, output)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.PageNumber = resp.NextPage
+	}
+
+	return outputs, nil
+}
+
+func (r *WorkspaceRunReconciler) updateStatus(ctx context.Context, w *workspaceRunInstance, run *tfc.Run) error {
+	w.instance.Status.ObservedGeneration = w.instance.Generation
+	w.instance.Status.ID = run.ID
+	w.instance.Status.Status = string(run.Status)
+	if run.ConfigurationVersion != nil {
+		w.instance.Status.ConfigurationVersion = run.ConfigurationVersion.ID
+	}
+	if w.instance.RunCompleted() && w.instance.Status.CompletionTime == nil {
+		w.instance.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	}
+	setSyncedCondition(&w.instance.Status.Conditions, w.instance.Generation, "", nil)
+
+	return r.Status().Update(ctx, &w.instance)
+}
+
+func (r *WorkspaceRunReconciler) reconcileWorkspaceRun(ctx context.Context, w *workspaceRunInstance) (err error) {
+	w.log.Info("Reconcile Workspace Run", "msg", "reconciling workspace run")
+
+	var run *tfc.Run
+
+	defer func() {
+		// Update the status with the Run ID if the reconciliation failed. This is crucial
+		// to avoid creating another run when the run has been created successfully,
+		// but the status update failed.
+		if err != nil && run != nil && run.ID != "" {
+			w.instance.Status.ID = run.ID
+			if err := r.Status().Update(ctx, &w.instance); err != nil {
+				w.log.Error(err, "Workspace Run Controller", "msg", "update status with run ID")
+				r.Recorder.Event(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to update status with run ID")
+			}
+		}
+	}()
+
+	// verify whether the Kubernetes object has been marked as deleted and if so stop the run
+	if isDeletionCandidate(&w.instance, workspaceRunFinalizer) {
+		w.log.Info("Reconcile Workspace Run", "msg", "object marked as deleted, need to stop run first")
+		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspaceRun", "Object marked as deleted, need to stop run first")
+		return r.deleteWorkspaceRun(ctx, w)
+	}
+
+	// create a new run if run ID is unknown(means it was never created by the controller)
+	// this condition will work just one time, when a new Kubernetes object is created
+	// the run is never re-created, the spec changes made afterwards are not applied
+	if w.instance.IsCreationCandidate() {
+		w.log.Info("Reconcile Workspace Run", "msg", "status.ID is empty, creating a new run")
+		r.Recorder.Event(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspaceRun", "Status.ID is empty, creating a new run")
+		run, err = r.createRun(ctx, w)
+		if err != nil {
+			return err
+		}
+		w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("successfully created a new run %s", run.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeNormal, "ReconcileWorkspaceRun", "Successfully created a new run with ID %s", run.ID)
+		return r.updateStatus(ctx, w, run)
+	}
+
+	// read the HCP Terraform run to update the status
+	run, err = r.readRun(ctx, w)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to read run ID %s", w.instance.Status.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to read run ID %s", w.instance.Status.ID)
+		return err
+	}
+
+	plan, err := r.readPlan(ctx, w, run)
+	if err != nil {
+		w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to read plan of run ID %s", run.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to read plan of run ID %s", run.ID)
+		return err
+	}
+	w.instance.Status.Plan = plan
+
+	if run.Status == tfc.RunApplied {
+		outputs, err := r.readOutputs(ctx, w, run)
+		if err != nil {
+			w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to read outputs of run ID %s", run.ID))
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "ReconcileWorkspaceRun", "Failed to read outputs of run ID %s", run.ID)
+			return err
+		}
+		w.instance.Status.Outputs = outputs
+	}
+
+	if w.instance.Status.Status != string(run.Status) {
+		w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("run ID %s status changed to %s", run.ID, run.Status))
+		eventType := corev1.EventTypeNormal
+		if run.Status == tfc.RunErrored {
+			eventType = corev1.EventTypeWarning
+		}
+		r.Recorder.Eventf(&w.instance, eventType, "ReconcileWorkspaceRun", "Run ID %s status changed to %s", run.ID, run.Status)
+	}
+
+	return r.updateStatus(ctx, w, run)
+}
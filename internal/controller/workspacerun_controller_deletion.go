@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	tfc "github.com/hashicorp/go-tfe"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// deleteWorkspaceRun stops the run if it is still in progress and removes the finalizer.
+// Runs awaiting confirmation are discarded, runs in other non-final statuses are canceled.
+func (r *WorkspaceRunReconciler) deleteWorkspaceRun(ctx context.Context, w *workspaceRunInstance) error {
+	if w.instance.Status.ID == "" {
+		w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("status.ID is empty, remove finalizer %s", workspaceRunFinalizer))
+		return r.removeFinalizer(ctx, w)
+	}
+
+	run, err := r.readRun(ctx, w)
+	if err != nil {
+		if err == tfc.ErrResourceNotFound {
+			w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("run ID %s not found, remove finalizer %s", w.instance.Status.ID, workspaceRunFinalizer))
+			return r.removeFinalizer(ctx, w)
+		}
+		w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to read run ID %s", w.instance.Status.ID))
+		r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "RemoveWorkspaceRun", "Failed to read run ID %s", w.instance.Status.ID)
+		return err
+	}
+
+	w.instance.Status.Status = string(run.Status)
+	if !w.instance.RunCompleted() && run.Actions != nil {
+		switch {
+		case run.Actions.IsDiscardable:
+			w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("discarding run ID %s", run.ID))
+			err = w.tfClient.Client.Runs.Discard(ctx, run.ID, tfc.RunDiscardOptions{Comment: tfc.String(runMessage)})
+		case run.Actions.IsCancelable:
+			w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("canceling run ID %s", run.ID))
+			err = w.tfClient.Client.Runs.Cancel(ctx, run.ID, tfc.RunCancelOptions{Comment: tfc.String(runMessage)})
+		}
+		if err != nil {
+			w.log.Error(err, "Reconcile Workspace Run", "msg", fmt.Sprintf("failed to stop run ID %s", run.ID))
+			r.Recorder.Eventf(&w.instance, corev1.EventTypeWarning, "RemoveWorkspaceRun", "Failed to stop run ID %s", run.ID)
+			return err
+		}
+	}
+
+	w.log.Info("Reconcile Workspace Run", "msg", fmt.Sprintf("remove finalizer %s", workspaceRunFinalizer))
+	return r.removeFinalizer(ctx, w)
+}
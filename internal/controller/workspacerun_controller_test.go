@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+	"github.com/hashicorp/hcp-terraform-operator/internal/pointer"
+)
+
+func TestWorkspaceRunReconcile(t *testing.T) {
+	t.Parallel()
+
+	workspaceRun := &appv1alpha2.WorkspaceRun{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceRunSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Workspace:     &appv1alpha2.WorkspaceRunWorkspace{Name: "this"},
+			Type:          appv1alpha2.WorkspaceRunTypeApply,
+			Targets:       []string{"random_pet.this"},
+			ReplaceAddrs:  []string{"random_pet.that"},
+			Variables: []appv1alpha2.WorkspaceRunVariable{
+				{Name: "length", Value: "3"},
+			},
+			Message:                 "this",
+			AutoApply:               tfc.Bool(true),
+			TTLSecondsAfterFinished: pointer.PointerOf(int32(0)),
+		},
+	}
+	f := newFakeAPI(t, workspaceRun)
+	r := &WorkspaceRunReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	ctx := context.TODO()
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(ctx, fakeOrganization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+
+	f.reconcile(t, r, workspaceRun)
+	requireSynced(t, workspaceRun.Status.Conditions)
+	assert.Contains(t, workspaceRun.Finalizers, workspaceRunFinalizer)
+	assert.Equal(t, ws.ID, workspaceRun.Status.WorkspaceID)
+	assert.Equal(t, string(tfc.RunPending), workspaceRun.Status.Status)
+	run := f.server.Run(workspaceRun.Status.ID)
+	require.NotNil(t, run)
+	assert.Equal(t, "this", run.Message)
+	assert.Equal(t, []string{"random_pet.this"}, run.TargetAddrs)
+	assert.Equal(t, []string{"random_pet.that"}, run.ReplaceAddrs)
+	assert.Equal(t, []*tfc.RunVariableAttr{{Key: "length", Value: "3"}}, run.Variables)
+	assert.True(t, run.AutoApply)
+	assert.False(t, run.PlanOnly)
+
+	// Changes made to the spec after the run is created do not start another run.
+	workspaceRun.Spec.Message = "that"
+	workspaceRun.Generation++
+	require.NoError(t, f.client.Update(ctx, workspaceRun))
+	require.NoError(t, f.server.SetPlan(run.ID, &tfc.Plan{Status: tfc.PlanFinished, ResourceAdditions: 1, ResourceDestructions: 2}))
+	require.NoError(t, f.server.SetRunStatus(run.ID, tfc.RunApplying))
+	f.reconcile(t, r, workspaceRun)
+	requireSynced(t, workspaceRun.Status.Conditions)
+	assert.Equal(t, run.ID, workspaceRun.Status.ID)
+	assert.Len(t, f.server.Runs(ws.ID), 1)
+	assert.Equal(t, string(tfc.RunApplying), workspaceRun.Status.Status)
+	require.NotNil(t, workspaceRun.Status.Plan)
+	assert.Equal(t, run.Plan.ID, workspaceRun.Status.Plan.ID)
+	assert.Equal(t, 1, workspaceRun.Status.Plan.ResourceAdditions)
+	assert.Equal(t, 2, workspaceRun.Status.Plan.ResourceDestructions)
+	assert.Nil(t, workspaceRun.Status.CompletionTime)
+
+	// The object is deleted once the run is completed since the TTL is zero.
+	require.NoError(t, f.server.SetRunStatus(run.ID, tfc.RunApplied))
+	require.NoError(t, f.server.SetOutputs(ws.ID, []*tfc.StateVersionOutput{
+		{Name: "plain", Value: "value"},
+		{Name: "secret", Value: "s3cr3t", Sensitive: true},
+	}))
+	f.reconcile(t, r, workspaceRun)
+	assert.Equal(t, string(tfc.RunApplied), workspaceRun.Status.Status)
+	assert.NotNil(t, workspaceRun.Status.CompletionTime)
+	assert.ElementsMatch(t, []appv1alpha2.WorkspaceRunOutput{
+		{Name: "plain", Value: "value"},
+		{Name: "secret", Sensitive: true},
+	}, workspaceRun.Status.Outputs)
+	assert.False(t, workspaceRun.GetDeletionTimestamp().IsZero())
+
+	f.reconcile(t, r, workspaceRun)
+	assert.False(t, f.exists(t, workspaceRun))
+	assert.Equal(t, tfc.RunApplied, f.server.Run(run.ID).Status)
+}
+
+func TestWorkspaceRunReconcileDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.TODO()
+	workspaceRun := &appv1alpha2.WorkspaceRun{
+		ObjectMeta: objectMeta("this"),
+		Spec: appv1alpha2.WorkspaceRunSpec{
+			Organization:  fakeOrganization,
+			ConnectionRef: connectionRef(),
+			Type:          appv1alpha2.WorkspaceRunTypePlan,
+		},
+	}
+	f := newFakeAPI(t)
+	r := &WorkspaceRunReconciler{Client: f.client, Recorder: f.recorder, Scheme: f.client.Scheme()}
+
+	c, err := f.server.Client()
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(ctx, fakeOrganization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	workspaceRun.Spec.Workspace = &appv1alpha2.WorkspaceRunWorkspace{ID: ws.ID}
+	require.NoError(t, f.client.Create(ctx, workspaceRun))
+
+	f.reconcile(t, r, workspaceRun)
+	requireSynced(t, workspaceRun.Status.Conditions)
+	run := f.server.Run(workspaceRun.Status.ID)
+	require.NotNil(t, run)
+	assert.True(t, run.PlanOnly)
+	assert.Equal(t, runMessage, run.Message)
+
+	// The run in progress is stopped when the object is deleted.
+	f.delete(t, workspaceRun)
+	f.reconcile(t, r, workspaceRun)
+	assert.False(t, f.exists(t, workspaceRun))
+	assert.Equal(t, tfc.RunDiscarded, f.server.Run(run.ID).Status)
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerAgents(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/agent-pools", s.createAgentPool)
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/agent-pools", s.listAgentPools)
+	mux.HandleFunc("GET "+basePath+"/agent-pools/{pool}", s.readAgentPool)
+	mux.HandleFunc("PATCH "+basePath+"/agent-pools/{pool}", s.updateAgentPool)
+	mux.HandleFunc("DELETE "+basePath+"/agent-pools/{pool}", s.deleteAgentPool)
+
+	mux.HandleFunc("GET "+basePath+"/agent-pools/{pool}/authentication-tokens", s.listAgentTokens)
+	mux.HandleFunc("POST "+basePath+"/agent-pools/{pool}/authentication-tokens", s.createAgentToken)
+	mux.HandleFunc("GET "+basePath+"/authentication-tokens/{token}", s.readAgentToken)
+	mux.HandleFunc("DELETE "+basePath+"/authentication-tokens/{token}", s.deleteAgentToken)
+}
+
+// AddAgentPool creates a new agent pool in a given organization and returns it.
+func (s *Server) AddAgentPool(organization, name string) *tfc.AgentPool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool := &tfc.AgentPool{
+		ID:                 s.newID("apool"),
+		Name:               name,
+		CreatedAt:          now(),
+		OrganizationScoped: true,
+		Organization:       &tfc.Organization{Name: organization},
+	}
+	s.agentPools[pool.ID] = pool
+
+	return copyOf(pool)
+}
+
+// AgentPool returns a copy of the agent pool with a given ID or nil if it does not exist.
+func (s *Server) AgentPool(id string) *tfc.AgentPool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.agentPools[id])
+}
+
+// AgentTokens returns copies of all agent tokens of the agent pool with a given ID.
+func (s *Server) AgentTokens(poolID string) []*tfc.AgentToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.agentTokens, func(t *tfc.AgentToken) bool {
+		return s.agentTokenPools[t.ID] == poolID
+	})
+}
+
+// agentPool returns the agent pool of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) agentPool(w http.ResponseWriter, r *http.Request) (*tfc.AgentPool, bool) {
+	pool, ok := s.agentPools[r.PathValue("pool")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return pool, true
+}
+
+// agentPoolNameTaken reports whether another agent pool in an organization has a given name.
+// The caller must hold the lock.
+func (s *Server) agentPoolNameTaken(organization, id, name string) bool {
+	for _, p := range s.agentPools {
+		if p.ID != id && p.Organization.Name == organization && p.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) createAgentPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	pool := &tfc.AgentPool{OrganizationScoped: true}
+	if _, ok := readResource(w, r, pool); !ok {
+		return
+	}
+	if pool.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.agentPoolNameTaken(org, "", pool.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	pool.ID = s.newID("apool")
+	pool.CreatedAt = now()
+	pool.Organization = &tfc.Organization{Name: org}
+	s.agentPools[pool.ID] = pool
+
+	writeResource(w, http.StatusCreated, pool)
+}
+
+func (s *Server) listAgentPools(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	query := r.URL.Query().Get("q")
+	items := values(s.agentPools, func(p *tfc.AgentPool) bool {
+		return p.Organization.Name == org && strings.Contains(p.Name, query)
+	})
+
+	writeList(w, r, items, func(p *tfc.AgentPool) string { return p.ID })
+}
+
+func (s *Server) readAgentPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.agentPool(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, pool)
+}
+
+func (s *Server) updateAgentPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.agentPool(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(pool)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if s.agentPoolNameTaken(pool.Organization.Name, pool.ID, updated.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	updated.ID = pool.ID
+	s.agentPools[pool.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteAgentPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.agentPool(w, r)
+	if !ok {
+		return
+	}
+	for _, ws := range s.workspaces {
+		if ws.AgentPool != nil && ws.AgentPool.ID == pool.ID {
+			writeError(w, http.StatusUnprocessableEntity, "agent pool is still used by workspaces")
+			return
+		}
+	}
+	delete(s.agentPools, pool.ID)
+	for id, poolID := range s.agentTokenPools {
+		if poolID == pool.ID {
+			delete(s.agentTokens, id)
+			delete(s.agentTokenPools, id)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// withoutSecret returns a copy of a given agent token as the API returns it after creation, without the secret.
+func withoutSecret(t *tfc.AgentToken) *tfc.AgentToken {
+	c := copyOf(t)
+	c.Token = ""
+
+	return c
+}
+
+func (s *Server) listAgentTokens(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.agentPool(w, r)
+	if !ok {
+		return
+	}
+	items := []*tfc.AgentToken{}
+	for id, poolID := range s.agentTokenPools {
+		if poolID == pool.ID {
+			items = append(items, withoutSecret(s.agentTokens[id]))
+		}
+	}
+
+	writeList(w, r, items, func(t *tfc.AgentToken) string { return t.ID })
+}
+
+func (s *Server) createAgentToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.agentPool(w, r)
+	if !ok {
+		return
+	}
+	options := &tfc.AgentTokenCreateOptions{}
+	if _, ok := readResource(w, r, options); !ok {
+		return
+	}
+	token := &tfc.AgentToken{
+		ID:        s.newID("at"),
+		CreatedAt: now(),
+	}
+	if options.Description != nil {
+		token.Description = *options.Description
+	}
+	token.Token = s.newID("secret")
+	s.agentTokens[token.ID] = token
+	s.agentTokenPools[token.ID] = pool.ID
+
+	writeResource(w, http.StatusCreated, token)
+}
+
+func (s *Server) readAgentToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.agentTokens[r.PathValue("token")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, withoutSecret(token))
+}
+
+func (s *Server) deleteAgentToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.agentTokens[r.PathValue("token")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.agentTokens, token.ID)
+	delete(s.agentTokenPools, token.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerNotifications(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/notification-configurations", s.listNotifications)
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/notification-configurations", s.createNotification)
+	mux.HandleFunc("GET "+basePath+"/notification-configurations/{notification}", s.readNotification)
+	mux.HandleFunc("PATCH "+basePath+"/notification-configurations/{notification}", s.updateNotification)
+	mux.HandleFunc("DELETE "+basePath+"/notification-configurations/{notification}", s.deleteNotification)
+}
+
+// Notifications returns copies of all notification configurations of the workspace with a given ID.
+func (s *Server) Notifications(workspaceID string) []*tfc.NotificationConfiguration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.notifications, func(n *tfc.NotificationConfiguration) bool {
+		return s.notificationWorkspaces[n.ID] == workspaceID
+	})
+}
+
+// notification returns the notification configuration of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) notification(w http.ResponseWriter, r *http.Request) (*tfc.NotificationConfiguration, bool) {
+	n, ok := s.notifications[r.PathValue("notification")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return n, true
+}
+
+// subscribable sets the workspace relation of a given notification configuration.
+func subscribable(n *tfc.NotificationConfiguration, workspaceID string) {
+	n.Subscribable = &tfc.Workspace{ID: workspaceID}
+	n.SubscribableChoice = nil
+}
+
+func (s *Server) listNotifications(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	items := values(s.notifications, func(n *tfc.NotificationConfiguration) bool {
+		return s.notificationWorkspaces[n.ID] == ws.ID
+	})
+
+	writeList(w, r, items, func(n *tfc.NotificationConfiguration) string { return n.ID })
+}
+
+func (s *Server) createNotification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	n := &tfc.NotificationConfiguration{}
+	if _, ok := readResource(w, r, n); !ok {
+		return
+	}
+	if n.Name == "" || n.DestinationType == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name and destination type are required")
+		return
+	}
+	n.ID = s.newID("nc")
+	n.CreatedAt = now()
+	n.UpdatedAt = n.CreatedAt
+	subscribable(n, ws.ID)
+	s.notifications[n.ID] = n
+	s.notificationWorkspaces[n.ID] = ws.ID
+
+	writeResource(w, http.StatusCreated, n)
+}
+
+func (s *Server) readNotification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.notification(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, n)
+}
+
+func (s *Server) updateNotification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.notification(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(n)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	updated.ID = n.ID
+	updated.UpdatedAt = now()
+	subscribable(updated, s.notificationWorkspaces[n.ID])
+	s.notifications[n.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteNotification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, ok := s.notification(w, r)
+	if !ok {
+		return
+	}
+	delete(s.notifications, n.ID)
+	delete(s.notificationWorkspaces, n.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
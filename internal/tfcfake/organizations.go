@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerOrganizations(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}", s.readOrganization)
+
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/organization-memberships", s.listOrganizationMemberships)
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/organization-memberships", s.createOrganizationMembership)
+}
+
+// AddOrganization creates a new organization with a default project and returns it.
+func (s *Server) AddOrganization(name string) *tfc.Organization {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project := &tfc.Project{
+		ID:           s.newID("prj"),
+		Name:         "Default Project",
+		Organization: &tfc.Organization{Name: name},
+	}
+	s.projects[project.ID] = project
+
+	org := &tfc.Organization{
+		Name:                 name,
+		CreatedAt:            now(),
+		DefaultExecutionMode: "remote",
+		DefaultProject:       &tfc.Project{ID: project.ID},
+	}
+	s.organizations[name] = org
+
+	return copyOf(org)
+}
+
+// AddOrganizationMember creates a new user with a given username and email and adds it to a given organization.
+// It returns the active organization membership of the user.
+func (s *Server) AddOrganizationMember(organization, username, email string) *tfc.OrganizationMembership {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := &tfc.User{
+		ID:       s.newID("user"),
+		Username: username,
+		Email:    email,
+	}
+	s.users[user.ID] = user
+
+	m := &tfc.OrganizationMembership{
+		ID:           s.newID("ou"),
+		Status:       tfc.OrganizationMembershipActive,
+		Email:        email,
+		Organization: &tfc.Organization{Name: organization},
+		User:         &tfc.User{ID: user.ID},
+	}
+	s.organizationMemberships[m.ID] = m
+
+	return copyOf(m)
+}
+
+// OrganizationMemberships returns copies of all organization memberships of a given organization.
+func (s *Server) OrganizationMemberships(organization string) []*tfc.OrganizationMembership {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.organizationMemberships, func(m *tfc.OrganizationMembership) bool {
+		return m.Organization.Name == organization
+	})
+}
+
+// organization reports whether the organization of a given request exists and writes a not found error otherwise.
+// The caller must hold the lock.
+func (s *Server) organization(w http.ResponseWriter, r *http.Request) (string, bool) {
+	name := r.PathValue("organization")
+	if _, ok := s.organizations[name]; !ok {
+		writeNotFound(w)
+		return "", false
+	}
+
+	return name, true
+}
+
+func (s *Server) readOrganization(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	writeResource(w, http.StatusOK, s.organizations[org])
+}
+
+// withUser returns a copy of a given organization membership with the user relation as the API includes it.
+// The caller must hold the lock.
+func (s *Server) withUser(m *tfc.OrganizationMembership) *tfc.OrganizationMembership {
+	c := copyOf(m)
+	if m.User != nil {
+		c.User = copyOf(s.users[m.User.ID])
+	}
+
+	return c
+}
+
+func (s *Server) listOrganizationMemberships(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var emails []string
+	for _, v := range q["filter[email]"] {
+		emails = append(emails, strings.Split(v, ",")...)
+	}
+	query := q.Get("q")
+	items := []*tfc.OrganizationMembership{}
+	for _, m := range s.organizationMemberships {
+		if m.Organization.Name != org || (emails != nil && !slices.Contains(emails, m.Email)) {
+			continue
+		}
+		c := s.withUser(m)
+		if query != "" && !strings.Contains(c.Email, query) && (c.User == nil || !strings.Contains(c.User.Username, query)) {
+			continue
+		}
+		if !slices.Contains(strings.Split(q.Get("include"), ","), "user") {
+			c.User = copyOf(m.User)
+		}
+		items = append(items, c)
+	}
+
+	writeList(w, r, items, func(m *tfc.OrganizationMembership) string { return m.ID })
+}
+
+func (s *Server) createOrganizationMembership(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	m := &tfc.OrganizationMembership{}
+	if _, ok := readResource(w, r, m); !ok {
+		return
+	}
+	if m.Email == "" {
+		writeError(w, http.StatusUnprocessableEntity, "email is required")
+		return
+	}
+	for _, o := range s.organizationMemberships {
+		if o.Organization.Name == org && o.Email == m.Email {
+			writeError(w, http.StatusUnprocessableEntity, "user is already a member of the organization")
+			return
+		}
+	}
+	for _, t := range m.Teams {
+		if !s.teamExists(w, t) {
+			return
+		}
+	}
+	m.ID = s.newID("ou")
+	m.Status = tfc.OrganizationMembershipInvited
+	m.Organization = &tfc.Organization{Name: org}
+	m.User = nil
+	// Invited users become members of the given teams right away.
+	for _, t := range m.Teams {
+		team := s.teams[t.ID]
+		team.OrganizationMemberships = append(slices.Clone(team.OrganizationMemberships), &tfc.OrganizationMembership{ID: m.ID})
+	}
+	m.Teams = nil
+	s.organizationMemberships[m.ID] = m
+
+	writeResource(w, http.StatusCreated, m)
+}
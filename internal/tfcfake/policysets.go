@@ -0,0 +1,656 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerPolicySets(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/policy-sets", s.listPolicySets)
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/policy-sets", s.createPolicySet)
+	mux.HandleFunc("GET "+basePath+"/policy-sets/{policyset}", s.readPolicySet)
+	mux.HandleFunc("PATCH "+basePath+"/policy-sets/{policyset}", s.updatePolicySet)
+	mux.HandleFunc("DELETE "+basePath+"/policy-sets/{policyset}", s.deletePolicySet)
+	mux.HandleFunc("POST "+basePath+"/policy-sets/{policyset}/relationships/policies", s.addPolicySetPolicies)
+	mux.HandleFunc("DELETE "+basePath+"/policy-sets/{policyset}/relationships/policies", s.removePolicySetPolicies)
+	mux.HandleFunc("POST "+basePath+"/policy-sets/{policyset}/relationships/workspaces", s.addPolicySetWorkspaces)
+	mux.HandleFunc("DELETE "+basePath+"/policy-sets/{policyset}/relationships/workspaces", s.removePolicySetWorkspaces)
+	mux.HandleFunc("POST "+basePath+"/policy-sets/{policyset}/relationships/projects", s.addPolicySetProjects)
+	mux.HandleFunc("DELETE "+basePath+"/policy-sets/{policyset}/relationships/projects", s.removePolicySetProjects)
+
+	mux.HandleFunc("GET "+basePath+"/policy-sets/{policyset}/parameters", s.listPolicySetParameters)
+	mux.HandleFunc("POST "+basePath+"/policy-sets/{policyset}/parameters", s.createPolicySetParameter)
+	mux.HandleFunc("GET "+basePath+"/policy-sets/{policyset}/parameters/{parameter}", s.readPolicySetParameter)
+	mux.HandleFunc("PATCH "+basePath+"/policy-sets/{policyset}/parameters/{parameter}", s.updatePolicySetParameter)
+	mux.HandleFunc("DELETE "+basePath+"/policy-sets/{policyset}/parameters/{parameter}", s.deletePolicySetParameter)
+
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/policies", s.listPolicies)
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/policies", s.createPolicy)
+	mux.HandleFunc("GET "+basePath+"/policies/{policy}", s.readPolicy)
+	mux.HandleFunc("PATCH "+basePath+"/policies/{policy}", s.updatePolicy)
+	mux.HandleFunc("DELETE "+basePath+"/policies/{policy}", s.deletePolicy)
+	mux.HandleFunc("PUT "+basePath+"/policies/{policy}/upload", s.uploadPolicy)
+	mux.HandleFunc("GET "+basePath+"/policies/{policy}/download", s.downloadPolicy)
+}
+
+// PolicySet returns a copy of the policy set with a given ID or nil if it does not exist.
+func (s *Server) PolicySet(id string) *tfc.PolicySet {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.policySets[id])
+}
+
+// Policy returns a copy of the policy with a given ID or nil if it does not exist.
+func (s *Server) Policy(id string) *tfc.Policy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.policies[id])
+}
+
+// PolicyContent returns the uploaded code of the policy with a given ID.
+func (s *Server) PolicyContent(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return string(s.policyContents[id])
+}
+
+// PolicySetParameters returns copies of all parameters of the policy set with a given ID.
+// Values of sensitive parameters are included.
+func (s *Server) PolicySetParameters(id string) []*tfc.PolicySetParameter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.policySetParameters, func(p *tfc.PolicySetParameter) bool {
+		return p.PolicySet.ID == id
+	})
+}
+
+// policySet returns the policy set of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) policySet(w http.ResponseWriter, r *http.Request) (*tfc.PolicySet, bool) {
+	ps, ok := s.policySets[r.PathValue("policyset")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return ps, true
+}
+
+// policySetNameTaken reports whether an organization has another policy set with a given name.
+// The caller must hold the lock.
+func (s *Server) policySetNameTaken(organization, id, name string) bool {
+	for _, ps := range s.policySets {
+		if ps.ID != id && ps.Organization.Name == organization && ps.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withPolicySetCounts returns a copy of a given policy set with the counters as the API returns them.
+func withPolicySetCounts(ps *tfc.PolicySet) *tfc.PolicySet {
+	c := copyOf(ps)
+	c.PolicyCount = len(ps.Policies)
+	c.WorkspaceCount = len(ps.Workspaces)
+	c.ProjectCount = len(ps.Projects)
+
+	return c
+}
+
+func (s *Server) listPolicySets(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query().Get("search[name]")
+	items := []*tfc.PolicySet{}
+	for _, ps := range s.policySets {
+		if ps.Organization.Name == org && strings.Contains(ps.Name, q) {
+			items = append(items, withPolicySetCounts(ps))
+		}
+	}
+
+	writeList(w, r, items, func(ps *tfc.PolicySet) string { return ps.ID })
+}
+
+func (s *Server) createPolicySet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	ps := &tfc.PolicySet{}
+	if _, ok := readResource(w, r, ps); !ok {
+		return
+	}
+	if ps.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.policySetNameTaken(org, "", ps.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	if ps.Kind == "" {
+		ps.Kind = tfc.Sentinel
+	}
+	if ps.Overridable != nil && *ps.Overridable && ps.Kind != tfc.OPA {
+		writeError(w, http.StatusUnprocessableEntity, "only OPA policy sets can be overridable")
+		return
+	}
+	if ps.VCSRepo != nil && len(ps.Policies) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "VCS policy sets cannot have individually managed policies")
+		return
+	}
+	for _, p := range ps.Policies {
+		if _, ok := s.policies[p.ID]; !ok {
+			writeNotFound(w)
+			return
+		}
+	}
+	ps.ID = s.newID("polset")
+	ps.Organization = &tfc.Organization{Name: org}
+	ps.CreatedAt = now()
+	ps.UpdatedAt = ps.CreatedAt
+	if ps.Global {
+		ps.Workspaces = nil
+		ps.Projects = nil
+	}
+	s.policySets[ps.ID] = ps
+
+	writeResource(w, http.StatusCreated, withPolicySetCounts(ps))
+}
+
+func (s *Server) readPolicySet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps, ok := s.policySet(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, withPolicySetCounts(ps))
+}
+
+func (s *Server) updatePolicySet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps, ok := s.policySet(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(ps)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if s.policySetNameTaken(ps.Organization.Name, ps.ID, updated.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	if updated.Kind != ps.Kind {
+		writeError(w, http.StatusUnprocessableEntity, "kind cannot be changed")
+		return
+	}
+	if updated.Overridable != nil && *updated.Overridable && updated.Kind != tfc.OPA {
+		writeError(w, http.StatusUnprocessableEntity, "only OPA policy sets can be overridable")
+		return
+	}
+	updated.ID = ps.ID
+	updated.UpdatedAt = now()
+	// Global policy sets apply to all workspaces and therefore drop their workspaces and projects.
+	if updated.Global {
+		updated.Workspaces = nil
+		updated.Projects = nil
+	}
+	s.policySets[ps.ID] = updated
+
+	writeResource(w, http.StatusOK, withPolicySetCounts(updated))
+}
+
+func (s *Server) deletePolicySet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps, ok := s.policySet(w, r)
+	if !ok {
+		return
+	}
+	delete(s.policySets, ps.ID)
+	for id, p := range s.policySetParameters {
+		if p.PolicySet.ID == ps.ID {
+			delete(s.policySetParameters, id)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updatePolicySetRelation returns a handler that adds or removes the resources of a request to or from a policy set.
+func updatePolicySetRelation[T any](s *Server, id func(*T) string, relation func(*tfc.PolicySet) *[]*T, exists func(string) bool, add bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		ps, ok := s.policySet(w, r)
+		if !ok {
+			return
+		}
+		items, ok := readResources[T](w, r)
+		if !ok {
+			return
+		}
+		current := relation(ps)
+		for _, i := range items {
+			if !exists(id(i)) {
+				writeNotFound(w)
+				return
+			}
+			*current = slices.DeleteFunc(*current, func(c *T) bool {
+				return id(c) == id(i)
+			})
+			if add {
+				*current = append(*current, i)
+			}
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) addPolicySetPolicies(w http.ResponseWriter, r *http.Request) {
+	s.updatePolicySetPolicies(true)(w, r)
+}
+
+func (s *Server) removePolicySetPolicies(w http.ResponseWriter, r *http.Request) {
+	s.updatePolicySetPolicies(false)(w, r)
+}
+
+func (s *Server) updatePolicySetPolicies(add bool) http.HandlerFunc {
+	return updatePolicySetRelation(s,
+		func(p *tfc.Policy) string { return p.ID },
+		func(ps *tfc.PolicySet) *[]*tfc.Policy { return &ps.Policies },
+		func(id string) bool { _, ok := s.policies[id]; return ok },
+		add,
+	)
+}
+
+func (s *Server) addPolicySetWorkspaces(w http.ResponseWriter, r *http.Request) {
+	s.updatePolicySetWorkspaces(true)(w, r)
+}
+
+func (s *Server) removePolicySetWorkspaces(w http.ResponseWriter, r *http.Request) {
+	s.updatePolicySetWorkspaces(false)(w, r)
+}
+
+func (s *Server) updatePolicySetWorkspaces(add bool) http.HandlerFunc {
+	return updatePolicySetRelation(s,
+		func(ws *tfc.Workspace) string { return ws.ID },
+		func(ps *tfc.PolicySet) *[]*tfc.Workspace { return &ps.Workspaces },
+		func(id string) bool { _, ok := s.workspaces[id]; return ok },
+		add,
+	)
+}
+
+func (s *Server) addPolicySetProjects(w http.ResponseWriter, r *http.Request) {
+	s.updatePolicySetProjects(true)(w, r)
+}
+
+func (s *Server) removePolicySetProjects(w http.ResponseWriter, r *http.Request) {
+	s.updatePolicySetProjects(false)(w, r)
+}
+
+func (s *Server) updatePolicySetProjects(add bool) http.HandlerFunc {
+	return updatePolicySetRelation(s,
+		func(p *tfc.Project) string { return p.ID },
+		func(ps *tfc.PolicySet) *[]*tfc.Project { return &ps.Projects },
+		func(id string) bool { _, ok := s.projects[id]; return ok },
+		add,
+	)
+}
+
+// policySetParameter returns the policy set parameter of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) policySetParameter(w http.ResponseWriter, r *http.Request) (*tfc.PolicySetParameter, bool) {
+	p, ok := s.policySetParameters[r.PathValue("parameter")]
+	if !ok || p.PolicySet.ID != r.PathValue("policyset") {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return p, true
+}
+
+// policySetParameterConflicts reports whether a policy set has another parameter with the same key.
+// The caller must hold the lock.
+func (s *Server) policySetParameterConflicts(p *tfc.PolicySetParameter) bool {
+	for _, o := range s.policySetParameters {
+		if o.ID != p.ID && o.PolicySet.ID == p.PolicySet.ID && o.Key == p.Key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactedPolicySetParameter returns a copy of a given parameter as the API returns it, without the value of a sensitive parameter.
+func redactedPolicySetParameter(p *tfc.PolicySetParameter) *tfc.PolicySetParameter {
+	c := copyOf(p)
+	if c.Sensitive {
+		c.Value = ""
+	}
+
+	return c
+}
+
+func (s *Server) listPolicySetParameters(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps, ok := s.policySet(w, r)
+	if !ok {
+		return
+	}
+	items := []*tfc.PolicySetParameter{}
+	for _, p := range s.policySetParameters {
+		if p.PolicySet.ID == ps.ID {
+			items = append(items, redactedPolicySetParameter(p))
+		}
+	}
+
+	writeList(w, r, items, func(p *tfc.PolicySetParameter) string { return p.ID })
+}
+
+func (s *Server) createPolicySetParameter(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps, ok := s.policySet(w, r)
+	if !ok {
+		return
+	}
+	p := &tfc.PolicySetParameter{}
+	if _, ok := readResource(w, r, p); !ok {
+		return
+	}
+	if p.Key == "" || p.Category != tfc.CategoryPolicySet {
+		writeError(w, http.StatusUnprocessableEntity, "key and category are required")
+		return
+	}
+	p.ID = s.newID("var")
+	p.PolicySet = &tfc.PolicySet{ID: ps.ID}
+	if s.policySetParameterConflicts(p) {
+		writeError(w, http.StatusUnprocessableEntity, "key has already been taken")
+		return
+	}
+	s.policySetParameters[p.ID] = p
+
+	writeResource(w, http.StatusCreated, redactedPolicySetParameter(p))
+}
+
+func (s *Server) readPolicySetParameter(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policySetParameter(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, redactedPolicySetParameter(p))
+}
+
+func (s *Server) updatePolicySetParameter(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policySetParameter(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(p)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if p.Sensitive && !updated.Sensitive {
+		writeError(w, http.StatusUnprocessableEntity, "sensitive parameters cannot be made non-sensitive")
+		return
+	}
+	updated.ID = p.ID
+	updated.Category = p.Category
+	updated.PolicySet = &tfc.PolicySet{ID: p.PolicySet.ID}
+	if s.policySetParameterConflicts(updated) {
+		writeError(w, http.StatusUnprocessableEntity, "key has already been taken")
+		return
+	}
+	s.policySetParameters[p.ID] = updated
+
+	writeResource(w, http.StatusOK, redactedPolicySetParameter(updated))
+}
+
+func (s *Server) deletePolicySetParameter(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policySetParameter(w, r)
+	if !ok {
+		return
+	}
+	delete(s.policySetParameters, p.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// policy returns the policy of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) policy(w http.ResponseWriter, r *http.Request) (*tfc.Policy, bool) {
+	p, ok := s.policies[r.PathValue("policy")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return p, true
+}
+
+// policyNameTaken reports whether an organization has another policy with a given name.
+// The caller must hold the lock.
+func (s *Server) policyNameTaken(organization, id, name string) bool {
+	for _, p := range s.policies {
+		if p.ID != id && p.Organization.Name == organization && p.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validEnforcementLevel reports whether a given enforcement level is supported by a given policy framework.
+func validEnforcementLevel(kind tfc.PolicyKind, level tfc.EnforcementLevel) bool {
+	switch kind {
+	case tfc.OPA:
+		return level == tfc.EnforcementAdvisory || level == tfc.EnforcementMandatory
+	default:
+		return level == tfc.EnforcementAdvisory || level == tfc.EnforcementSoft || level == tfc.EnforcementHard
+	}
+}
+
+// withPolicySetCount returns a copy of a given policy with the number of policy sets it belongs to.
+// The caller must hold the lock.
+func (s *Server) withPolicySetCount(p *tfc.Policy) *tfc.Policy {
+	c := copyOf(p)
+	c.PolicySetCount = 0
+	for _, ps := range s.policySets {
+		if slices.ContainsFunc(ps.Policies, func(i *tfc.Policy) bool { return i.ID == p.ID }) {
+			c.PolicySetCount++
+		}
+	}
+
+	return c
+}
+
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query().Get("search[name]")
+	items := []*tfc.Policy{}
+	for _, p := range s.policies {
+		if p.Organization.Name == org && strings.Contains(p.Name, q) {
+			items = append(items, s.withPolicySetCount(p))
+		}
+	}
+
+	writeList(w, r, items, func(p *tfc.Policy) string { return p.ID })
+}
+
+func (s *Server) createPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	p := &tfc.Policy{}
+	if _, ok := readResource(w, r, p); !ok {
+		return
+	}
+	if p.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.policyNameTaken(org, "", p.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	if p.Kind == "" {
+		p.Kind = tfc.Sentinel
+	}
+	if p.Kind == tfc.OPA && (p.Query == nil || *p.Query == "") {
+		writeError(w, http.StatusUnprocessableEntity, "query is required for OPA policies")
+		return
+	}
+	if !validEnforcementLevel(p.Kind, p.EnforcementLevel) {
+		writeError(w, http.StatusUnprocessableEntity, "enforcement level is not supported by the policy kind")
+		return
+	}
+	p.ID = s.newID("pol")
+	p.Organization = &tfc.Organization{Name: org}
+	p.Enforce = nil
+	p.UpdatedAt = now()
+	s.policies[p.ID] = p
+
+	writeResource(w, http.StatusCreated, s.withPolicySetCount(p))
+}
+
+func (s *Server) readPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policy(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, s.withPolicySetCount(p))
+}
+
+func (s *Server) updatePolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policy(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(p)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if !validEnforcementLevel(p.Kind, updated.EnforcementLevel) {
+		writeError(w, http.StatusUnprocessableEntity, "enforcement level is not supported by the policy kind")
+		return
+	}
+	updated.ID = p.ID
+	updated.Name = p.Name
+	updated.Kind = p.Kind
+	updated.Enforce = nil
+	updated.UpdatedAt = now()
+	s.policies[p.ID] = updated
+
+	writeResource(w, http.StatusOK, s.withPolicySetCount(updated))
+}
+
+func (s *Server) deletePolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policy(w, r)
+	if !ok {
+		return
+	}
+	delete(s.policies, p.ID)
+	delete(s.policyContents, p.ID)
+	for _, ps := range s.policySets {
+		ps.Policies = slices.DeleteFunc(ps.Policies, func(i *tfc.Policy) bool {
+			return i.ID == p.ID
+		})
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) uploadPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policy(w, r)
+	if !ok {
+		return
+	}
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.policyContents[p.ID] = b
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) downloadPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policy(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(s.policyContents[p.ID])
+}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerProjects(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/projects", s.createProject)
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/projects", s.listProjects)
+	mux.HandleFunc("GET "+basePath+"/projects/{project}", s.readProject)
+	mux.HandleFunc("PATCH "+basePath+"/projects/{project}", s.updateProject)
+	mux.HandleFunc("DELETE "+basePath+"/projects/{project}", s.deleteProject)
+}
+
+// Project returns a copy of the project with a given ID or nil if it does not exist.
+func (s *Server) Project(id string) *tfc.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.projects[id])
+}
+
+// project returns the project of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) project(w http.ResponseWriter, r *http.Request) (*tfc.Project, bool) {
+	p, ok := s.projects[r.PathValue("project")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return p, true
+}
+
+// projectNameTaken reports whether another project in an organization has a given name.
+// The caller must hold the lock.
+func (s *Server) projectNameTaken(organization, id, name string) bool {
+	for _, p := range s.projects {
+		if p.ID != id && p.Organization.Name == organization && p.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	p := &tfc.Project{}
+	if _, ok := readResource(w, r, p); !ok {
+		return
+	}
+	if p.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.projectNameTaken(org, "", p.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	p.ID = s.newID("prj")
+	p.Organization = &tfc.Organization{Name: org}
+	s.projects[p.ID] = p
+
+	writeResource(w, http.StatusCreated, p)
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var names []string
+	if v := q.Get("filter[names]"); v != "" {
+		names = strings.Split(v, ",")
+	}
+	query := q.Get("q")
+	items := values(s.projects, func(p *tfc.Project) bool {
+		return p.Organization.Name == org &&
+			(names == nil || slices.Contains(names, p.Name)) &&
+			strings.Contains(p.Name, query)
+	})
+
+	writeList(w, r, items, func(p *tfc.Project) string { return p.ID })
+}
+
+func (s *Server) readProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.project(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, p)
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.project(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(p)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if s.projectNameTaken(p.Organization.Name, p.ID, updated.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	updated.ID = p.ID
+	s.projects[p.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.project(w, r)
+	if !ok {
+		return
+	}
+	if org := s.organizations[p.Organization.Name]; org != nil && org.DefaultProject.ID == p.ID {
+		writeError(w, http.StatusUnprocessableEntity, "the default project cannot be deleted")
+		return
+	}
+	for _, ws := range s.workspaces {
+		if ws.Project != nil && ws.Project.ID == p.ID {
+			writeError(w, http.StatusUnprocessableEntity, "project still contains workspaces")
+			return
+		}
+	}
+	delete(s.projects, p.ID)
+	for id, a := range s.teamProjectAccess {
+		if a.Project.ID == p.ID {
+			delete(s.teamProjectAccess, id)
+		}
+	}
+	for _, vs := range s.variableSets {
+		vs.Projects = slices.DeleteFunc(vs.Projects, func(prj *tfc.Project) bool {
+			return prj.ID == p.ID
+		})
+	}
+	for _, ps := range s.policySets {
+		ps.Projects = slices.DeleteFunc(ps.Projects, func(prj *tfc.Project) bool {
+			return prj.ID == p.ID
+		})
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
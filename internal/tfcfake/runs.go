@@ -0,0 +1,452 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+// finalRunStatuses are the run statuses in which runs do not progress anymore.
+var finalRunStatuses = []tfc.RunStatus{
+	tfc.RunApplied,
+	tfc.RunCanceled,
+	tfc.RunDiscarded,
+	tfc.RunErrored,
+	tfc.RunPlannedAndFinished,
+	tfc.RunPlannedAndSaved,
+	tfc.RunPolicySoftFailed,
+}
+
+// confirmableRunStatuses are the run statuses in which runs can be applied.
+var confirmableRunStatuses = []tfc.RunStatus{
+	tfc.RunPlanned,
+	tfc.RunPolicyChecked,
+	tfc.RunPolicyOverride,
+	tfc.RunCostEstimated,
+}
+
+// discardableRunStatuses are the run statuses in which runs can be discarded.
+var discardableRunStatuses = append([]tfc.RunStatus{tfc.RunPending}, confirmableRunStatuses...)
+
+// cancelableRunStatuses are the run statuses in which runs can be canceled.
+var cancelableRunStatuses = []tfc.RunStatus{
+	tfc.RunPending,
+	tfc.RunPlanQueued,
+	tfc.RunPlanning,
+	tfc.RunApplyQueued,
+	tfc.RunApplying,
+}
+
+func (s *Server) registerRuns(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+basePath+"/runs", s.createRun)
+	mux.HandleFunc("GET "+basePath+"/runs/{run}", s.readRun)
+	mux.HandleFunc("POST "+basePath+"/runs/{run}/actions/apply", s.runAction(tfc.RunApplying, confirmableRunStatuses...))
+	mux.HandleFunc("POST "+basePath+"/runs/{run}/actions/discard", s.runAction(tfc.RunDiscarded, discardableRunStatuses...))
+	mux.HandleFunc("POST "+basePath+"/runs/{run}/actions/cancel", s.runAction(tfc.RunCanceled, cancelableRunStatuses...))
+	mux.HandleFunc("POST "+basePath+"/runs/{run}/actions/force-cancel", s.runAction(tfc.RunCanceled, cancelableRunStatuses...))
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/runs", s.listWorkspaceRuns)
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/runs", s.listOrganizationRuns)
+	mux.HandleFunc("GET "+basePath+"/plans/{plan}", s.readPlan)
+	mux.HandleFunc("GET "+basePath+"/plans/{plan}/json-output", s.readPlanJSONOutput)
+
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/configuration-versions", s.createConfigurationVersion)
+	mux.HandleFunc("GET "+basePath+"/configuration-versions/{cv}", s.readConfigurationVersion)
+	mux.HandleFunc("PUT "+uploadPath+"{cv}", s.uploadConfigurationVersion)
+
+	mux.HandleFunc("GET "+basePath+"/state-versions/{sv}/outputs", s.listStateVersionOutputs)
+}
+
+// Run returns a copy of the run with a given ID or nil if it does not exist.
+func (s *Server) Run(id string) *tfc.Run {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.runs[id])
+}
+
+// Runs returns copies of all runs of a given workspace.
+func (s *Server) Runs(workspaceID string) []*tfc.Run {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.runs, func(run *tfc.Run) bool {
+		return run.Workspace.ID == workspaceID
+	})
+}
+
+// SetRunStatus sets the status of the run with a given ID. Runs never progress on their own.
+func (s *Server) SetRunStatus(id string, status tfc.RunStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runs[id]
+	if !ok {
+		return fmt.Errorf("run %s not found", id)
+	}
+	run.Status = status
+	run.Actions = runActions(status)
+
+	return nil
+}
+
+// SetRunCreatedAt sets the creation time of the run with a given ID.
+func (s *Server) SetRunCreatedAt(id string, t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runs[id]
+	if !ok {
+		return fmt.Errorf("run %s not found", id)
+	}
+	run.CreatedAt = t
+
+	return nil
+}
+
+// RunActionComment returns the comment of the latest action taken on the run with a given ID.
+func (s *Server) RunActionComment(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.runActionComments[id]
+}
+
+// runActions returns the actions that are available to runs in a given status.
+func runActions(status tfc.RunStatus) *tfc.RunActions {
+	return &tfc.RunActions{
+		IsCancelable:      slices.Contains(cancelableRunStatuses, status),
+		IsConfirmable:     slices.Contains(confirmableRunStatuses, status),
+		IsDiscardable:     slices.Contains(discardableRunStatuses, status),
+		IsForceCancelable: slices.Contains(cancelableRunStatuses, status),
+	}
+}
+
+// SetPlan sets the plan of the run with a given ID. The plan ID is kept.
+func (s *Server) SetPlan(runID string, plan *tfc.Plan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runs[runID]
+	if !ok {
+		return fmt.Errorf("run %s not found", runID)
+	}
+	p := copyOf(plan)
+	p.ID = run.Plan.ID
+	s.plans[p.ID] = p
+
+	return nil
+}
+
+// SetPlanJSONOutput sets the JSON execution plan of the run with a given ID.
+// The JSON execution plan of a run is not found until it is set.
+func (s *Server) SetPlanJSONOutput(runID string, output []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runs[runID]
+	if !ok {
+		return fmt.Errorf("run %s not found", runID)
+	}
+	s.planJSONOutputs[run.Plan.ID] = slices.Clone(output)
+
+	return nil
+}
+
+// SetOutputs creates a new current state version of the workspace with a given ID with given outputs.
+func (s *Server) SetOutputs(workspaceID string, outputs []*tfc.StateVersionOutput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspaces[workspaceID]
+	if !ok {
+		return fmt.Errorf("workspace %s not found", workspaceID)
+	}
+	id := s.newID("sv")
+	items := make([]*tfc.StateVersionOutput, 0, len(outputs))
+	for _, o := range outputs {
+		c := copyOf(o)
+		c.ID = s.newID("wsout")
+		items = append(items, c)
+	}
+	s.stateVersionOutputs[id] = items
+	ws.CurrentStateVersion = &tfc.StateVersion{ID: id}
+	ws.UpdatedAt = now()
+
+	return nil
+}
+
+func (s *Server) createRun(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run := &tfc.Run{}
+	if _, ok := readResource(w, r, run); !ok {
+		return
+	}
+	if run.Workspace == nil {
+		writeError(w, http.StatusUnprocessableEntity, "workspace is required")
+		return
+	}
+	ws, ok := s.workspaces[run.Workspace.ID]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	run.ID = s.newID("run")
+	run.CreatedAt = now()
+	run.Status = tfc.RunPending
+	run.Source = tfc.RunSourceAPI
+	run.HasChanges = true
+	run.Actions = runActions(run.Status)
+	run.Workspace = &tfc.Workspace{ID: ws.ID}
+	if run.TerraformVersion == "" {
+		run.TerraformVersion = ws.TerraformVersion
+	}
+	if run.ConfigurationVersion == nil {
+		if ws.CurrentConfigurationVersion == nil {
+			cv := &tfc.ConfigurationVersion{
+				ID:     s.newID("cv"),
+				Source: tfc.ConfigurationSourceAPI,
+				Status: tfc.ConfigurationUploaded,
+			}
+			s.configurationVersions[cv.ID] = cv
+			ws.CurrentConfigurationVersion = &tfc.ConfigurationVersion{ID: cv.ID}
+		}
+		run.ConfigurationVersion = &tfc.ConfigurationVersion{ID: ws.CurrentConfigurationVersion.ID}
+	}
+	run.Plan = &tfc.Plan{ID: strings.Replace(run.ID, "run-", "plan-", 1)}
+	s.plans[run.Plan.ID] = &tfc.Plan{ID: run.Plan.ID, Status: tfc.PlanPending}
+	run.Apply = &tfc.Apply{ID: strings.Replace(run.ID, "run-", "apply-", 1)}
+	s.runs[run.ID] = run
+	if !run.PlanOnly {
+		ws.CurrentRun = &tfc.Run{ID: run.ID}
+	}
+
+	writeResource(w, http.StatusCreated, run)
+}
+
+func (s *Server) readRun(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runs[r.PathValue("run")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, run)
+}
+
+func (s *Server) readPlan(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	plan, ok := s.plans[r.PathValue("plan")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, plan)
+}
+
+func (s *Server) readPlanJSONOutput(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output, ok := s.planJSONOutputs[r.PathValue("plan")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(output)
+}
+
+// runAction returns a handler of a run action that moves runs in given statuses to a new status.
+func (s *Server) runAction(to tfc.RunStatus, from ...tfc.RunStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		run, ok := s.runs[r.PathValue("run")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		if !slices.Contains(from, run.Status) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("transition from %s to %s is not allowed", run.Status, to))
+			return
+		}
+		options := struct {
+			Comment string `json:"comment"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&options); err == nil {
+			s.runActionComments[run.ID] = options.Comment
+		}
+		run.Status = to
+		run.Actions = runActions(to)
+
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// runStatusGroup returns the status group of a given run status.
+func runStatusGroup(status tfc.RunStatus) string {
+	if slices.Contains(finalRunStatuses, status) {
+		return "final"
+	}
+
+	return "non_final"
+}
+
+// filterRuns returns runs that match the status filters of a given request.
+func filterRuns(r *http.Request, runs []*tfc.Run) []*tfc.Run {
+	q := r.URL.Query()
+
+	return slices.DeleteFunc(runs, func(run *tfc.Run) bool {
+		if g := q.Get("filter[status_group]"); g != "" && runStatusGroup(run.Status) != g {
+			return true
+		}
+		if st := q.Get("filter[status]"); st != "" && !slices.Contains(strings.Split(st, ","), string(run.Status)) {
+			return true
+		}
+		return false
+	})
+}
+
+func (s *Server) listWorkspaceRuns(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	items := values(s.runs, func(run *tfc.Run) bool {
+		return run.Workspace.ID == ws.ID
+	})
+
+	writeList(w, r, filterRuns(r, items), func(run *tfc.Run) string { return run.ID })
+}
+
+func (s *Server) listOrganizationRuns(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	var pools []string
+	if v := r.URL.Query().Get("filter[agent_pool_names]"); v != "" {
+		pools = strings.Split(v, ",")
+	}
+	items := values(s.runs, func(run *tfc.Run) bool {
+		ws := s.workspaces[run.Workspace.ID]
+		if ws == nil || ws.Organization.Name != org {
+			return false
+		}
+		if pools == nil {
+			return true
+		}
+		if ws.AgentPool == nil {
+			return false
+		}
+		pool := s.agentPools[ws.AgentPool.ID]
+		return pool != nil && slices.Contains(pools, pool.Name)
+	})
+
+	writeList(w, r, filterRuns(r, items), func(run *tfc.Run) string { return run.ID })
+}
+
+func (s *Server) createConfigurationVersion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	options := &tfc.ConfigurationVersionCreateOptions{}
+	if _, ok := readResource(w, r, options); !ok {
+		return
+	}
+	cv := &tfc.ConfigurationVersion{
+		ID:     s.newID("cv"),
+		Source: tfc.ConfigurationSourceAPI,
+		Status: tfc.ConfigurationPending,
+	}
+	if options.AutoQueueRuns != nil {
+		cv.AutoQueueRuns = *options.AutoQueueRuns
+	}
+	if options.Speculative != nil {
+		cv.Speculative = *options.Speculative
+	}
+	cv.UploadURL = s.URL() + uploadPath + cv.ID
+	s.configurationVersions[cv.ID] = cv
+	if !cv.Speculative {
+		ws.CurrentConfigurationVersion = &tfc.ConfigurationVersion{ID: cv.ID}
+	}
+
+	writeResource(w, http.StatusCreated, cv)
+}
+
+func (s *Server) readConfigurationVersion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cv, ok := s.configurationVersions[r.PathValue("cv")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, cv)
+}
+
+func (s *Server) uploadConfigurationVersion(w http.ResponseWriter, r *http.Request) {
+	_, _ = io.Copy(io.Discard, r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cv, ok := s.configurationVersions[r.PathValue("cv")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	cv.Status = tfc.ConfigurationUploaded
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listStateVersionOutputs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	outputs, ok := s.stateVersionOutputs[r.PathValue("sv")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	items := make([]*tfc.StateVersionOutput, 0, len(outputs))
+	for _, o := range outputs {
+		items = append(items, copyOf(o))
+	}
+
+	writeList(w, r, items, func(o *tfc.StateVersionOutput) string { return o.ID })
+}
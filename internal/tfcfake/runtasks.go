@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerRunTasks(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/tasks", s.listRunTasks)
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/tasks", s.createRunTask)
+	mux.HandleFunc("GET "+basePath+"/tasks/{task}", s.readRunTask)
+	mux.HandleFunc("PATCH "+basePath+"/tasks/{task}", s.updateRunTask)
+	mux.HandleFunc("DELETE "+basePath+"/tasks/{task}", s.deleteRunTask)
+}
+
+// AddRunTask creates a new run task in a given organization and returns it.
+func (s *Server) AddRunTask(organization, name, url string) *tfc.RunTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt := &tfc.RunTask{
+		ID:           s.newID("task"),
+		Name:         name,
+		URL:          url,
+		Category:     "task",
+		Enabled:      true,
+		Organization: &tfc.Organization{Name: organization},
+	}
+	s.runTasks[rt.ID] = rt
+
+	return copyOf(rt)
+}
+
+// RunTask returns a copy of the run task with a given ID or nil if it does not exist.
+func (s *Server) RunTask(id string) *tfc.RunTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.runTasks[id])
+}
+
+// RunTaskHMACKey returns the HMAC key of the run task with a given ID.
+// The API never returns the key, so this is the only way to observe it.
+func (s *Server) RunTaskHMACKey(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.runTaskHMACKeys[id]
+}
+
+// runTask returns the run task of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) runTask(w http.ResponseWriter, r *http.Request) (*tfc.RunTask, bool) {
+	rt, ok := s.runTasks[r.PathValue("task")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return rt, true
+}
+
+// runTaskNameTaken reports whether an organization has another run task with a given name.
+// The caller must hold the lock.
+func (s *Server) runTaskNameTaken(organization, id, name string) bool {
+	for _, rt := range s.runTasks {
+		if rt.ID != id && rt.Organization.Name == organization && rt.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validRunTask reports whether a given run task has the mandatory attributes and writes an error otherwise.
+func validRunTask(w http.ResponseWriter, rt *tfc.RunTask) bool {
+	switch {
+	case rt.Name == "":
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return false
+	case rt.URL == "":
+		writeError(w, http.StatusUnprocessableEntity, "url is required")
+		return false
+	case rt.Category != "task":
+		writeError(w, http.StatusUnprocessableEntity, "category must be task")
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) listRunTasks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	items := values(s.runTasks, func(rt *tfc.RunTask) bool {
+		return rt.Organization.Name == org
+	})
+
+	writeList(w, r, items, func(rt *tfc.RunTask) string { return rt.ID })
+}
+
+func (s *Server) createRunTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	rt := &tfc.RunTask{}
+	attrs, ok := readResource(w, r, rt)
+	if !ok {
+		return
+	}
+	if !validRunTask(w, rt) {
+		return
+	}
+	if s.runTaskNameTaken(org, "", rt.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	if _, ok := attrs["enabled"]; !ok {
+		rt.Enabled = true
+	}
+	rt.ID = s.newID("task")
+	rt.Organization = &tfc.Organization{Name: org}
+	if rt.HMACKey != nil {
+		s.runTaskHMACKeys[rt.ID] = *rt.HMACKey
+		rt.HMACKey = nil
+	}
+	s.runTasks[rt.ID] = rt
+
+	writeResource(w, http.StatusCreated, rt)
+}
+
+func (s *Server) readRunTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt, ok := s.runTask(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, rt)
+}
+
+func (s *Server) updateRunTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt, ok := s.runTask(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(rt)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if !validRunTask(w, updated) {
+		return
+	}
+	if s.runTaskNameTaken(rt.Organization.Name, rt.ID, updated.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	updated.ID = rt.ID
+	updated.Organization = rt.Organization
+	if updated.HMACKey != nil {
+		s.runTaskHMACKeys[rt.ID] = *updated.HMACKey
+		updated.HMACKey = nil
+	}
+	s.runTasks[rt.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteRunTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt, ok := s.runTask(w, r)
+	if !ok {
+		return
+	}
+	delete(s.runTasks, rt.ID)
+	delete(s.runTaskHMACKeys, rt.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,377 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfcfake implements an in-memory fake of the HCP Terraform API for tests.
+//
+// The fake implements the subset of the JSON:API endpoints the controllers use.
+// It speaks the same wire format as HCP Terraform and therefore works with an unmodified go-tfe client.
+// Relations between stored resources always point to stubs that only contain the ID of the related resource,
+// so the stored resources never form reference cycles.
+//
+// The controller tests run the fake together with the controller-runtime fake client.
+// It is not wired into the envtest suite under test/e2e, which runs against a real HCP Terraform organization.
+package tfcfake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/jsonapi"
+)
+
+const (
+	basePath        = "/api/v2"
+	uploadPath      = "/_archivist/"
+	defaultPageSize = 20
+	// DefaultTerraformVersion is the Terraform version of workspaces that do not set one.
+	DefaultTerraformVersion = "1.9.0"
+)
+
+// Server is an in-memory fake of the HCP Terraform API.
+// All methods are safe for concurrent use.
+type Server struct {
+	// Token is the API token the server accepts.
+	// Any non-empty token is accepted when empty.
+	Token string
+
+	server *httptest.Server
+
+	mu     sync.Mutex
+	nextID int
+	// failures contains the responses to return instead of handling requests.
+	failures map[string][]int
+
+	organizations         map[string]*tfc.Organization
+	workspaces            map[string]*tfc.Workspace
+	variables             map[string]*tfc.Variable
+	runs                  map[string]*tfc.Run
+	plans                 map[string]*tfc.Plan
+	planJSONOutputs       map[string][]byte
+	configurationVersions map[string]*tfc.ConfigurationVersion
+	stateVersionOutputs   map[string][]*tfc.StateVersionOutput
+	agentPools            map[string]*tfc.AgentPool
+	agentTokens           map[string]*tfc.AgentToken
+	// agentTokenPools maps an agent token ID to its agent pool ID.
+	agentTokenPools   map[string]string
+	projects          map[string]*tfc.Project
+	teams             map[string]*tfc.Team
+	teamOrganizations map[string]string
+	teamAccess        map[string]*tfc.TeamAccess
+	teamProjectAccess map[string]*tfc.TeamProjectAccess
+	notifications     map[string]*tfc.NotificationConfiguration
+	// notificationWorkspaces maps a notification configuration ID to its workspace ID.
+	notificationWorkspaces map[string]string
+	variableSets           map[string]*tfc.VariableSet
+	variableSetVariables   map[string]*tfc.VariableSetVariable
+	users                  map[string]*tfc.User
+	// organizationMemberships contains organization memberships, the relations of teams to them are stored in the teams.
+	organizationMemberships map[string]*tfc.OrganizationMembership
+	policySets              map[string]*tfc.PolicySet
+	policies                map[string]*tfc.Policy
+	// policyContents maps a policy ID to the uploaded policy code.
+	policyContents      map[string][]byte
+	policySetParameters map[string]*tfc.PolicySetParameter
+	runTasks            map[string]*tfc.RunTask
+	// runTaskHMACKeys maps a run task ID to its HMAC key, the API never returns the key.
+	runTaskHMACKeys map[string]string
+	// runActionComments maps a run ID to the comment of the latest action taken on it.
+	runActionComments map[string]string
+	// workspaceLockHolders maps a workspace ID to the holder of its lock.
+	workspaceLockHolders map[string]string
+}
+
+// NewServer starts a new fake HCP Terraform API server. The caller must call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		failures:                make(map[string][]int),
+		organizations:           make(map[string]*tfc.Organization),
+		workspaces:              make(map[string]*tfc.Workspace),
+		variables:               make(map[string]*tfc.Variable),
+		runs:                    make(map[string]*tfc.Run),
+		runActionComments:       make(map[string]string),
+		workspaceLockHolders:    make(map[string]string),
+		plans:                   make(map[string]*tfc.Plan),
+		planJSONOutputs:         make(map[string][]byte),
+		configurationVersions:   make(map[string]*tfc.ConfigurationVersion),
+		stateVersionOutputs:     make(map[string][]*tfc.StateVersionOutput),
+		agentPools:              make(map[string]*tfc.AgentPool),
+		agentTokens:             make(map[string]*tfc.AgentToken),
+		agentTokenPools:         make(map[string]string),
+		projects:                make(map[string]*tfc.Project),
+		teams:                   make(map[string]*tfc.Team),
+		teamOrganizations:       make(map[string]string),
+		teamAccess:              make(map[string]*tfc.TeamAccess),
+		teamProjectAccess:       make(map[string]*tfc.TeamProjectAccess),
+		notifications:           make(map[string]*tfc.NotificationConfiguration),
+		notificationWorkspaces:  make(map[string]string),
+		variableSets:            make(map[string]*tfc.VariableSet),
+		variableSetVariables:    make(map[string]*tfc.VariableSetVariable),
+		users:                   make(map[string]*tfc.User),
+		organizationMemberships: make(map[string]*tfc.OrganizationMembership),
+		policySets:              make(map[string]*tfc.PolicySet),
+		policies:                make(map[string]*tfc.Policy),
+		policyContents:          make(map[string][]byte),
+		policySetParameters:     make(map[string]*tfc.PolicySetParameter),
+		runTasks:                make(map[string]*tfc.RunTask),
+		runTaskHMACKeys:         make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+basePath+"/ping", s.ping)
+	s.registerOrganizations(mux)
+	s.registerWorkspaces(mux)
+	s.registerVariables(mux)
+	s.registerRuns(mux)
+	s.registerAgents(mux)
+	s.registerProjects(mux)
+	s.registerTeams(mux)
+	s.registerNotifications(mux)
+	s.registerVariableSets(mux)
+	s.registerPolicySets(mux)
+	s.registerRunTasks(mux)
+
+	s.server = httptest.NewServer(s.handler(mux))
+
+	return s
+}
+
+// URL returns the address of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Client returns a new go-tfe client configured to use the server.
+func (s *Server) Client() (*tfc.Client, error) {
+	token := s.Token
+	if token == "" {
+		token = "fake"
+	}
+
+	return tfc.NewClient(&tfc.Config{
+		Address:           s.URL(),
+		Token:             token,
+		RetryServerErrors: false,
+	})
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// FailNext makes the server respond with the given HTTP status codes to the next requests
+// that match a given method and path, for example, `GET /api/v2/workspaces/ws-1`.
+func (s *Server) FailNext(method, path string, statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := method + " " + path
+	s.failures[key] = append(s.failures[key], statuses...)
+}
+
+// handler wraps the API handlers with authentication and failure injection.
+func (s *Server) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+
+		s.mu.Lock()
+		key := r.Method + " " + r.URL.Path
+		var status int
+		if f := s.failures[key]; len(f) > 0 {
+			status, s.failures[key] = f[0], f[1:]
+		}
+		s.mu.Unlock()
+		if status != 0 {
+			if status == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			writeError(w, status, http.StatusText(status))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	// The configuration version upload URL does not require authentication.
+	if strings.HasPrefix(r.URL.Path, uploadPath) {
+		return true
+	}
+	auth := r.Header.Get("Authorization")
+	if s.Token == "" {
+		return auth != "" && auth != "Bearer "
+	}
+
+	return auth == "Bearer "+s.Token
+}
+
+func (s *Server) ping(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("TFP-API-Version", "2.6")
+	w.Header().Set("TFP-AppName", "HCP Terraform")
+	w.Header().Set("X-RateLimit-Limit", "30")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newID returns a new unique resource ID with a given prefix. The caller must hold the lock.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+
+	return fmt.Sprintf("%s-%016d", prefix, s.nextID)
+}
+
+// now returns the current time truncated to seconds, the precision of the API timestamps.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// copyOf returns a shallow copy of a given resource or nil.
+func copyOf[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	c := *v
+
+	return &c
+}
+
+// readResource decodes a JSON:API request body into a given resource and returns the attributes of the request.
+// Only the attributes and relations present in the request are set, so decoding into a copy of a stored
+// resource applies a partial update.
+func readResource(w http.ResponseWriter, r *http.Request, v any) (map[string]any, bool) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	payload := &jsonapi.OnePayload{}
+	if err := json.Unmarshal(b, payload); err != nil || payload.Data == nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return nil, false
+	}
+	if err := jsonapi.UnmarshalPayload(bytes.NewReader(b), v); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return nil, false
+	}
+
+	return payload.Data.Attributes, true
+}
+
+// readResources decodes a JSON:API request body with a list of resources of a given type.
+func readResources[T any](w http.ResponseWriter, r *http.Request) ([]*T, bool) {
+	items, err := jsonapi.UnmarshalManyPayload(r.Body, reflect.TypeOf(new(T)))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	result := make([]*T, 0, len(items))
+	for _, i := range items {
+		result = append(result, i.(*T))
+	}
+
+	return result, true
+}
+
+// writeResource writes a single JSON:API resource.
+func writeResource(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(status)
+	_ = jsonapi.MarshalPayloadWithoutIncluded(w, v)
+}
+
+// writeList writes a page of JSON:API resources with the pagination details.
+// Items are sorted by ID to keep responses deterministic.
+func writeList[T any](w http.ResponseWriter, r *http.Request, items []*T, id func(*T) string) {
+	slices.SortFunc(items, func(a, b *T) int {
+		switch {
+		case id(a) < id(b):
+			return -1
+		case id(a) > id(b):
+			return 1
+		}
+		return 0
+	})
+
+	pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+	pageNumber = max(pageNumber, 1)
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	totalPages := max((len(items)+pageSize-1)/pageSize, 1)
+	start := min((pageNumber-1)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+
+	p, err := jsonapi.Marshal(items[start:end])
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	payload := p.(*jsonapi.ManyPayload)
+	// Related resources are only included on request, e.g. the users of organization memberships.
+	if r.URL.Query().Get("include") == "" {
+		payload.Included = nil
+	}
+	pagination := map[string]int{
+		"current-page": pageNumber,
+		"prev-page":    0,
+		"next-page":    0,
+		"total-count":  len(items),
+		"total-pages":  totalPages,
+	}
+	if pageNumber > 1 {
+		pagination["prev-page"] = pageNumber - 1
+	}
+	if pageNumber < totalPages {
+		pagination["next-page"] = pageNumber + 1
+	}
+	payload.Meta = &jsonapi.Meta{"pagination": pagination}
+
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+// writeError writes a JSON:API error.
+func writeError(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(status)
+	_ = jsonapi.MarshalErrors(w, []*jsonapi.ErrorObject{
+		{
+			Status: strconv.Itoa(status),
+			Title:  http.StatusText(status),
+			Detail: detail,
+		},
+	})
+}
+
+// writeNotFound writes a JSON:API not found error.
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "not found")
+}
+
+// values returns the values of a map that match a given filter.
+func values[T any](m map[string]*T, filter func(*T) bool) []*T {
+	result := []*T{}
+	for _, v := range m {
+		if filter == nil || filter(v) {
+			result = append(result, copyOf(v))
+		}
+	}
+
+	return result
+}
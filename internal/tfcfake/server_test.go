@@ -0,0 +1,642 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfc "github.com/hashicorp/go-tfe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const organization = "kubernetes-operator"
+
+func newTestServer(t *testing.T) (*Server, *tfc.Client) {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+	s.AddOrganization(organization)
+	c, err := s.Client()
+	require.NoError(t, err)
+
+	return s, c
+}
+
+func TestServerAuthentication(t *testing.T) {
+	t.Parallel()
+
+	s := NewServer()
+	defer s.Close()
+	s.Token = "this"
+	s.AddOrganization(organization)
+
+	c, err := tfc.NewClient(&tfc.Config{Address: s.URL(), Token: "that"})
+	require.NoError(t, err)
+	_, err = c.Organizations.Read(context.TODO(), organization)
+	assert.ErrorIs(t, err, tfc.ErrUnauthorized)
+
+	c, err = s.Client()
+	require.NoError(t, err)
+	assert.True(t, c.IsCloud())
+	_, err = c.Organizations.Read(context.TODO(), organization)
+	assert.NoError(t, err)
+}
+
+func TestServerFailNext(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	s.FailNext(http.MethodGet, basePath+"/organizations/"+organization, http.StatusInternalServerError)
+
+	_, err := c.Organizations.Read(context.TODO(), organization)
+	assert.Error(t, err)
+	_, err = c.Organizations.Read(context.TODO(), organization)
+	assert.NoError(t, err)
+}
+
+func TestServerWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	_, c := newTestServer(t)
+	ctx := context.TODO()
+
+	org, err := c.Organizations.Read(ctx, organization)
+	require.NoError(t, err)
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{
+		Name:      tfc.String("this"),
+		AutoApply: tfc.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "remote", ws.ExecutionMode)
+	assert.Equal(t, DefaultTerraformVersion, ws.TerraformVersion)
+	assert.Equal(t, org.DefaultProject.ID, ws.Project.ID)
+
+	_, err = c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	assert.Error(t, err)
+
+	pool, err := c.AgentPools.Create(ctx, organization, tfc.AgentPoolCreateOptions{Name: tfc.String("pool")})
+	require.NoError(t, err)
+	ws, err = c.Workspaces.UpdateByID(ctx, ws.ID, tfc.WorkspaceUpdateOptions{
+		Description:   tfc.String("description"),
+		ExecutionMode: tfc.String("agent"),
+		AgentPoolID:   tfc.String(pool.ID),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "description", ws.Description)
+	assert.True(t, ws.AutoApply)
+	assert.Equal(t, pool.ID, ws.AgentPool.ID)
+
+	require.NoError(t, c.Workspaces.AddTags(ctx, ws.ID, tfc.WorkspaceAddTagsOptions{
+		Tags: []*tfc.Tag{{Name: "b"}, {Name: "a"}},
+	}))
+	require.NoError(t, c.Workspaces.RemoveTags(ctx, ws.ID, tfc.WorkspaceRemoveTagsOptions{
+		Tags: []*tfc.Tag{{Name: "b"}},
+	}))
+	ws, err = c.Workspaces.Read(ctx, organization, "this")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, ws.TagNames)
+
+	for i := range 25 {
+		_, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String(fmt.Sprintf("that-%d", i))})
+		require.NoError(t, err)
+	}
+	list, err := c.Workspaces.List(ctx, organization, &tfc.WorkspaceListOptions{Search: "that"})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, defaultPageSize)
+	assert.Equal(t, 25, list.TotalCount)
+	assert.Equal(t, 2, list.NextPage)
+
+	require.NoError(t, c.Workspaces.SafeDeleteByID(ctx, ws.ID))
+	_, err = c.Workspaces.ReadByID(ctx, ws.ID)
+	assert.ErrorIs(t, err, tfc.ErrResourceNotFound)
+}
+
+func TestServerWorkspaceLock(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+
+	ws, err = c.Workspaces.Lock(ctx, ws.ID, tfc.WorkspaceLockOptions{Reason: tfc.String("freeze")})
+	require.NoError(t, err)
+	assert.True(t, ws.Locked)
+	_, err = c.Workspaces.Lock(ctx, ws.ID, tfc.WorkspaceLockOptions{})
+	assert.ErrorIs(t, err, tfc.ErrWorkspaceLocked)
+
+	ws, err = c.Workspaces.Unlock(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.False(t, ws.Locked)
+	_, err = c.Workspaces.Unlock(ctx, ws.ID)
+	assert.ErrorIs(t, err, tfc.ErrWorkspaceNotLocked)
+
+	require.NoError(t, s.SetWorkspaceLock(ws.ID, "User alice"))
+	_, err = c.Workspaces.Unlock(ctx, ws.ID)
+	assert.ErrorIs(t, err, tfc.ErrWorkspaceLockedByUser)
+	ws, err = c.Workspaces.ForceUnlock(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.False(t, ws.Locked)
+}
+
+func TestServerVariables(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+
+	v, err := c.Variables.Create(ctx, ws.ID, tfc.VariableCreateOptions{
+		Key:       tfc.String("secret"),
+		Value:     tfc.String("value"),
+		Category:  tfc.Category(tfc.CategoryEnv),
+		Sensitive: tfc.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, v.Value)
+	assert.Equal(t, "value", s.Variables(ws.ID)[0].Value)
+
+	_, err = c.Variables.Create(ctx, ws.ID, tfc.VariableCreateOptions{
+		Key:      tfc.String("secret"),
+		Category: tfc.Category(tfc.CategoryEnv),
+	})
+	assert.Error(t, err)
+
+	updated, err := c.Variables.Update(ctx, ws.ID, v.ID, tfc.VariableUpdateOptions{Value: tfc.String("new")})
+	require.NoError(t, err)
+	assert.NotEqual(t, v.VersionID, updated.VersionID)
+	assert.True(t, updated.Sensitive)
+
+	list, err := c.Variables.List(ctx, ws.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	require.NoError(t, c.Variables.Delete(ctx, ws.ID, v.ID))
+	assert.Empty(t, s.Variables(ws.ID))
+}
+
+func TestServerRuns(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	pool, err := c.AgentPools.Create(ctx, organization, tfc.AgentPoolCreateOptions{Name: tfc.String("pool")})
+	require.NoError(t, err)
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{
+		Name:          tfc.String("this"),
+		ExecutionMode: tfc.String("agent"),
+		AgentPoolID:   tfc.String(pool.ID),
+	})
+	require.NoError(t, err)
+
+	run, err := c.Runs.Create(ctx, tfc.RunCreateOptions{Workspace: ws})
+	require.NoError(t, err)
+	assert.Equal(t, tfc.RunPending, run.Status)
+	assert.NotNil(t, run.ConfigurationVersion)
+	ws, err = c.Workspaces.ReadByID(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, run.ID, ws.CurrentRun.ID)
+
+	list, err := c.Runs.ListForOrganization(ctx, organization, &tfc.RunListForOrganizationOptions{
+		AgentPoolNames: pool.Name,
+		StatusGroup:    "non_final",
+	})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	plan, err := c.Plans.Read(ctx, run.Plan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tfc.PlanPending, plan.Status)
+	require.NoError(t, s.SetPlan(run.ID, &tfc.Plan{Status: tfc.PlanFinished, ResourceAdditions: 2}))
+	plan, err = c.Plans.Read(ctx, run.Plan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, run.Plan.ID, plan.ID)
+	assert.Equal(t, 2, plan.ResourceAdditions)
+	_, err = c.Plans.ReadJSONOutput(ctx, run.Plan.ID)
+	assert.ErrorIs(t, err, tfc.ErrResourceNotFound)
+	require.NoError(t, s.SetPlanJSONOutput(run.ID, []byte(`{"output_changes":{}}`)))
+	output, err := c.Plans.ReadJSONOutput(ctx, run.Plan.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"output_changes":{}}`, string(output))
+
+	require.NoError(t, s.SetRunStatus(run.ID, tfc.RunPlanned))
+	require.NoError(t, c.Runs.Apply(ctx, run.ID, tfc.RunApplyOptions{}))
+	require.NoError(t, s.SetRunStatus(run.ID, tfc.RunApplied))
+	run, err = c.Runs.Read(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tfc.RunApplied, run.Status)
+	assert.Error(t, c.Runs.Cancel(ctx, run.ID, tfc.RunCancelOptions{}))
+
+	list, err = c.Runs.ListForOrganization(ctx, organization, &tfc.RunListForOrganizationOptions{StatusGroup: "non_final"})
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+}
+
+func TestServerConfigurationVersions(t *testing.T) {
+	t.Parallel()
+
+	_, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	cv, err := c.ConfigurationVersions.Create(ctx, ws.ID, tfc.ConfigurationVersionCreateOptions{AutoQueueRuns: tfc.Bool(false)})
+	require.NoError(t, err)
+	assert.Equal(t, tfc.ConfigurationPending, cv.Status)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`output "this" { value = 1 }`), 0o600))
+	require.NoError(t, c.ConfigurationVersions.Upload(ctx, cv.UploadURL, dir))
+
+	cv, err = c.ConfigurationVersions.Read(ctx, cv.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tfc.ConfigurationUploaded, cv.Status)
+}
+
+func TestServerOutputs(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	require.NoError(t, s.SetOutputs(ws.ID, []*tfc.StateVersionOutput{{Name: "this", Type: "string", Value: "value"}}))
+
+	ws, err = c.Workspaces.ReadByID(ctx, ws.ID)
+	require.NoError(t, err)
+	outputs, err := c.StateVersions.ListOutputs(ctx, ws.CurrentStateVersion.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, outputs.Items, 1)
+	assert.Equal(t, "value", outputs.Items[0].Value)
+}
+
+func TestServerAgentPools(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	pool, err := c.AgentPools.Create(ctx, organization, tfc.AgentPoolCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	pool, err = c.AgentPools.Update(ctx, pool.ID, tfc.AgentPoolUpdateOptions{Name: tfc.String("that")})
+	require.NoError(t, err)
+	assert.Equal(t, "that", pool.Name)
+
+	token, err := c.AgentTokens.Create(ctx, pool.ID, tfc.AgentTokenCreateOptions{Description: tfc.String("token")})
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.Token)
+	tokens, err := c.AgentTokens.List(ctx, pool.ID)
+	require.NoError(t, err)
+	require.Len(t, tokens.Items, 1)
+	assert.Equal(t, "token", tokens.Items[0].Description)
+	assert.Empty(t, tokens.Items[0].Token)
+
+	require.NoError(t, c.AgentTokens.Delete(ctx, token.ID))
+	assert.Empty(t, s.AgentTokens(pool.ID))
+	require.NoError(t, c.AgentPools.Delete(ctx, pool.ID))
+	assert.Nil(t, s.AgentPool(pool.ID))
+}
+
+func TestServerProjects(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+	team := s.AddTeam(organization, "team")
+
+	p, err := c.Projects.Create(ctx, organization, tfc.ProjectCreateOptions{Name: "this"})
+	require.NoError(t, err)
+	p, err = c.Projects.Update(ctx, p.ID, tfc.ProjectUpdateOptions{Name: tfc.String("that")})
+	require.NoError(t, err)
+	assert.Equal(t, "that", p.Name)
+	list, err := c.Projects.List(ctx, organization, &tfc.ProjectListOptions{Name: "that"})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	teams, err := c.Teams.List(ctx, organization, &tfc.TeamListOptions{Names: []string{"team"}})
+	require.NoError(t, err)
+	require.Len(t, teams.Items, 1)
+	assert.Equal(t, team.ID, teams.Items[0].ID)
+
+	a, err := c.TeamProjectAccess.Add(ctx, tfc.TeamProjectAccessAddOptions{
+		Access:  tfc.TeamProjectAccessRead,
+		Team:    &tfc.Team{ID: team.ID},
+		Project: &tfc.Project{ID: p.ID},
+	})
+	require.NoError(t, err)
+	a, err = c.TeamProjectAccess.Update(ctx, a.ID, tfc.TeamProjectAccessUpdateOptions{
+		Access: tfc.ProjectAccess(tfc.TeamProjectAccessAdmin),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tfc.TeamProjectAccessAdmin, a.Access)
+	assert.Equal(t, team.ID, a.Team.ID)
+	accesses, err := c.TeamProjectAccess.List(ctx, tfc.TeamProjectAccessListOptions{ProjectID: p.ID})
+	require.NoError(t, err)
+	assert.Len(t, accesses.Items, 1)
+
+	require.NoError(t, c.Projects.Delete(ctx, p.ID))
+	assert.Nil(t, s.Project(p.ID))
+	assert.Empty(t, s.TeamProjectAccess(p.ID))
+}
+
+func TestServerTeamAccess(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+	team := s.AddTeam(organization, "team")
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	a, err := c.TeamAccess.Add(ctx, tfc.TeamAccessAddOptions{
+		Access:    tfc.Access(tfc.AccessRead),
+		Team:      &tfc.Team{ID: team.ID},
+		Workspace: &tfc.Workspace{ID: ws.ID},
+	})
+	require.NoError(t, err)
+	_, err = c.TeamAccess.Update(ctx, a.ID, tfc.TeamAccessUpdateOptions{Access: tfc.Access(tfc.AccessWrite)})
+	require.NoError(t, err)
+	list, err := c.TeamAccess.List(ctx, &tfc.TeamAccessListOptions{WorkspaceID: ws.ID})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, tfc.AccessWrite, list.Items[0].Access)
+
+	require.NoError(t, c.TeamAccess.Remove(ctx, a.ID))
+	assert.Empty(t, s.TeamAccess(ws.ID))
+}
+
+func TestServerTeams(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+	member := s.AddOrganizationMember(organization, "this", "this@example.com")
+
+	team, err := c.Teams.Create(ctx, organization, tfc.TeamCreateOptions{
+		Name:               tfc.String("this"),
+		OrganizationAccess: &tfc.OrganizationAccessOptions{ManageWorkspaces: tfc.Bool(true)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", team.Visibility)
+	assert.True(t, team.OrganizationAccess.ManageWorkspaces)
+	_, err = c.Teams.Create(ctx, organization, tfc.TeamCreateOptions{Name: tfc.String("this")})
+	require.Error(t, err)
+	team, err = c.Teams.Update(ctx, team.ID, tfc.TeamUpdateOptions{Visibility: tfc.String("organization")})
+	require.NoError(t, err)
+	assert.Equal(t, "organization", team.Visibility)
+	assert.True(t, team.OrganizationAccess.ManageWorkspaces)
+
+	memberships, err := c.OrganizationMemberships.List(ctx, organization, &tfc.OrganizationMembershipListOptions{
+		Include: []tfc.OrgMembershipIncludeOpt{tfc.OrgMembershipUser},
+		Query:   "this",
+	})
+	require.NoError(t, err)
+	require.Len(t, memberships.Items, 1)
+	assert.Equal(t, "this", memberships.Items[0].User.Username)
+	invited, err := c.OrganizationMemberships.Create(ctx, organization, tfc.OrganizationMembershipCreateOptions{
+		Email: tfc.String("that@example.com"),
+		Teams: []*tfc.Team{{ID: team.ID}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tfc.OrganizationMembershipInvited, invited.Status)
+
+	require.NoError(t, c.TeamMembers.Add(ctx, team.ID, tfc.TeamMemberAddOptions{OrganizationMembershipIDs: []string{member.ID}}))
+	members, err := c.TeamMembers.ListOrganizationMemberships(ctx, team.ID)
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+	require.NoError(t, c.TeamMembers.Remove(ctx, team.ID, tfc.TeamMemberRemoveOptions{OrganizationMembershipIDs: []string{invited.ID}}))
+	members, err = c.TeamMembers.ListOrganizationMemberships(ctx, team.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, member.ID, members[0].ID)
+
+	require.NoError(t, c.Teams.Delete(ctx, team.ID))
+	assert.Nil(t, s.Team(team.ID))
+}
+
+func TestServerNotifications(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	n, err := c.NotificationConfigurations.Create(ctx, ws.ID, tfc.NotificationConfigurationCreateOptions{
+		Name:            tfc.String("this"),
+		DestinationType: tfc.NotificationDestination(tfc.NotificationDestinationTypeGeneric),
+		Enabled:         tfc.Bool(true),
+		URL:             tfc.String("https://example.com"),
+	})
+	require.NoError(t, err)
+	_, err = c.NotificationConfigurations.Update(ctx, n.ID, tfc.NotificationConfigurationUpdateOptions{Enabled: tfc.Bool(false)})
+	require.NoError(t, err)
+	list, err := c.NotificationConfigurations.List(ctx, ws.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.False(t, list.Items[0].Enabled)
+	assert.Equal(t, "https://example.com", list.Items[0].URL)
+
+	require.NoError(t, c.NotificationConfigurations.Delete(ctx, n.ID))
+	assert.Empty(t, s.Notifications(ws.ID))
+}
+
+func TestServerVariableSets(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	p, err := c.Projects.Create(ctx, organization, tfc.ProjectCreateOptions{Name: "this"})
+	require.NoError(t, err)
+
+	vs, err := c.VariableSets.Create(ctx, organization, &tfc.VariableSetCreateOptions{Name: tfc.String("this"), Global: tfc.Bool(false)})
+	require.NoError(t, err)
+	_, err = c.VariableSets.Create(ctx, organization, &tfc.VariableSetCreateOptions{Name: tfc.String("this"), Global: tfc.Bool(false)})
+	require.Error(t, err)
+	vs, err = c.VariableSets.Update(ctx, vs.ID, &tfc.VariableSetUpdateOptions{Priority: tfc.Bool(true)})
+	require.NoError(t, err)
+	assert.True(t, vs.Priority)
+	list, err := c.VariableSets.List(ctx, organization, &tfc.VariableSetListOptions{Query: "th"})
+	require.NoError(t, err)
+	assert.Len(t, list.Items, 1)
+
+	v, err := c.VariableSetVariables.Create(ctx, vs.ID, &tfc.VariableSetVariableCreateOptions{
+		Key:       tfc.String("secret"),
+		Value:     tfc.String("value"),
+		Category:  tfc.Category(tfc.CategoryEnv),
+		Sensitive: tfc.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, v.Value)
+	vars := s.VariableSetVariables(vs.ID)
+	require.Len(t, vars, 1)
+	assert.Equal(t, "value", vars[0].Value)
+	_, err = c.VariableSetVariables.Update(ctx, vs.ID, v.ID, &tfc.VariableSetVariableUpdateOptions{Sensitive: tfc.Bool(false)})
+	require.Error(t, err)
+
+	require.NoError(t, c.VariableSets.ApplyToWorkspaces(ctx, vs.ID, &tfc.VariableSetApplyToWorkspacesOptions{
+		Workspaces: []*tfc.Workspace{{ID: ws.ID}},
+	}))
+	require.NoError(t, c.VariableSets.ApplyToProjects(ctx, vs.ID, tfc.VariableSetApplyToProjectsOptions{
+		Projects: []*tfc.Project{{ID: p.ID}},
+	}))
+	vs, err = c.VariableSets.Read(ctx, vs.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, vs.Workspaces, 1)
+	assert.Len(t, vs.Projects, 1)
+	assert.Len(t, vs.Variables, 1)
+
+	require.NoError(t, c.VariableSets.RemoveFromProjects(ctx, vs.ID, tfc.VariableSetRemoveFromProjectsOptions{
+		Projects: []*tfc.Project{{ID: p.ID}},
+	}))
+	require.NoError(t, c.Workspaces.DeleteByID(ctx, ws.ID))
+	vs = s.VariableSet(vs.ID)
+	assert.Empty(t, vs.Workspaces)
+	assert.Empty(t, vs.Projects)
+
+	require.NoError(t, c.VariableSets.Delete(ctx, vs.ID))
+	assert.Nil(t, s.VariableSet(vs.ID))
+	assert.Empty(t, s.VariableSetVariables(vs.ID))
+}
+
+func TestServerPolicySets(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	ws, err := c.Workspaces.Create(ctx, organization, tfc.WorkspaceCreateOptions{Name: tfc.String("this")})
+	require.NoError(t, err)
+	p, err := c.Projects.Create(ctx, organization, tfc.ProjectCreateOptions{Name: "this"})
+	require.NoError(t, err)
+
+	policy, err := c.Policies.Create(ctx, organization, tfc.PolicyCreateOptions{
+		Name:             tfc.String("this"),
+		Kind:             tfc.Sentinel,
+		EnforcementLevel: tfc.EnforcementMode(tfc.EnforcementHard),
+	})
+	require.NoError(t, err)
+	_, err = c.Policies.Create(ctx, organization, tfc.PolicyCreateOptions{
+		Name:             tfc.String("that"),
+		Kind:             tfc.Sentinel,
+		EnforcementLevel: tfc.EnforcementMode(tfc.EnforcementMandatory),
+	})
+	require.Error(t, err)
+	require.NoError(t, c.Policies.Upload(ctx, policy.ID, []byte("main = true")))
+	content, err := c.Policies.Download(ctx, policy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "main = true", string(content))
+
+	ps, err := c.PolicySets.Create(ctx, organization, tfc.PolicySetCreateOptions{
+		Name:     tfc.String("this"),
+		Kind:     tfc.Sentinel,
+		Policies: []*tfc.Policy{{ID: policy.ID}},
+	})
+	require.NoError(t, err)
+	_, err = c.PolicySets.Create(ctx, organization, tfc.PolicySetCreateOptions{Name: tfc.String("this")})
+	require.Error(t, err)
+	_, err = c.PolicySets.Update(ctx, ps.ID, tfc.PolicySetUpdateOptions{Overridable: tfc.Bool(true)})
+	require.Error(t, err)
+	policy, err = c.Policies.Read(ctx, policy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, policy.PolicySetCount)
+
+	param, err := c.PolicySetParameters.Create(ctx, ps.ID, tfc.PolicySetParameterCreateOptions{
+		Key:       tfc.String("secret"),
+		Value:     tfc.String("value"),
+		Category:  tfc.Category(tfc.CategoryPolicySet),
+		Sensitive: tfc.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, param.Value)
+	params := s.PolicySetParameters(ps.ID)
+	require.Len(t, params, 1)
+	assert.Equal(t, "value", params[0].Value)
+
+	require.NoError(t, c.PolicySets.AddWorkspaces(ctx, ps.ID, tfc.PolicySetAddWorkspacesOptions{
+		Workspaces: []*tfc.Workspace{{ID: ws.ID}},
+	}))
+	require.NoError(t, c.PolicySets.AddProjects(ctx, ps.ID, tfc.PolicySetAddProjectsOptions{
+		Projects: []*tfc.Project{{ID: p.ID}},
+	}))
+	ps, err = c.PolicySets.Read(ctx, ps.ID)
+	require.NoError(t, err)
+	assert.Len(t, ps.Workspaces, 1)
+	assert.Len(t, ps.Projects, 1)
+	assert.Len(t, ps.Policies, 1)
+
+	require.NoError(t, c.Policies.Delete(ctx, policy.ID))
+	require.NoError(t, c.PolicySets.RemoveProjects(ctx, ps.ID, tfc.PolicySetRemoveProjectsOptions{
+		Projects: []*tfc.Project{{ID: p.ID}},
+	}))
+	require.NoError(t, c.Workspaces.DeleteByID(ctx, ws.ID))
+	ps = s.PolicySet(ps.ID)
+	assert.Empty(t, ps.Policies)
+	assert.Empty(t, ps.Workspaces)
+	assert.Empty(t, ps.Projects)
+
+	require.NoError(t, c.PolicySets.Delete(ctx, ps.ID))
+	assert.Nil(t, s.PolicySet(ps.ID))
+	assert.Empty(t, s.PolicySetParameters(ps.ID))
+}
+
+func TestServerRunTasks(t *testing.T) {
+	t.Parallel()
+
+	s, c := newTestServer(t)
+	ctx := context.TODO()
+
+	rt, err := c.RunTasks.Create(ctx, organization, tfc.RunTaskCreateOptions{
+		Name:     "this",
+		URL:      "https://example.com/this",
+		Category: "task",
+		HMACKey:  tfc.String("secret"),
+	})
+	require.NoError(t, err)
+	assert.True(t, rt.Enabled)
+	assert.Nil(t, rt.HMACKey)
+	assert.Equal(t, "secret", s.RunTaskHMACKey(rt.ID))
+
+	_, err = c.RunTasks.Create(ctx, organization, tfc.RunTaskCreateOptions{
+		Name:     "this",
+		URL:      "https://example.com/that",
+		Category: "task",
+	})
+	require.Error(t, err)
+
+	rt, err = c.RunTasks.Update(ctx, rt.ID, tfc.RunTaskUpdateOptions{
+		Description: tfc.String("this"),
+		Enabled:     tfc.Bool(false),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "this", rt.Description)
+	assert.False(t, rt.Enabled)
+	assert.Equal(t, "https://example.com/this", rt.URL)
+	assert.Equal(t, "secret", s.RunTaskHMACKey(rt.ID))
+
+	l, err := c.RunTasks.List(ctx, organization, nil)
+	require.NoError(t, err)
+	require.Len(t, l.Items, 1)
+	assert.Equal(t, rt.ID, l.Items[0].ID)
+
+	require.NoError(t, c.RunTasks.Delete(ctx, rt.ID))
+	_, err = c.RunTasks.Read(ctx, rt.ID)
+	assert.ErrorIs(t, err, tfc.ErrResourceNotFound)
+}
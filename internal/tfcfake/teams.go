@@ -0,0 +1,456 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerTeams(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/teams", s.listTeams)
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/teams", s.createTeam)
+	mux.HandleFunc("GET "+basePath+"/teams/{team}", s.readTeam)
+	mux.HandleFunc("PATCH "+basePath+"/teams/{team}", s.updateTeam)
+	mux.HandleFunc("DELETE "+basePath+"/teams/{team}", s.deleteTeam)
+	mux.HandleFunc("POST "+basePath+"/teams/{team}/relationships/organization-memberships", s.addTeamMembers)
+	mux.HandleFunc("DELETE "+basePath+"/teams/{team}/relationships/organization-memberships", s.removeTeamMembers)
+
+	mux.HandleFunc("GET "+basePath+"/team-workspaces", s.listTeamAccess)
+	mux.HandleFunc("POST "+basePath+"/team-workspaces", s.addTeamAccess)
+	mux.HandleFunc("GET "+basePath+"/team-workspaces/{access}", s.readTeamAccess)
+	mux.HandleFunc("PATCH "+basePath+"/team-workspaces/{access}", s.updateTeamAccess)
+	mux.HandleFunc("DELETE "+basePath+"/team-workspaces/{access}", s.removeTeamAccess)
+
+	mux.HandleFunc("GET "+basePath+"/team-projects", s.listTeamProjectAccess)
+	mux.HandleFunc("POST "+basePath+"/team-projects", s.addTeamProjectAccess)
+	mux.HandleFunc("GET "+basePath+"/team-projects/{access}", s.readTeamProjectAccess)
+	mux.HandleFunc("PATCH "+basePath+"/team-projects/{access}", s.updateTeamProjectAccess)
+	mux.HandleFunc("DELETE "+basePath+"/team-projects/{access}", s.removeTeamProjectAccess)
+}
+
+// AddTeam creates a new team in a given organization and returns it.
+func (s *Server) AddTeam(organization, name string) *tfc.Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	team := &tfc.Team{
+		ID:         s.newID("team"),
+		Name:       name,
+		Visibility: "organization",
+	}
+	s.teams[team.ID] = team
+	s.teamOrganizations[team.ID] = organization
+
+	return copyOf(team)
+}
+
+// Team returns a copy of the team with a given ID or nil if it does not exist.
+func (s *Server) Team(id string) *tfc.Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.teams[id])
+}
+
+// TeamAccess returns copies of all team accesses of the workspace with a given ID.
+func (s *Server) TeamAccess(workspaceID string) []*tfc.TeamAccess {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.teamAccess, func(a *tfc.TeamAccess) bool {
+		return a.Workspace.ID == workspaceID
+	})
+}
+
+// TeamProjectAccess returns copies of all team accesses of the project with a given ID.
+func (s *Server) TeamProjectAccess(projectID string) []*tfc.TeamProjectAccess {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.teamProjectAccess, func(a *tfc.TeamProjectAccess) bool {
+		return a.Project.ID == projectID
+	})
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var names []string
+	for _, v := range q["filter[names]"] {
+		names = append(names, strings.Split(v, ",")...)
+	}
+	query := q.Get("q")
+	items := values(s.teams, func(t *tfc.Team) bool {
+		return s.teamOrganizations[t.ID] == org &&
+			(names == nil || slices.Contains(names, t.Name)) &&
+			strings.Contains(t.Name, query)
+	})
+
+	writeList(w, r, items, func(t *tfc.Team) string { return t.ID })
+}
+
+// teamExists reports whether the team a given relation points to exists and writes an error otherwise.
+// The caller must hold the lock.
+func (s *Server) teamExists(w http.ResponseWriter, team *tfc.Team) bool {
+	if team == nil || s.teams[team.ID] == nil {
+		writeError(w, http.StatusUnprocessableEntity, "team not found")
+		return false
+	}
+
+	return true
+}
+
+// team returns the team of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) team(w http.ResponseWriter, r *http.Request) (*tfc.Team, bool) {
+	t, ok := s.teams[r.PathValue("team")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return t, true
+}
+
+// teamNameTaken reports whether an organization has another team with a given name.
+// The caller must hold the lock.
+func (s *Server) teamNameTaken(organization, id, name string) bool {
+	for _, t := range s.teams {
+		if t.ID != id && s.teamOrganizations[t.ID] == organization && t.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	t := &tfc.Team{}
+	if _, ok := readResource(w, r, t); !ok {
+		return
+	}
+	if t.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.teamNameTaken(org, "", t.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	t.ID = s.newID("team")
+	if t.Visibility == "" {
+		t.Visibility = "secret"
+	}
+	if t.OrganizationAccess == nil {
+		t.OrganizationAccess = &tfc.OrganizationAccess{}
+	}
+	t.Users = nil
+	t.OrganizationMemberships = nil
+	s.teams[t.ID] = t
+	s.teamOrganizations[t.ID] = org
+
+	writeResource(w, http.StatusCreated, t)
+}
+
+func (s *Server) readTeam(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.team(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, t)
+}
+
+func (s *Server) updateTeam(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.team(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(t)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if s.teamNameTaken(s.teamOrganizations[t.ID], t.ID, updated.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	updated.ID = t.ID
+	updated.OrganizationMemberships = t.OrganizationMemberships
+	s.teams[t.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) deleteTeam(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.team(w, r)
+	if !ok {
+		return
+	}
+	delete(s.teams, t.ID)
+	delete(s.teamOrganizations, t.ID)
+	for id, a := range s.teamAccess {
+		if a.Team.ID == t.ID {
+			delete(s.teamAccess, id)
+		}
+	}
+	for id, a := range s.teamProjectAccess {
+		if a.Team.ID == t.ID {
+			delete(s.teamProjectAccess, id)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addTeamMembers(w http.ResponseWriter, r *http.Request) {
+	s.updateTeamMembers(w, r, true)
+}
+
+func (s *Server) removeTeamMembers(w http.ResponseWriter, r *http.Request) {
+	s.updateTeamMembers(w, r, false)
+}
+
+// updateTeamMembers adds or removes the organization memberships of a request to or from a team.
+func (s *Server) updateTeamMembers(w http.ResponseWriter, r *http.Request, add bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.team(w, r)
+	if !ok {
+		return
+	}
+	items, ok := readResources[tfc.OrganizationMembership](w, r)
+	if !ok {
+		return
+	}
+	members := slices.Clone(t.OrganizationMemberships)
+	for _, i := range items {
+		m, ok := s.organizationMemberships[i.ID]
+		if !ok || m.Organization.Name != s.teamOrganizations[t.ID] {
+			writeNotFound(w)
+			return
+		}
+		members = slices.DeleteFunc(members, func(c *tfc.OrganizationMembership) bool {
+			return c.ID == i.ID
+		})
+		if add {
+			members = append(members, &tfc.OrganizationMembership{ID: i.ID})
+		}
+	}
+	t.OrganizationMemberships = members
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listTeamAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspaceID := r.URL.Query().Get("filter[workspace][id]")
+	if _, ok := s.workspaces[workspaceID]; !ok {
+		writeNotFound(w)
+		return
+	}
+	items := values(s.teamAccess, func(a *tfc.TeamAccess) bool {
+		return a.Workspace.ID == workspaceID
+	})
+
+	writeList(w, r, items, func(a *tfc.TeamAccess) string { return a.ID })
+}
+
+func (s *Server) addTeamAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := &tfc.TeamAccess{}
+	if _, ok := readResource(w, r, a); !ok {
+		return
+	}
+	if !s.teamExists(w, a.Team) {
+		return
+	}
+	if a.Workspace == nil || s.workspaces[a.Workspace.ID] == nil {
+		writeNotFound(w)
+		return
+	}
+	for _, o := range s.teamAccess {
+		if o.Team.ID == a.Team.ID && o.Workspace.ID == a.Workspace.ID {
+			writeError(w, http.StatusUnprocessableEntity, "team already has access to the workspace")
+			return
+		}
+	}
+	a.ID = s.newID("tws")
+	a.Team = &tfc.Team{ID: a.Team.ID}
+	a.Workspace = &tfc.Workspace{ID: a.Workspace.ID}
+	s.teamAccess[a.ID] = a
+
+	writeResource(w, http.StatusCreated, a)
+}
+
+func (s *Server) readTeamAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.teamAccess[r.PathValue("access")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, a)
+}
+
+func (s *Server) updateTeamAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.teamAccess[r.PathValue("access")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	updated := copyOf(a)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	updated.ID = a.ID
+	updated.Team = &tfc.Team{ID: a.Team.ID}
+	updated.Workspace = &tfc.Workspace{ID: a.Workspace.ID}
+	s.teamAccess[a.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) removeTeamAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.teamAccess[r.PathValue("access")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.teamAccess, a.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	projectID := r.URL.Query().Get("filter[project][id]")
+	if _, ok := s.projects[projectID]; !ok {
+		writeNotFound(w)
+		return
+	}
+	items := values(s.teamProjectAccess, func(a *tfc.TeamProjectAccess) bool {
+		return a.Project.ID == projectID
+	})
+
+	writeList(w, r, items, func(a *tfc.TeamProjectAccess) string { return a.ID })
+}
+
+func (s *Server) addTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a := &tfc.TeamProjectAccess{}
+	if _, ok := readResource(w, r, a); !ok {
+		return
+	}
+	if !s.teamExists(w, a.Team) {
+		return
+	}
+	if a.Project == nil || s.projects[a.Project.ID] == nil {
+		writeNotFound(w)
+		return
+	}
+	for _, o := range s.teamProjectAccess {
+		if o.Team.ID == a.Team.ID && o.Project.ID == a.Project.ID {
+			writeError(w, http.StatusUnprocessableEntity, "team already has access to the project")
+			return
+		}
+	}
+	a.ID = s.newID("tprj")
+	a.Team = &tfc.Team{ID: a.Team.ID}
+	a.Project = &tfc.Project{ID: a.Project.ID}
+	s.teamProjectAccess[a.ID] = a
+
+	writeResource(w, http.StatusCreated, a)
+}
+
+func (s *Server) readTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.teamProjectAccess[r.PathValue("access")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, a)
+}
+
+func (s *Server) updateTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.teamProjectAccess[r.PathValue("access")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	updated := copyOf(a)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	updated.ID = a.ID
+	updated.Team = &tfc.Team{ID: a.Team.ID}
+	updated.Project = &tfc.Project{ID: a.Project.ID}
+	s.teamProjectAccess[a.ID] = updated
+
+	writeResource(w, http.StatusOK, updated)
+}
+
+func (s *Server) removeTeamProjectAccess(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.teamProjectAccess[r.PathValue("access")]
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	delete(s.teamProjectAccess, a.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
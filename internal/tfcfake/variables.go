@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerVariables(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/vars", s.listVariables)
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/vars", s.createVariable)
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/vars/{variable}", s.readVariable)
+	mux.HandleFunc("PATCH "+basePath+"/workspaces/{id}/vars/{variable}", s.updateVariable)
+	mux.HandleFunc("DELETE "+basePath+"/workspaces/{id}/vars/{variable}", s.deleteVariable)
+}
+
+// Variables returns copies of all variables of a given workspace. Values of sensitive variables are included.
+func (s *Server) Variables(workspaceID string) []*tfc.Variable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.variables, func(v *tfc.Variable) bool {
+		return v.Workspace.ID == workspaceID
+	})
+}
+
+// variable returns the variable of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) variable(w http.ResponseWriter, r *http.Request) (*tfc.Variable, bool) {
+	v, ok := s.variables[r.PathValue("variable")]
+	if !ok || v.Workspace.ID != r.PathValue("id") {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return v, true
+}
+
+// variableConflicts reports whether a workspace has another variable with the same key and category.
+// The caller must hold the lock.
+func (s *Server) variableConflicts(v *tfc.Variable) bool {
+	for _, o := range s.variables {
+		if o.ID != v.ID && o.Workspace.ID == v.Workspace.ID && o.Key == v.Key && o.Category == v.Category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redacted returns a copy of a given variable as the API returns it, without the value of a sensitive variable.
+func redacted(v *tfc.Variable) *tfc.Variable {
+	c := copyOf(v)
+	if c.Sensitive {
+		c.Value = ""
+	}
+
+	return c
+}
+
+func (s *Server) listVariables(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	items := []*tfc.Variable{}
+	for _, v := range s.variables {
+		if v.Workspace.ID == ws.ID {
+			items = append(items, redacted(v))
+		}
+	}
+
+	writeList(w, r, items, func(v *tfc.Variable) string { return v.ID })
+}
+
+func (s *Server) createVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	v := &tfc.Variable{}
+	if _, ok := readResource(w, r, v); !ok {
+		return
+	}
+	if v.Key == "" || v.Category == "" {
+		writeError(w, http.StatusUnprocessableEntity, "key and category are required")
+		return
+	}
+	v.ID = s.newID("var")
+	v.VersionID = s.newID("ver")
+	v.Workspace = &tfc.Workspace{ID: ws.ID}
+	if s.variableConflicts(v) {
+		writeError(w, http.StatusUnprocessableEntity, "key has already been taken")
+		return
+	}
+	s.variables[v.ID] = v
+
+	writeResource(w, http.StatusCreated, redacted(v))
+}
+
+func (s *Server) readVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.variable(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, redacted(v))
+}
+
+func (s *Server) updateVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.variable(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(v)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if v.Sensitive && !updated.Sensitive {
+		writeError(w, http.StatusUnprocessableEntity, "sensitive variables cannot be made non-sensitive")
+		return
+	}
+	updated.ID = v.ID
+	updated.Workspace = &tfc.Workspace{ID: v.Workspace.ID}
+	if s.variableConflicts(updated) {
+		writeError(w, http.StatusUnprocessableEntity, "key has already been taken")
+		return
+	}
+	updated.VersionID = s.newID("ver")
+	s.variables[v.ID] = updated
+
+	writeResource(w, http.StatusOK, redacted(updated))
+}
+
+func (s *Server) deleteVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.variable(w, r)
+	if !ok {
+		return
+	}
+	delete(s.variables, v.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
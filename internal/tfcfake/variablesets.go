@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerVariableSets(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/varsets", s.listVariableSets)
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/varsets", s.createVariableSet)
+	mux.HandleFunc("GET "+basePath+"/varsets/{varset}", s.readVariableSet)
+	mux.HandleFunc("PATCH "+basePath+"/varsets/{varset}", s.updateVariableSet)
+	mux.HandleFunc("DELETE "+basePath+"/varsets/{varset}", s.deleteVariableSet)
+	mux.HandleFunc("POST "+basePath+"/varsets/{varset}/relationships/workspaces", s.applyVariableSetToWorkspaces)
+	mux.HandleFunc("DELETE "+basePath+"/varsets/{varset}/relationships/workspaces", s.removeVariableSetFromWorkspaces)
+	mux.HandleFunc("POST "+basePath+"/varsets/{varset}/relationships/projects", s.applyVariableSetToProjects)
+	mux.HandleFunc("DELETE "+basePath+"/varsets/{varset}/relationships/projects", s.removeVariableSetFromProjects)
+
+	mux.HandleFunc("GET "+basePath+"/varsets/{varset}/relationships/vars", s.listVariableSetVariables)
+	mux.HandleFunc("POST "+basePath+"/varsets/{varset}/relationships/vars", s.createVariableSetVariable)
+	mux.HandleFunc("GET "+basePath+"/varsets/{varset}/relationships/vars/{variable}", s.readVariableSetVariable)
+	mux.HandleFunc("PATCH "+basePath+"/varsets/{varset}/relationships/vars/{variable}", s.updateVariableSetVariable)
+	mux.HandleFunc("DELETE "+basePath+"/varsets/{varset}/relationships/vars/{variable}", s.deleteVariableSetVariable)
+}
+
+// VariableSet returns a copy of the variable set with a given ID or nil if it does not exist.
+func (s *Server) VariableSet(id string) *tfc.VariableSet {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vs, ok := s.variableSets[id]
+	if !ok {
+		return nil
+	}
+
+	return s.withVariableSetVariables(vs)
+}
+
+// VariableSetVariables returns copies of all variables of the variable set with a given ID.
+// Values of sensitive variables are included.
+func (s *Server) VariableSetVariables(id string) []*tfc.VariableSetVariable {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.variableSetVariables, func(v *tfc.VariableSetVariable) bool {
+		return v.VariableSet.ID == id
+	})
+}
+
+// variableSet returns the variable set of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) variableSet(w http.ResponseWriter, r *http.Request) (*tfc.VariableSet, bool) {
+	vs, ok := s.variableSets[r.PathValue("varset")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return vs, true
+}
+
+// withVariableSetVariables returns a copy of a given variable set with the variables relation as the API returns it.
+// The caller must hold the lock.
+func (s *Server) withVariableSetVariables(vs *tfc.VariableSet) *tfc.VariableSet {
+	c := copyOf(vs)
+	c.Variables = nil
+	for _, v := range s.variableSetVariables {
+		if v.VariableSet.ID == vs.ID {
+			c.Variables = append(c.Variables, &tfc.VariableSetVariable{ID: v.ID})
+		}
+	}
+	slices.SortFunc(c.Variables, func(a, b *tfc.VariableSetVariable) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return c
+}
+
+// variableSetNameTaken reports whether an organization has another variable set with a given name.
+// The caller must hold the lock.
+func (s *Server) variableSetNameTaken(organization, id, name string) bool {
+	for _, vs := range s.variableSets {
+		if vs.ID != id && vs.Organization.Name == organization && vs.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) listVariableSets(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query().Get("q")
+	items := []*tfc.VariableSet{}
+	for _, vs := range s.variableSets {
+		if vs.Organization.Name == org && strings.Contains(vs.Name, q) {
+			items = append(items, s.withVariableSetVariables(vs))
+		}
+	}
+
+	writeList(w, r, items, func(vs *tfc.VariableSet) string { return vs.ID })
+}
+
+func (s *Server) createVariableSet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	vs := &tfc.VariableSet{}
+	if _, ok := readResource(w, r, vs); !ok {
+		return
+	}
+	if vs.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.variableSetNameTaken(org, "", vs.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	vs.ID = s.newID("varset")
+	vs.Organization = &tfc.Organization{Name: org}
+	vs.Parent = nil
+	vs.Workspaces = nil
+	vs.Projects = nil
+	vs.Stacks = nil
+	vs.Variables = nil
+	s.variableSets[vs.ID] = vs
+
+	writeResource(w, http.StatusCreated, s.withVariableSetVariables(vs))
+}
+
+func (s *Server) readVariableSet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vs, ok := s.variableSet(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, s.withVariableSetVariables(vs))
+}
+
+func (s *Server) updateVariableSet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vs, ok := s.variableSet(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(vs)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if s.variableSetNameTaken(vs.Organization.Name, vs.ID, updated.Name) {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+	updated.ID = vs.ID
+	// Global variable sets apply to all workspaces and therefore drop their workspaces and projects.
+	if updated.Global {
+		updated.Workspaces = nil
+		updated.Projects = nil
+	}
+	s.variableSets[vs.ID] = updated
+
+	writeResource(w, http.StatusOK, s.withVariableSetVariables(updated))
+}
+
+func (s *Server) deleteVariableSet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vs, ok := s.variableSet(w, r)
+	if !ok {
+		return
+	}
+	delete(s.variableSets, vs.ID)
+	for id, v := range s.variableSetVariables {
+		if v.VariableSet.ID == vs.ID {
+			delete(s.variableSetVariables, id)
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateVariableSetScope returns a handler that adds or removes the workspaces or projects of a request
+// to or from a variable set.
+func updateVariableSetScope[T any](s *Server, id func(*T) string, scope func(*tfc.VariableSet) *[]*T, exists func(string) bool, add bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		vs, ok := s.variableSet(w, r)
+		if !ok {
+			return
+		}
+		if vs.Global {
+			writeError(w, http.StatusUnprocessableEntity, "global variable sets cannot be applied to workspaces or projects")
+			return
+		}
+		items, ok := readResources[T](w, r)
+		if !ok {
+			return
+		}
+		current := scope(vs)
+		for _, i := range items {
+			if !exists(id(i)) {
+				writeNotFound(w)
+				return
+			}
+			*current = slices.DeleteFunc(*current, func(c *T) bool {
+				return id(c) == id(i)
+			})
+			if add {
+				*current = append(*current, i)
+			}
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) applyVariableSetToWorkspaces(w http.ResponseWriter, r *http.Request) {
+	s.updateVariableSetWorkspaces(true)(w, r)
+}
+
+func (s *Server) removeVariableSetFromWorkspaces(w http.ResponseWriter, r *http.Request) {
+	s.updateVariableSetWorkspaces(false)(w, r)
+}
+
+func (s *Server) updateVariableSetWorkspaces(add bool) http.HandlerFunc {
+	return updateVariableSetScope(s,
+		func(ws *tfc.Workspace) string { return ws.ID },
+		func(vs *tfc.VariableSet) *[]*tfc.Workspace { return &vs.Workspaces },
+		func(id string) bool { _, ok := s.workspaces[id]; return ok },
+		add,
+	)
+}
+
+func (s *Server) applyVariableSetToProjects(w http.ResponseWriter, r *http.Request) {
+	s.updateVariableSetProjects(true)(w, r)
+}
+
+func (s *Server) removeVariableSetFromProjects(w http.ResponseWriter, r *http.Request) {
+	s.updateVariableSetProjects(false)(w, r)
+}
+
+func (s *Server) updateVariableSetProjects(add bool) http.HandlerFunc {
+	return updateVariableSetScope(s,
+		func(p *tfc.Project) string { return p.ID },
+		func(vs *tfc.VariableSet) *[]*tfc.Project { return &vs.Projects },
+		func(id string) bool { _, ok := s.projects[id]; return ok },
+		add,
+	)
+}
+
+// variableSetVariable returns the variable set variable of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) variableSetVariable(w http.ResponseWriter, r *http.Request) (*tfc.VariableSetVariable, bool) {
+	v, ok := s.variableSetVariables[r.PathValue("variable")]
+	if !ok || v.VariableSet.ID != r.PathValue("varset") {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return v, true
+}
+
+// variableSetVariableConflicts reports whether a variable set has another variable with the same key and category.
+// The caller must hold the lock.
+func (s *Server) variableSetVariableConflicts(v *tfc.VariableSetVariable) bool {
+	for _, o := range s.variableSetVariables {
+		if o.ID != v.ID && o.VariableSet.ID == v.VariableSet.ID && o.Key == v.Key && o.Category == v.Category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactedVariableSetVariable returns a copy of a given variable as the API returns it, without the value of a sensitive variable.
+func redactedVariableSetVariable(v *tfc.VariableSetVariable) *tfc.VariableSetVariable {
+	c := copyOf(v)
+	if c.Sensitive {
+		c.Value = ""
+	}
+
+	return c
+}
+
+func (s *Server) listVariableSetVariables(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vs, ok := s.variableSet(w, r)
+	if !ok {
+		return
+	}
+	items := []*tfc.VariableSetVariable{}
+	for _, v := range s.variableSetVariables {
+		if v.VariableSet.ID == vs.ID {
+			items = append(items, redactedVariableSetVariable(v))
+		}
+	}
+
+	writeList(w, r, items, func(v *tfc.VariableSetVariable) string { return v.ID })
+}
+
+func (s *Server) createVariableSetVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vs, ok := s.variableSet(w, r)
+	if !ok {
+		return
+	}
+	v := &tfc.VariableSetVariable{}
+	if _, ok := readResource(w, r, v); !ok {
+		return
+	}
+	if v.Key == "" || v.Category == "" {
+		writeError(w, http.StatusUnprocessableEntity, "key and category are required")
+		return
+	}
+	v.ID = s.newID("var")
+	v.VersionID = s.newID("ver")
+	v.VariableSet = &tfc.VariableSet{ID: vs.ID}
+	if s.variableSetVariableConflicts(v) {
+		writeError(w, http.StatusUnprocessableEntity, "key has already been taken")
+		return
+	}
+	s.variableSetVariables[v.ID] = v
+
+	writeResource(w, http.StatusCreated, redactedVariableSetVariable(v))
+}
+
+func (s *Server) readVariableSetVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.variableSetVariable(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, redactedVariableSetVariable(v))
+}
+
+func (s *Server) updateVariableSetVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.variableSetVariable(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(v)
+	if _, ok := readResource(w, r, updated); !ok {
+		return
+	}
+	if v.Sensitive && !updated.Sensitive {
+		writeError(w, http.StatusUnprocessableEntity, "sensitive variables cannot be made non-sensitive")
+		return
+	}
+	updated.ID = v.ID
+	updated.Category = v.Category
+	updated.VariableSet = &tfc.VariableSet{ID: v.VariableSet.ID}
+	if s.variableSetVariableConflicts(updated) {
+		writeError(w, http.StatusUnprocessableEntity, "key has already been taken")
+		return
+	}
+	updated.VersionID = s.newID("ver")
+	s.variableSetVariables[v.ID] = updated
+
+	writeResource(w, http.StatusOK, redactedVariableSetVariable(updated))
+}
+
+func (s *Server) deleteVariableSetVariable(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.variableSetVariable(w, r)
+	if !ok {
+		return
+	}
+	delete(s.variableSetVariables, v.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
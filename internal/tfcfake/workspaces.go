@@ -0,0 +1,430 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package tfcfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	tfc "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) registerWorkspaces(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+basePath+"/organizations/{organization}/workspaces", s.createWorkspace)
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/workspaces", s.listWorkspaces)
+	mux.HandleFunc("GET "+basePath+"/organizations/{organization}/workspaces/{name}", s.readWorkspaceByName)
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}", s.readWorkspace)
+	mux.HandleFunc("PATCH "+basePath+"/workspaces/{id}", s.updateWorkspace)
+	mux.HandleFunc("DELETE "+basePath+"/workspaces/{id}", s.deleteWorkspace)
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/actions/safe-delete", s.deleteWorkspace)
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/actions/lock", s.lockWorkspace)
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/actions/unlock", s.unlockWorkspace(false))
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/actions/force-unlock", s.unlockWorkspace(true))
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/relationships/tags", s.listWorkspaceTags)
+	mux.HandleFunc("POST "+basePath+"/workspaces/{id}/relationships/tags", s.addWorkspaceTags)
+	mux.HandleFunc("DELETE "+basePath+"/workspaces/{id}/relationships/tags", s.removeWorkspaceTags)
+	// Run triggers and run tasks are not supported, the workspaces never have any.
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/run-triggers", s.listEmpty)
+	mux.HandleFunc("GET "+basePath+"/workspaces/{id}/tasks", s.listEmpty)
+}
+
+// Workspace returns a copy of the workspace with a given ID or nil if it does not exist.
+func (s *Server) Workspace(id string) *tfc.Workspace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return copyOf(s.workspaces[id])
+}
+
+// Workspaces returns copies of all workspaces of a given organization.
+func (s *Server) Workspaces(organization string) []*tfc.Workspace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return values(s.workspaces, func(ws *tfc.Workspace) bool {
+		return ws.Organization.Name == organization
+	})
+}
+
+// SetWorkspaceLock locks the workspace with a given ID on behalf of a given lock holder, for example, `User alice` or `Run run-123`.
+// An empty lock holder unlocks the workspace.
+func (s *Server) SetWorkspaceLock(id, holder string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspaces[id]
+	if !ok {
+		return fmt.Errorf("workspace %s not found", id)
+	}
+	ws.Locked = holder != ""
+	s.workspaceLockHolders[id] = holder
+
+	return nil
+}
+
+// ModifyWorkspace changes the workspace with a given ID outside of the API, for example, to simulate changes in the UI.
+// The update timestamp of the workspace is kept, like for settings that HCP Terraform changes without updating it.
+func (s *Server) ModifyWorkspace(id string, modify func(ws *tfc.Workspace)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspaces[id]
+	if !ok {
+		return fmt.Errorf("workspace %s not found", id)
+	}
+	modify(ws)
+
+	return nil
+}
+
+// workspace returns the workspace of a given request and writes a not found error if it does not exist.
+// The caller must hold the lock.
+func (s *Server) workspace(w http.ResponseWriter, r *http.Request) (*tfc.Workspace, bool) {
+	ws, ok := s.workspaces[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w)
+		return nil, false
+	}
+
+	return ws, true
+}
+
+// workspaceByName returns the workspace with a given name in a given organization or nil.
+// The caller must hold the lock.
+func (s *Server) workspaceByName(organization, name string) *tfc.Workspace {
+	for _, ws := range s.workspaces {
+		if ws.Organization.Name == organization && ws.Name == name {
+			return ws
+		}
+	}
+
+	return nil
+}
+
+// setWorkspaceAgentPool applies the agent pool ID attribute of a workspace create or update request.
+func setWorkspaceAgentPool(ws *tfc.Workspace, attributes map[string]any) {
+	if v, ok := attributes["agent-pool-id"]; ok {
+		ws.AgentPool = nil
+		if id, ok := v.(string); ok && id != "" {
+			ws.AgentPool = &tfc.AgentPool{ID: id}
+		}
+	}
+	if ws.ExecutionMode != "agent" {
+		ws.AgentPool = nil
+	}
+}
+
+// withVariables returns a copy of a given workspace with the variables relation as the API returns it.
+// The caller must hold the lock.
+func (s *Server) withVariables(ws *tfc.Workspace) *tfc.Workspace {
+	c := copyOf(ws)
+	c.Variables = nil
+	for _, v := range s.variables {
+		if v.Workspace.ID == ws.ID {
+			c.Variables = append(c.Variables, &tfc.Variable{ID: v.ID})
+		}
+	}
+	slices.SortFunc(c.Variables, func(a, b *tfc.Variable) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return c
+}
+
+// tagNames returns the sorted names of given tags.
+func tagNames(tags []*tfc.Tag) []string {
+	names := []string{}
+	for _, t := range tags {
+		if t != nil && t.Name != "" {
+			names = append(names, t.Name)
+		}
+	}
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	ws := &tfc.Workspace{}
+	attributes, ok := readResource(w, r, ws)
+	if !ok {
+		return
+	}
+	if ws.Name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "name is required")
+		return
+	}
+	if s.workspaceByName(org, ws.Name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+		return
+	}
+
+	ws.ID = s.newID("ws")
+	ws.CreatedAt = now()
+	ws.UpdatedAt = ws.CreatedAt
+	ws.Organization = &tfc.Organization{Name: org}
+	ws.Actions = &tfc.WorkspaceActions{IsDestroyable: true}
+	ws.Permissions = &tfc.WorkspacePermissions{CanUpdate: true, CanDestroy: true, CanQueueRun: true, CanQueueApply: true}
+	if ws.ExecutionMode == "" {
+		ws.ExecutionMode = "remote"
+	}
+	if ws.TerraformVersion == "" {
+		ws.TerraformVersion = DefaultTerraformVersion
+	}
+	if ws.Project == nil {
+		ws.Project = &tfc.Project{ID: s.organizations[org].DefaultProject.ID}
+	}
+	setWorkspaceAgentPool(ws, attributes)
+	ws.TagNames = tagNames(ws.Tags)
+	ws.Tags = nil
+	s.workspaces[ws.ID] = ws
+
+	writeResource(w, http.StatusCreated, s.withVariables(ws))
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	search := q.Get("search[name]")
+	projectID := q.Get("filter[project][id]")
+	items := values(s.workspaces, func(ws *tfc.Workspace) bool {
+		return ws.Organization.Name == org &&
+			strings.Contains(ws.Name, search) &&
+			(projectID == "" || (ws.Project != nil && ws.Project.ID == projectID))
+	})
+	for i, ws := range items {
+		items[i] = s.withVariables(ws)
+	}
+
+	writeList(w, r, items, func(ws *tfc.Workspace) string { return ws.ID })
+}
+
+func (s *Server) readWorkspaceByName(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.organization(w, r)
+	if !ok {
+		return
+	}
+	ws := s.workspaceByName(org, r.PathValue("name"))
+	if ws == nil {
+		writeNotFound(w)
+		return
+	}
+
+	writeResource(w, http.StatusOK, s.withVariables(ws))
+}
+
+func (s *Server) readWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+
+	writeResource(w, http.StatusOK, s.withVariables(ws))
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	updated := copyOf(ws)
+	attributes, ok := readResource(w, r, updated)
+	if !ok {
+		return
+	}
+	if updated.Name != ws.Name {
+		if s.workspaceByName(ws.Organization.Name, updated.Name) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "name has already been taken")
+			return
+		}
+	}
+	// A null VCS repository detaches the workspace from a VCS.
+	if v, ok := attributes["vcs-repo"]; ok && v == nil {
+		updated.VCSRepo = nil
+	}
+	setWorkspaceAgentPool(updated, attributes)
+	updated.ID = ws.ID
+	updated.UpdatedAt = now()
+	s.workspaces[ws.ID] = updated
+
+	writeResource(w, http.StatusOK, s.withVariables(updated))
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	delete(s.workspaces, ws.ID)
+	for id, v := range s.variables {
+		if v.Workspace.ID == ws.ID {
+			delete(s.variables, id)
+		}
+	}
+	for id, a := range s.teamAccess {
+		if a.Workspace.ID == ws.ID {
+			delete(s.teamAccess, id)
+		}
+	}
+	for id, workspaceID := range s.notificationWorkspaces {
+		if workspaceID == ws.ID {
+			delete(s.notifications, id)
+			delete(s.notificationWorkspaces, id)
+		}
+	}
+	for _, vs := range s.variableSets {
+		vs.Workspaces = slices.DeleteFunc(vs.Workspaces, func(w *tfc.Workspace) bool {
+			return w.ID == ws.ID
+		})
+	}
+	for _, ps := range s.policySets {
+		ps.Workspaces = slices.DeleteFunc(ps.Workspaces, func(w *tfc.Workspace) bool {
+			return w.ID == ws.ID
+		})
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listWorkspaceTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	tags := []*tfc.Tag{}
+	for _, n := range ws.TagNames {
+		tags = append(tags, &tfc.Tag{ID: "tag-" + n, Name: n})
+	}
+
+	writeList(w, r, tags, func(t *tfc.Tag) string { return t.ID })
+}
+
+func (s *Server) addWorkspaceTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	tags, ok := readResources[tfc.Tag](w, r)
+	if !ok {
+		return
+	}
+	names := append(slices.Clone(ws.TagNames), tagNames(tags)...)
+	slices.Sort(names)
+	ws.TagNames = slices.Compact(names)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeWorkspaceTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	tags, ok := readResources[tfc.Tag](w, r)
+	if !ok {
+		return
+	}
+	remove := tagNames(tags)
+	ws.TagNames = slices.DeleteFunc(slices.Clone(ws.TagNames), func(n string) bool {
+		return slices.Contains(remove, n)
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listEmpty writes an empty list for unsupported collections.
+func (s *Server) listEmpty(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.workspace(w, r); !ok {
+		return
+	}
+
+	writeList(w, r, []*struct {
+		ID string `jsonapi:"primary,empty"`
+	}{}, nil)
+}
+
+// apiLockHolder is the holder of the workspace locks created via the API.
+const apiLockHolder = "Team api"
+
+func (s *Server) lockWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ws, ok := s.workspace(w, r)
+	if !ok {
+		return
+	}
+	if ws.Locked {
+		writeError(w, http.StatusConflict, "Workspace is already locked")
+		return
+	}
+	ws.Locked = true
+	s.workspaceLockHolders[ws.ID] = apiLockHolder
+
+	writeResource(w, http.StatusOK, s.withVariables(ws))
+}
+
+// unlockWorkspace unlocks workspaces, only locks created via the API can be unlocked unless the unlock is forced.
+func (s *Server) unlockWorkspace(force bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		ws, ok := s.workspace(w, r)
+		if !ok {
+			return
+		}
+		if !ws.Locked {
+			writeError(w, http.StatusConflict, "Workspace is already unlocked")
+			return
+		}
+		if h := s.workspaceLockHolders[ws.ID]; !force && h != apiLockHolder {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Workspace is locked by %s", h))
+			return
+		}
+		ws.Locked = false
+		delete(s.workspaceLockHolders, ws.ID)
+
+		writeResource(w, http.StatusOK, s.withVariables(ws))
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupAgentPoolWebhookWithManager registers the webhooks for AgentPool with the Manager.
+func SetupAgentPoolWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.AgentPool{}).
+		WithValidator(&AgentPoolCustomValidator{}).
+		WithDefaulter(&AgentPoolCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-agentpool,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=agentpools,verbs=create;update,versions=v1alpha2,name=magentpool-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// AgentPoolCustomDefaulter sets default values on AgentPool objects.
+type AgentPoolCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &AgentPoolCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *AgentPoolCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	ap, ok := obj.(*appv1alpha2.AgentPool)
+	if !ok {
+		return fmt.Errorf("expected an AgentPool object but got %T", obj)
+	}
+	if ap.Spec.DeletionPolicy == "" {
+		ap.Spec.DeletionPolicy = appv1alpha2.AgentPoolDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-agentpool,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=agentpools,verbs=create;update,versions=v1alpha2,name=vagentpool-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// AgentPoolCustomValidator validates AgentPool objects.
+type AgentPoolCustomValidator struct{}
+
+var _ admission.CustomValidator = &AgentPoolCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *AgentPoolCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.AgentPool](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *AgentPoolCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.AgentPool](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *AgentPoolCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
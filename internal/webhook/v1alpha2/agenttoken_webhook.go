@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupAgentTokenWebhookWithManager registers the webhooks for AgentToken with the Manager.
+func SetupAgentTokenWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.AgentToken{}).
+		WithValidator(&AgentTokenCustomValidator{}).
+		WithDefaulter(&AgentTokenCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-agenttoken,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=agenttokens,verbs=create;update,versions=v1alpha2,name=magenttoken-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// AgentTokenCustomDefaulter sets default values on AgentToken objects.
+type AgentTokenCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &AgentTokenCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *AgentTokenCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	t, ok := obj.(*appv1alpha2.AgentToken)
+	if !ok {
+		return fmt.Errorf("expected an AgentToken object but got %T", obj)
+	}
+	if t.Spec.DeletionPolicy == "" {
+		t.Spec.DeletionPolicy = appv1alpha2.AgentTokenDeletionPolicyRetain
+	}
+	if t.Spec.ManagementPolicy == "" {
+		t.Spec.ManagementPolicy = appv1alpha2.AgentTokenManagementPolicyMerge
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-agenttoken,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=agenttokens,verbs=create;update,versions=v1alpha2,name=vagenttoken-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// AgentTokenCustomValidator validates AgentToken objects.
+type AgentTokenCustomValidator struct{}
+
+var _ admission.CustomValidator = &AgentTokenCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *AgentTokenCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.AgentToken](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *AgentTokenCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.AgentToken](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *AgentTokenCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
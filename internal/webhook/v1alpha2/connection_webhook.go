@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupConnectionWebhookWithManager registers the webhooks for Connection with the Manager.
+func SetupConnectionWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.Connection{}).
+		WithValidator(&ConnectionCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-connection,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=connections,verbs=create;update,versions=v1alpha2,name=vconnection-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// ConnectionCustomValidator validates Connection objects.
+type ConnectionCustomValidator struct{}
+
+var _ admission.CustomValidator = &ConnectionCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ConnectionCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Connection](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *ConnectionCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Connection](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ConnectionCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupModuleWebhookWithManager registers the webhooks for Module with the Manager.
+func SetupModuleWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.Module{}).
+		WithValidator(&ModuleCustomValidator{}).
+		WithDefaulter(&ModuleCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-module,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=modules,verbs=create;update,versions=v1alpha2,name=mmodule-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// ModuleCustomDefaulter sets default values on Module objects.
+type ModuleCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &ModuleCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *ModuleCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	m, ok := obj.(*appv1alpha2.Module)
+	if !ok {
+		return fmt.Errorf("expected a Module object but got %T", obj)
+	}
+	if m.Spec.DeletionPolicy == "" {
+		m.Spec.DeletionPolicy = appv1alpha2.ModuleDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-module,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=modules,verbs=create;update,versions=v1alpha2,name=vmodule-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// ModuleCustomValidator validates Module objects.
+type ModuleCustomValidator struct{}
+
+var _ admission.CustomValidator = &ModuleCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ModuleCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Module](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *ModuleCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Module](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ModuleCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
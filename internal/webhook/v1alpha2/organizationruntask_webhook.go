@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupOrganizationRunTaskWebhookWithManager registers the webhooks for OrganizationRunTask with the Manager.
+func SetupOrganizationRunTaskWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.OrganizationRunTask{}).
+		WithValidator(&OrganizationRunTaskCustomValidator{}).
+		WithDefaulter(&OrganizationRunTaskCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-organizationruntask,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=organizationruntasks,verbs=create;update,versions=v1alpha2,name=morganizationruntask-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// OrganizationRunTaskCustomDefaulter sets default values on OrganizationRunTask objects.
+type OrganizationRunTaskCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &OrganizationRunTaskCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *OrganizationRunTaskCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	p, ok := obj.(*appv1alpha2.OrganizationRunTask)
+	if !ok {
+		return fmt.Errorf("expected an OrganizationRunTask object but got %T", obj)
+	}
+	if p.Spec.DeletionPolicy == "" {
+		p.Spec.DeletionPolicy = appv1alpha2.OrganizationRunTaskDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-organizationruntask,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=organizationruntasks,verbs=create;update,versions=v1alpha2,name=vorganizationruntask-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// OrganizationRunTaskCustomValidator validates OrganizationRunTask objects.
+type OrganizationRunTaskCustomValidator struct{}
+
+var _ admission.CustomValidator = &OrganizationRunTaskCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *OrganizationRunTaskCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.OrganizationRunTask](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *OrganizationRunTaskCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.OrganizationRunTask](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *OrganizationRunTaskCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
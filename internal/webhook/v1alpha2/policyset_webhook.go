@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupPolicySetWebhookWithManager registers the webhooks for PolicySet with the Manager.
+func SetupPolicySetWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.PolicySet{}).
+		WithValidator(&PolicySetCustomValidator{}).
+		WithDefaulter(&PolicySetCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-policyset,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=policysets,verbs=create;update,versions=v1alpha2,name=mpolicyset-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// PolicySetCustomDefaulter sets default values on PolicySet objects.
+type PolicySetCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &PolicySetCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *PolicySetCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	p, ok := obj.(*appv1alpha2.PolicySet)
+	if !ok {
+		return fmt.Errorf("expected a PolicySet object but got %T", obj)
+	}
+	if p.Spec.DeletionPolicy == "" {
+		p.Spec.DeletionPolicy = appv1alpha2.PolicySetDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-policyset,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=policysets,verbs=create;update,versions=v1alpha2,name=vpolicyset-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// PolicySetCustomValidator validates PolicySet objects.
+type PolicySetCustomValidator struct{}
+
+var _ admission.CustomValidator = &PolicySetCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *PolicySetCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.PolicySet](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *PolicySetCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	warnings, err := validateSpec[*appv1alpha2.PolicySet](newObj)
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, validatePolicySetKind(oldObj.(*appv1alpha2.PolicySet), newObj.(*appv1alpha2.PolicySet))
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *PolicySetCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validatePolicySetKind validates that the policy framework does not change once the policy set is created.
+func validatePolicySetKind(oldObj, newObj *appv1alpha2.PolicySet) error {
+	if oldObj.Status.ID == "" || !newObj.GetDeletionTimestamp().IsZero() || oldObj.Spec.Kind == newObj.Spec.Kind {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: appv1alpha2.GroupVersion.Group, Kind: "PolicySet"},
+		newObj.Name,
+		field.ErrorList{field.Forbidden(field.NewPath("spec").Child("kind"), "'spec.kind' cannot be changed after the policy set is created")},
+	)
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestValidatePolicySetKind(t *testing.T) {
+	t.Parallel()
+
+	policySet := func(kind, id string) *appv1alpha2.PolicySet {
+		return &appv1alpha2.PolicySet{
+			ObjectMeta: metav1.ObjectMeta{Name: "this"},
+			Spec:       appv1alpha2.PolicySetSpec{Kind: kind},
+			Status:     appv1alpha2.PolicySetStatus{ID: id},
+		}
+	}
+
+	assert.NoError(t, validatePolicySetKind(policySet("sentinel", "polset-this"), policySet("sentinel", "polset-this")))
+	assert.NoError(t, validatePolicySetKind(policySet("sentinel", ""), policySet("opa", "")))
+	assert.Error(t, validatePolicySetKind(policySet("sentinel", "polset-this"), policySet("opa", "polset-this")))
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupProjectWebhookWithManager registers the webhooks for Project with the Manager.
+func SetupProjectWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.Project{}).
+		WithValidator(&ProjectCustomValidator{}).
+		WithDefaulter(&ProjectCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-project,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=projects,verbs=create;update,versions=v1alpha2,name=mproject-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// ProjectCustomDefaulter sets default values on Project objects.
+type ProjectCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &ProjectCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *ProjectCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	p, ok := obj.(*appv1alpha2.Project)
+	if !ok {
+		return fmt.Errorf("expected a Project object but got %T", obj)
+	}
+	if p.Spec.DeletionPolicy == "" {
+		p.Spec.DeletionPolicy = appv1alpha2.ProjectDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-project,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=projects,verbs=create;update,versions=v1alpha2,name=vproject-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// ProjectCustomValidator validates Project objects.
+type ProjectCustomValidator struct{}
+
+var _ admission.CustomValidator = &ProjectCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ProjectCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Project](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *ProjectCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Project](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ProjectCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupRunsCollectorWebhookWithManager registers the webhooks for RunsCollector with the Manager.
+func SetupRunsCollectorWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.RunsCollector{}).
+		WithValidator(&RunsCollectorCustomValidator{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-runscollector,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=runscollectors,verbs=create;update,versions=v1alpha2,name=vrunscollector-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// RunsCollectorCustomValidator validates RunsCollector objects.
+type RunsCollectorCustomValidator struct{}
+
+var _ admission.CustomValidator = &RunsCollectorCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *RunsCollectorCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.RunsCollector](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *RunsCollectorCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.RunsCollector](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *RunsCollectorCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupTeamWebhookWithManager registers the webhooks for Team with the Manager.
+func SetupTeamWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.Team{}).
+		WithValidator(&TeamCustomValidator{}).
+		WithDefaulter(&TeamCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-team,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=teams,verbs=create;update,versions=v1alpha2,name=mteam-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// TeamCustomDefaulter sets default values on Team objects.
+type TeamCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &TeamCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *TeamCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	p, ok := obj.(*appv1alpha2.Team)
+	if !ok {
+		return fmt.Errorf("expected a Team object but got %T", obj)
+	}
+	if p.Spec.DeletionPolicy == "" {
+		p.Spec.DeletionPolicy = appv1alpha2.TeamDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-team,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=teams,verbs=create;update,versions=v1alpha2,name=vteam-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// TeamCustomValidator validates Team objects.
+type TeamCustomValidator struct{}
+
+var _ admission.CustomValidator = &TeamCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *TeamCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Team](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *TeamCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.Team](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *TeamCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupVariableSetWebhookWithManager registers the webhooks for VariableSet with the Manager.
+func SetupVariableSetWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.VariableSet{}).
+		WithValidator(&VariableSetCustomValidator{}).
+		WithDefaulter(&VariableSetCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-variableset,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=variablesets,verbs=create;update,versions=v1alpha2,name=mvariableset-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// VariableSetCustomDefaulter sets default values on VariableSet objects.
+type VariableSetCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &VariableSetCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *VariableSetCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	p, ok := obj.(*appv1alpha2.VariableSet)
+	if !ok {
+		return fmt.Errorf("expected a VariableSet object but got %T", obj)
+	}
+	if p.Spec.DeletionPolicy == "" {
+		p.Spec.DeletionPolicy = appv1alpha2.VariableSetDeletionPolicyRetain
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-variableset,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=variablesets,verbs=create;update,versions=v1alpha2,name=vvariableset-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// VariableSetCustomValidator validates VariableSet objects.
+type VariableSetCustomValidator struct{}
+
+var _ admission.CustomValidator = &VariableSetCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *VariableSetCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.VariableSet](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *VariableSetCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.VariableSet](newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *VariableSetCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
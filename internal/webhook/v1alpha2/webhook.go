@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var webhookLog = logf.Log.WithName("webhook")
+
+// specValidator is implemented by all custom resources that validate their spec.
+type specValidator interface {
+	client.Object
+	ValidateSpec() error
+}
+
+// SetupWebhooksWithManager registers the defaulting and validating webhooks of all custom resources with the Manager.
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	for _, setup := range []func(ctrl.Manager) error{
+		SetupAgentPoolWebhookWithManager,
+		SetupAgentTokenWebhookWithManager,
+		SetupConnectionWebhookWithManager,
+		SetupModuleWebhookWithManager,
+		SetupOrganizationRunTaskWebhookWithManager,
+		SetupPolicySetWebhookWithManager,
+		SetupProjectWebhookWithManager,
+		SetupRunsCollectorWebhookWithManager,
+		SetupTeamWebhookWithManager,
+		SetupVariableSetWebhookWithManager,
+		SetupWorkspaceWebhookWithManager,
+		SetupWorkspaceRunWebhookWithManager,
+	} {
+		if err := setup(mgr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSpec runs the spec validation of a given object. Objects that are marked for deletion are not validated
+// to let the controllers remove finalizers from objects that were created before the webhooks were enabled.
+func validateSpec[T specValidator](obj runtime.Object) (admission.Warnings, error) {
+	o, ok := obj.(T)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", obj)
+	}
+	if !o.GetDeletionTimestamp().IsZero() {
+		return nil, nil
+	}
+	webhookLog.Info("Validation", "kind", o.GetObjectKind().GroupVersionKind().Kind, "namespace", o.GetNamespace(), "name", o.GetName())
+
+	return nil, o.ValidateSpec()
+}
@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+var runIDRegexp = regexp.MustCompile(`^run-[a-zA-Z0-9]+$`)
+
+// SetupWorkspaceWebhookWithManager registers the webhooks for Workspace with the Manager.
+func SetupWorkspaceWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.Workspace{}).
+		WithValidator(&WorkspaceCustomValidator{}).
+		WithDefaulter(&WorkspaceCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-workspace,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=workspaces,verbs=create;update,versions=v1alpha2,name=mworkspace-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// WorkspaceCustomDefaulter sets default values on Workspace objects.
+type WorkspaceCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &WorkspaceCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *WorkspaceCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	w, ok := obj.(*appv1alpha2.Workspace)
+	if !ok {
+		return fmt.Errorf("expected a Workspace object but got %T", obj)
+	}
+	if w.Spec.DeletionPolicy == "" {
+		w.Spec.DeletionPolicy = appv1alpha2.DeletionPolicyRetain
+	}
+	if w.Spec.ApplyMethod == "" {
+		w.Spec.ApplyMethod = "manual"
+	}
+	if w.Spec.ApplyRunTrigger == "" {
+		w.Spec.ApplyRunTrigger = "manual"
+	}
+	// Explicitly set the run type when a new run is requested, so it is visible which run type the controller triggers.
+	if v, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunNew]; ok && v == "true" {
+		if _, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunType]; !ok {
+			w.Annotations[appv1alpha2.WorkspaceAnnotationRunType] = appv1alpha2.RunTypeDefault
+		}
+	}
+	// Record the user who requested a run action, the controller adds it to the run comment.
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		return setRunActionRequestedBy(w, req)
+	}
+
+	return nil
+}
+
+// setRunActionRequestedBy sets the annotation `workspace.app.terraform.io/run-action-requested-by` to the user of a given request.
+// Users cannot set the annotation themselves: it is kept unchanged until the run action changes.
+func setRunActionRequestedBy(w *appv1alpha2.Workspace, req admission.Request) error {
+	action, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunAction]
+	if !ok {
+		delete(w.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRequestedBy)
+		return nil
+	}
+
+	old := &appv1alpha2.Workspace{}
+	if len(req.OldObject.Raw) > 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, old); err != nil {
+			return fmt.Errorf("failed to decode the old Workspace object: %w", err)
+		}
+	}
+
+	if a, ok := old.Annotations[appv1alpha2.WorkspaceAnnotationRunAction]; ok && a == action &&
+		old.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRunID] == w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRunID] {
+		if u, ok := old.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy]; ok {
+			w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy] = u
+		} else {
+			delete(w.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRequestedBy)
+		}
+		return nil
+	}
+
+	w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy] = req.UserInfo.Username
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-workspace,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=workspaces,verbs=create;update,versions=v1alpha2,name=vworkspace-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// WorkspaceCustomValidator validates Workspace objects.
+type WorkspaceCustomValidator struct{}
+
+var _ admission.CustomValidator = &WorkspaceCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *WorkspaceCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateWorkspace(obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *WorkspaceCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	return validateWorkspace(newObj)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *WorkspaceCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateWorkspace(obj runtime.Object) (admission.Warnings, error) {
+	warnings, err := validateSpec[*appv1alpha2.Workspace](obj)
+	if err != nil {
+		return warnings, err
+	}
+
+	w := obj.(*appv1alpha2.Workspace)
+	if !w.GetDeletionTimestamp().IsZero() {
+		return warnings, nil
+	}
+
+	return validateWorkspaceRunAnnotations(w)
+}
+
+// validateWorkspaceRunAnnotations validates annotations that control new runs.
+func validateWorkspaceRunAnnotations(w *appv1alpha2.Workspace) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	allErrs := field.ErrorList{}
+	f := field.NewPath("metadata").Child("annotations")
+
+	runType, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunType]
+	if ok {
+		switch runType {
+		case appv1alpha2.RunTypePlan, appv1alpha2.RunTypeApply, appv1alpha2.RunTypeRefresh:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				f.Key(appv1alpha2.WorkspaceAnnotationRunType),
+				runType,
+				[]string{appv1alpha2.RunTypePlan, appv1alpha2.RunTypeApply, appv1alpha2.RunTypeRefresh},
+			))
+		}
+	}
+
+	if _, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunTerraformVersion]; ok && runType != appv1alpha2.RunTypePlan {
+		warnings = append(warnings, fmt.Sprintf("annotation %s is ignored unless annotation %s is set to %q",
+			appv1alpha2.WorkspaceAnnotationRunTerraformVersion, appv1alpha2.WorkspaceAnnotationRunType, appv1alpha2.RunTypePlan))
+	}
+
+	if _, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunReplaceAddrs]; ok && runType == appv1alpha2.RunTypeRefresh {
+		allErrs = append(allErrs, field.Forbidden(
+			f.Key(appv1alpha2.WorkspaceAnnotationRunReplaceAddrs),
+			fmt.Sprintf("cannot be set when annotation %s is set to %q", appv1alpha2.WorkspaceAnnotationRunType, appv1alpha2.RunTypeRefresh),
+		))
+	}
+
+	if v, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply]; ok {
+		if _, err := strconv.ParseBool(v); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f.Key(appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply),
+				v,
+				"must be a boolean",
+			))
+		}
+		if runType == "" || runType == appv1alpha2.RunTypePlan {
+			warnings = append(warnings, fmt.Sprintf("annotation %s is ignored when annotation %s is set to %q",
+				appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply, appv1alpha2.WorkspaceAnnotationRunType, appv1alpha2.RunTypePlan))
+		}
+	}
+
+	if v, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunVariables]; ok {
+		if _, err := appv1alpha2.RunVariables(v); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				f.Key(appv1alpha2.WorkspaceAnnotationRunVariables),
+				v,
+				"must be a JSON object of variable names and their values expressed as HCL literals",
+			))
+		}
+	}
+
+	if v, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunAction]; ok {
+		switch v {
+		case appv1alpha2.RunActionConfirm, appv1alpha2.RunActionDiscard, appv1alpha2.RunActionCancel, appv1alpha2.RunActionForceCancel:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				f.Key(appv1alpha2.WorkspaceAnnotationRunAction),
+				v,
+				[]string{appv1alpha2.RunActionConfirm, appv1alpha2.RunActionDiscard, appv1alpha2.RunActionCancel, appv1alpha2.RunActionForceCancel},
+			))
+		}
+	}
+
+	if v, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRunID]; ok {
+		if !runIDRegexp.MatchString(v) {
+			allErrs = append(allErrs, field.Invalid(
+				f.Key(appv1alpha2.WorkspaceAnnotationRunActionRunID),
+				v,
+				fmt.Sprintf("must match pattern %s", runIDRegexp),
+			))
+		}
+		if _, ok := w.Annotations[appv1alpha2.WorkspaceAnnotationRunAction]; !ok {
+			warnings = append(warnings, fmt.Sprintf("annotation %s is ignored unless annotation %s is set",
+				appv1alpha2.WorkspaceAnnotationRunActionRunID, appv1alpha2.WorkspaceAnnotationRunAction))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+
+	return warnings, kerrors.NewInvalid(schema.GroupKind{Group: appv1alpha2.GroupVersion.Group, Kind: "Workspace"}, w.Name, allErrs)
+}
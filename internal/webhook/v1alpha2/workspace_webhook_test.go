@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestWorkspaceDefault(t *testing.T) {
+	t.Parallel()
+
+	w := &appv1alpha2.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "this",
+			Annotations: map[string]string{
+				appv1alpha2.WorkspaceAnnotationRunNew: "true",
+			},
+		},
+	}
+	d := &WorkspaceCustomDefaulter{}
+	assert.NoError(t, d.Default(context.TODO(), w))
+	assert.Equal(t, appv1alpha2.DeletionPolicyRetain, w.Spec.DeletionPolicy)
+	assert.Equal(t, "manual", w.Spec.ApplyMethod)
+	assert.Equal(t, "manual", w.Spec.ApplyRunTrigger)
+	assert.Equal(t, appv1alpha2.RunTypeDefault, w.Annotations[appv1alpha2.WorkspaceAnnotationRunType])
+
+	w.Spec.ApplyMethod = "auto"
+	w.Annotations[appv1alpha2.WorkspaceAnnotationRunType] = appv1alpha2.RunTypeApply
+	assert.NoError(t, d.Default(context.TODO(), w))
+	assert.Equal(t, "auto", w.Spec.ApplyMethod)
+	assert.Equal(t, appv1alpha2.RunTypeApply, w.Annotations[appv1alpha2.WorkspaceAnnotationRunType])
+}
+
+func TestValidateWorkspaceRunAnnotations(t *testing.T) {
+	t.Parallel()
+
+	successCases := map[string]map[string]string{
+		"NoAnnotations": {},
+		"RunTypePlan": {
+			appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypePlan,
+		},
+		"RunTypeApply": {
+			appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
+		},
+		"RunTypeRefresh": {
+			appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeRefresh,
+		},
+		"RunTypePlanWithTerraformVersion": {
+			appv1alpha2.WorkspaceAnnotationRunType:             appv1alpha2.RunTypePlan,
+			appv1alpha2.WorkspaceAnnotationRunTerraformVersion: "1.9.0",
+		},
+		"RunTypeApplyWithTargetsAndReplaceAddrs": {
+			appv1alpha2.WorkspaceAnnotationRunType:         appv1alpha2.RunTypeApply,
+			appv1alpha2.WorkspaceAnnotationRunTargetAddrs:  "aws_instance.this,aws_instance.that",
+			appv1alpha2.WorkspaceAnnotationRunReplaceAddrs: "aws_instance.this",
+		},
+		"RunTypeApplyWithAllowEmptyApply": {
+			appv1alpha2.WorkspaceAnnotationRunType:            appv1alpha2.RunTypeApply,
+			appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply: "true",
+		},
+		"RunTypeApplyWithVariables": {
+			appv1alpha2.WorkspaceAnnotationRunType:      appv1alpha2.RunTypeApply,
+			appv1alpha2.WorkspaceAnnotationRunVariables: `{"region": "\"eu-west-1\"", "count": "3"}`,
+		},
+		"RunActionConfirm": {
+			appv1alpha2.WorkspaceAnnotationRunAction: appv1alpha2.RunActionConfirm,
+		},
+		"RunActionForceCancel": {
+			appv1alpha2.WorkspaceAnnotationRunAction: appv1alpha2.RunActionForceCancel,
+		},
+		"RunActionDiscardWithRunID": {
+			appv1alpha2.WorkspaceAnnotationRunAction:      appv1alpha2.RunActionDiscard,
+			appv1alpha2.WorkspaceAnnotationRunActionRunID: "run-8bN6wDWpmzbkBz2d",
+		},
+	}
+
+	for n, c := range successCases {
+		t.Run(n, func(t *testing.T) {
+			w := &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: c}}
+			warnings, err := validateWorkspaceRunAnnotations(w)
+			assert.NoError(t, err)
+			assert.Empty(t, warnings)
+		})
+	}
+
+	errorCases := map[string]map[string]string{
+		"RunTypeInvalid": {
+			appv1alpha2.WorkspaceAnnotationRunType: "destroy",
+		},
+		"RunTypeRefreshWithReplaceAddrs": {
+			appv1alpha2.WorkspaceAnnotationRunType:         appv1alpha2.RunTypeRefresh,
+			appv1alpha2.WorkspaceAnnotationRunReplaceAddrs: "aws_instance.this",
+		},
+		"AllowEmptyApplyInvalid": {
+			appv1alpha2.WorkspaceAnnotationRunType:            appv1alpha2.RunTypeApply,
+			appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply: "yes please",
+		},
+		"VariablesInvalid": {
+			appv1alpha2.WorkspaceAnnotationRunType:      appv1alpha2.RunTypeApply,
+			appv1alpha2.WorkspaceAnnotationRunVariables: `["region"]`,
+		},
+		"RunActionInvalid": {
+			appv1alpha2.WorkspaceAnnotationRunAction: "approve",
+		},
+		"RunActionRunIDInvalid": {
+			appv1alpha2.WorkspaceAnnotationRunAction:      appv1alpha2.RunActionCancel,
+			appv1alpha2.WorkspaceAnnotationRunActionRunID: "ws-8bN6wDWpmzbkBz2d",
+		},
+	}
+
+	for n, c := range errorCases {
+		t.Run(n, func(t *testing.T) {
+			w := &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: c}}
+			_, err := validateWorkspaceRunAnnotations(w)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("TerraformVersionWithRunTypeApply", func(t *testing.T) {
+		w := &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			appv1alpha2.WorkspaceAnnotationRunType:             appv1alpha2.RunTypeApply,
+			appv1alpha2.WorkspaceAnnotationRunTerraformVersion: "1.9.0",
+		}}}
+		warnings, err := validateWorkspaceRunAnnotations(w)
+		assert.NoError(t, err)
+		assert.Len(t, warnings, 1)
+	})
+
+	t.Run("AllowEmptyApplyWithRunTypePlan", func(t *testing.T) {
+		w := &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			appv1alpha2.WorkspaceAnnotationRunType:            appv1alpha2.RunTypePlan,
+			appv1alpha2.WorkspaceAnnotationRunAllowEmptyApply: "true",
+		}}}
+		warnings, err := validateWorkspaceRunAnnotations(w)
+		assert.NoError(t, err)
+		assert.Len(t, warnings, 1)
+	})
+
+	t.Run("RunActionRunIDWithoutRunAction", func(t *testing.T) {
+		w := &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			appv1alpha2.WorkspaceAnnotationRunActionRunID: "run-8bN6wDWpmzbkBz2d",
+		}}}
+		warnings, err := validateWorkspaceRunAnnotations(w)
+		assert.NoError(t, err)
+		assert.Len(t, warnings, 1)
+	})
+}
+
+func TestWorkspaceDefaultRunActionRequestedBy(t *testing.T) {
+	t.Parallel()
+
+	request := func(t *testing.T, username string, old *appv1alpha2.Workspace) context.Context {
+		t.Helper()
+
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: username},
+		}}
+		if old != nil {
+			raw, err := json.Marshal(old)
+			require.NoError(t, err)
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return admission.NewContextWithRequest(context.TODO(), req)
+	}
+	d := &WorkspaceCustomDefaulter{}
+
+	// The user who sets the run action is recorded, the value set by the user is overwritten.
+	w := &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunAction:            appv1alpha2.RunActionConfirm,
+		appv1alpha2.WorkspaceAnnotationRunActionRequestedBy: "someone-else",
+	}}}
+	require.NoError(t, d.Default(request(t, "alice", &appv1alpha2.Workspace{}), w))
+	assert.Equal(t, "alice", w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy])
+
+	// Another user updates the object, the user who requested the run action is kept.
+	old := w.DeepCopy()
+	w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy] = "bob"
+	require.NoError(t, d.Default(request(t, "bob", old), w))
+	assert.Equal(t, "alice", w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy])
+
+	// Another user changes the run action, the new user is recorded.
+	old = w.DeepCopy()
+	w.Annotations[appv1alpha2.WorkspaceAnnotationRunAction] = appv1alpha2.RunActionDiscard
+	require.NoError(t, d.Default(request(t, "bob", old), w))
+	assert.Equal(t, "bob", w.Annotations[appv1alpha2.WorkspaceAnnotationRunActionRequestedBy])
+
+	// The annotation cannot be set without a run action.
+	w = &appv1alpha2.Workspace{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		appv1alpha2.WorkspaceAnnotationRunActionRequestedBy: "someone-else",
+	}}}
+	require.NoError(t, d.Default(request(t, "alice", nil), w))
+	assert.NotContains(t, w.Annotations, appv1alpha2.WorkspaceAnnotationRunActionRequestedBy)
+}
+
+func TestValidateSpecSkipsDeletedObjects(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.Now()
+	w := &appv1alpha2.Workspace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "this",
+			DeletionTimestamp: &now,
+		},
+		Spec: appv1alpha2.WorkspaceSpec{
+			AgentPool: &appv1alpha2.AgentPoolRef{
+				ID:   "apool-this",
+				Name: "this",
+			},
+		},
+	}
+	_, err := validateWorkspace(w)
+	assert.NoError(t, err)
+
+	w.DeletionTimestamp = nil
+	_, err = validateWorkspace(w)
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+// SetupWorkspaceRunWebhookWithManager registers the webhooks for WorkspaceRun with the Manager.
+func SetupWorkspaceRunWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&appv1alpha2.WorkspaceRun{}).
+		WithValidator(&WorkspaceRunCustomValidator{}).
+		WithDefaulter(&WorkspaceRunCustomDefaulter{}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-app-terraform-io-v1alpha2-workspacerun,mutating=true,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=workspaceruns,verbs=create;update,versions=v1alpha2,name=mworkspacerun-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// WorkspaceRunCustomDefaulter sets default values on WorkspaceRun objects.
+type WorkspaceRunCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &WorkspaceRunCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+func (d *WorkspaceRunCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	p, ok := obj.(*appv1alpha2.WorkspaceRun)
+	if !ok {
+		return fmt.Errorf("expected a WorkspaceRun object but got %T", obj)
+	}
+	if p.Spec.Type == "" {
+		p.Spec.Type = appv1alpha2.WorkspaceRunTypeApply
+	}
+
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-app-terraform-io-v1alpha2-workspacerun,mutating=false,failurePolicy=fail,sideEffects=None,groups=app.terraform.io,resources=workspaceruns,verbs=create;update,versions=v1alpha2,name=vworkspacerun-v1alpha2.app.terraform.io,admissionReviewVersions=v1
+
+// WorkspaceRunCustomValidator validates WorkspaceRun objects.
+type WorkspaceRunCustomValidator struct{}
+
+var _ admission.CustomValidator = &WorkspaceRunCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *WorkspaceRunCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return validateSpec[*appv1alpha2.WorkspaceRun](obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *WorkspaceRunCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	warnings, err := validateSpec[*appv1alpha2.WorkspaceRun](newObj)
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, validateWorkspaceRunSpecImmutable(oldObj.(*appv1alpha2.WorkspaceRun), newObj.(*appv1alpha2.WorkspaceRun))
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *WorkspaceRunCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateWorkspaceRunSpecImmutable validates that the spec does not change once the run is created.
+func validateWorkspaceRunSpecImmutable(oldObj, newObj *appv1alpha2.WorkspaceRun) error {
+	if oldObj.Status.ID == "" || !newObj.GetDeletionTimestamp().IsZero() || equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil
+	}
+
+	return kerrors.NewInvalid(
+		schema.GroupKind{Group: appv1alpha2.GroupVersion.Group, Kind: "WorkspaceRun"},
+		newObj.Name,
+		field.ErrorList{field.Forbidden(field.NewPath("spec"), "'spec' cannot be changed after the run is created, create a new object instead")},
+	)
+}
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2022, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1alpha2 "github.com/hashicorp/hcp-terraform-operator/api/v1alpha2"
+)
+
+func TestValidateWorkspaceRunSpecImmutable(t *testing.T) {
+	t.Parallel()
+
+	workspaceRun := func(message, id string) *appv1alpha2.WorkspaceRun {
+		return &appv1alpha2.WorkspaceRun{
+			ObjectMeta: metav1.ObjectMeta{Name: "this"},
+			Spec:       appv1alpha2.WorkspaceRunSpec{Message: message},
+			Status:     appv1alpha2.WorkspaceRunStatus{ID: id},
+		}
+	}
+
+	assert.NoError(t, validateWorkspaceRunSpecImmutable(workspaceRun("this", "run-this"), workspaceRun("this", "run-this")))
+	assert.NoError(t, validateWorkspaceRunSpecImmutable(workspaceRun("this", ""), workspaceRun("that", "")))
+	assert.Error(t, validateWorkspaceRunSpecImmutable(workspaceRun("this", "run-this"), workspaceRun("that", "run-this")))
+}
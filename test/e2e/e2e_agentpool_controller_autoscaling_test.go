@@ -53,7 +53,7 @@ var _ = Describe("Agent Pool controller", Ordered, func() {
 			Spec: appv1alpha2.AgentPoolSpec{
 				Name:         agentPool,
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
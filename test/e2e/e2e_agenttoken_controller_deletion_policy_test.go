@@ -61,7 +61,7 @@ var _ = Describe("Agent Token controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.AgentTokenSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
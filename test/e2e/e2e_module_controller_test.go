@@ -51,7 +51,7 @@ var _ = Describe("Module сontroller", Ordered, func() {
 			Spec: appv1alpha2.ModuleSpec{
 				Name:         "this",
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
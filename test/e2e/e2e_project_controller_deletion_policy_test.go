@@ -49,7 +49,7 @@ var _ = Describe("Project controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.ProjectSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
@@ -54,7 +54,7 @@ var _ = Describe("Project controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.ProjectSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
@@ -89,7 +89,7 @@ var _ = Describe("Project controller", Ordered, func() {
 		It("can handle pre-set team access", func() {
 			instance.Spec.TeamAccess = []*appv1alpha2.ProjectTeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						Name: team.Name,
 					},
 					Access: tfc.TeamProjectAccessAdmin,
@@ -106,7 +106,7 @@ var _ = Describe("Project controller", Ordered, func() {
 		It("can handle custom team access", func() {
 			instance.Spec.TeamAccess = []*appv1alpha2.ProjectTeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						Name: team.Name,
 					},
 					Access: tfc.TeamProjectAccessCustom,
@@ -138,7 +138,7 @@ var _ = Describe("Project controller", Ordered, func() {
 		It("can handle update from pre-set to custom team access", func() {
 			instance.Spec.TeamAccess = []*appv1alpha2.ProjectTeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						Name: team.Name,
 					},
 					Access: tfc.TeamProjectAccessAdmin,
@@ -155,7 +155,7 @@ var _ = Describe("Project controller", Ordered, func() {
 			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
 			instance.Spec.TeamAccess = []*appv1alpha2.ProjectTeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						ID: team.ID,
 					},
 					Access: tfc.TeamProjectAccessCustom,
@@ -190,7 +190,7 @@ var _ = Describe("Project controller", Ordered, func() {
 
 		It("can handle update from custom to pre-set team access", func() {
 			instance.Spec.TeamAccess = append(instance.Spec.TeamAccess, &appv1alpha2.ProjectTeamAccess{
-				Team: appv1alpha2.Team{
+				Team: appv1alpha2.TeamRef{
 					Name: team.Name,
 				},
 				Access: tfc.TeamProjectAccessCustom,
@@ -221,7 +221,7 @@ var _ = Describe("Project controller", Ordered, func() {
 			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
 			instance.Spec.TeamAccess = []*appv1alpha2.ProjectTeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						ID: team.ID,
 					},
 					Access: tfc.TeamProjectAccessAdmin,
@@ -275,7 +275,7 @@ func buildProjectTeamAccess(ID string, withTeamName bool, custom *appv1alpha2.Cu
 		t, err := tfClient.Teams.Read(ctx, teamAccess.Team.ID)
 		Expect(err).Should(Succeed())
 		Expect(t).ShouldNot(BeNil())
-		team := appv1alpha2.Team{}
+		team := appv1alpha2.TeamRef{}
 		if withTeamName {
 			team.Name = t.Name
 		} else {
@@ -162,12 +162,17 @@ var _ = BeforeSuite(func() {
 			},
 			Controller: config.Controller{
 				GroupKindConcurrency: map[string]int{
-					"AgentPool.app.terraform.io":     5,
-					"AgentToken.app.terraform.io":    5,
-					"Module.app.terraform.io":        5,
-					"Project.app.terraform.io":       5,
-					"RunsCollector.app.terraform.io": 5,
-					"Workspace.app.terraform.io":     5,
+					"AgentPool.app.terraform.io":           5,
+					"AgentToken.app.terraform.io":          5,
+					"Module.app.terraform.io":              5,
+					"OrganizationRunTask.app.terraform.io": 5,
+					"PolicySet.app.terraform.io":           5,
+					"Project.app.terraform.io":             5,
+					"RunsCollector.app.terraform.io":       5,
+					"Team.app.terraform.io":                5,
+					"VariableSet.app.terraform.io":         5,
+					"Workspace.app.terraform.io":           5,
+					"WorkspaceRun.app.terraform.io":        5,
 				},
 			},
 			Metrics: server.Options{
@@ -176,45 +181,95 @@ var _ = BeforeSuite(func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 
+		clientCache := controller.NewTerraformClientCache()
+		err = clientCache.SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
 		err = (&controller.AgentPoolReconciler{
-			Client:   k8sManager.GetClient(),
-			Scheme:   k8sManager.GetScheme(),
-			Recorder: k8sManager.GetEventRecorderFor("AgentPoolController"),
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("AgentPoolController"),
+			ClientCache: clientCache,
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = (&controller.AgentTokenReconciler{
-			Client:   k8sManager.GetClient(),
-			Scheme:   k8sManager.GetScheme(),
-			Recorder: k8sManager.GetEventRecorderFor("AgentTokenController"),
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("AgentTokenController"),
+			ClientCache: clientCache,
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = (&controller.ModuleReconciler{
-			Client:   k8sManager.GetClient(),
-			Scheme:   k8sManager.GetScheme(),
-			Recorder: k8sManager.GetEventRecorderFor("ModuleController"),
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("ModuleController"),
+			ClientCache: clientCache,
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = (&controller.OrganizationRunTaskReconciler{
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("OrganizationRunTaskController"),
+			ClientCache: clientCache,
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = (&controller.PolicySetReconciler{
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("PolicySetController"),
+			ClientCache: clientCache,
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = (&controller.ProjectReconciler{
-			Client:   k8sManager.GetClient(),
-			Scheme:   k8sManager.GetScheme(),
-			Recorder: k8sManager.GetEventRecorderFor("ProjectController"),
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("ProjectController"),
+			ClientCache: clientCache,
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = (&controller.RunsCollectorReconciler{
-			Client:   k8sManager.GetClient(),
-			Scheme:   k8sManager.GetScheme(),
-			Recorder: k8sManager.GetEventRecorderFor("RunsCollectorController"),
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("RunsCollectorController"),
+			ClientCache: clientCache,
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = (&controller.TeamReconciler{
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("TeamController"),
+			ClientCache: clientCache,
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = (&controller.VariableSetReconciler{
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("VariableSetController"),
+			ClientCache: clientCache,
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
 		err = (&controller.WorkspaceReconciler{
-			Client:   k8sManager.GetClient(),
-			Scheme:   k8sManager.GetScheme(),
-			Recorder: k8sManager.GetEventRecorderFor("WorkspaceController"),
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("WorkspaceController"),
+			ClientCache: clientCache,
+		}).SetupWithManager(k8sManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = (&controller.WorkspaceRunReconciler{
+			Client:      k8sManager.GetClient(),
+			Scheme:      k8sManager.GetScheme(),
+			Recorder:    k8sManager.GetEventRecorderFor("WorkspaceRunController"),
+			ClientCache: clientCache,
 		}).SetupWithManager(k8sManager)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -58,7 +58,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.WorkspaceSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
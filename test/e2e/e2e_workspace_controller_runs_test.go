@@ -50,7 +50,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.WorkspaceSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
@@ -101,14 +101,14 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			// Trigger a new apply run with annotations
 			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
 			instance.SetAnnotations(map[string]string{
-				controller.WorkspaceAnnotationRunNew:  controller.MetaTrue,
-				controller.WorkspaceAnnotationRunType: controller.RunTypeApply,
+				appv1alpha2.WorkspaceAnnotationRunNew:  controller.MetaTrue,
+				appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
 			})
 			Expect(k8sClient.Update(ctx, instance)).Should(Succeed())
 
 			Eventually(func() bool {
 				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
-				if instance.Annotations[controller.WorkspaceAnnotationRunNew] == controller.MetaTrue {
+				if instance.Annotations[appv1alpha2.WorkspaceAnnotationRunNew] == controller.MetaTrue {
 					return false
 				}
 				if instance.Status.Run == nil {
@@ -121,15 +121,15 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			// Trigger a new plan run with annotations
 			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
 			instance.SetAnnotations(map[string]string{
-				controller.WorkspaceAnnotationRunNew:              controller.MetaTrue,
-				controller.WorkspaceAnnotationRunType:             controller.RunTypePlan,
-				controller.WorkspaceAnnotationRunTerraformVersion: tf,
+				appv1alpha2.WorkspaceAnnotationRunNew:              controller.MetaTrue,
+				appv1alpha2.WorkspaceAnnotationRunType:             appv1alpha2.RunTypePlan,
+				appv1alpha2.WorkspaceAnnotationRunTerraformVersion: tf,
 			})
 			Expect(k8sClient.Update(ctx, instance)).Should(Succeed())
 
 			Eventually(func() bool {
 				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
-				if instance.Annotations[controller.WorkspaceAnnotationRunNew] == controller.MetaTrue {
+				if instance.Annotations[appv1alpha2.WorkspaceAnnotationRunNew] == controller.MetaTrue {
 					return false
 				}
 				if instance.Status.Plan == nil {
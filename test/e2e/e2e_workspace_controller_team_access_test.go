@@ -49,7 +49,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.WorkspaceSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
@@ -72,7 +72,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 		It("can handle pre-set team access", func() {
 			instance.Spec.TeamAccess = []*appv1alpha2.TeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						Name: team.Name,
 					},
 					Access: "admin",
@@ -96,7 +96,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 		It("can handle custom team access", func() {
 			instance.Spec.TeamAccess = []*appv1alpha2.TeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						Name: team.Name,
 					},
 					Access: "custom",
@@ -128,7 +128,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 		It("can handle update from pre-set to custom team access", func() {
 			instance.Spec.TeamAccess = []*appv1alpha2.TeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						Name: team.Name,
 					},
 					Access: "admin",
@@ -152,7 +152,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
 			instance.Spec.TeamAccess = []*appv1alpha2.TeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						ID: team.ID,
 					},
 					Access: "custom",
@@ -188,7 +188,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 
 		It("can handle update from custom to pre-set team access", func() {
 			instance.Spec.TeamAccess = append(instance.Spec.TeamAccess, &appv1alpha2.TeamAccess{
-				Team: appv1alpha2.Team{
+				Team: appv1alpha2.TeamRef{
 					Name: team.Name,
 				},
 				Access: "custom",
@@ -219,7 +219,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
 			instance.Spec.TeamAccess = []*appv1alpha2.TeamAccess{
 				{
-					Team: appv1alpha2.Team{
+					Team: appv1alpha2.TeamRef{
 						ID: team.ID,
 					},
 					Access: "admin",
@@ -290,7 +290,7 @@ func buildWorkspaceTeamAccess(wsID string, withTeamName bool, custom appv1alpha2
 		t, err := tfClient.Teams.Read(ctx, teamAccess.Team.ID)
 		Expect(err).Should(Succeed())
 		Expect(t).ShouldNot(BeNil())
-		team := appv1alpha2.Team{}
+		team := appv1alpha2.TeamRef{}
 		if withTeamName {
 			team.Name = t.Name
 		} else {
@@ -58,7 +58,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 			},
 			Spec: appv1alpha2.WorkspaceSpec{
 				Organization: organization,
-				Token: appv1alpha2.Token{
+				Token: &appv1alpha2.Token{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
 							Name: secretNamespacedName.Name,
@@ -239,8 +239,8 @@ var _ = Describe("Workspace controller", Ordered, func() {
 
 		It("cat trigger a new apply run on creation", func() {
 			instance.SetAnnotations(map[string]string{
-				controller.WorkspaceAnnotationRunNew:  controller.MetaTrue,
-				controller.WorkspaceAnnotationRunType: controller.RunTypeApply,
+				appv1alpha2.WorkspaceAnnotationRunNew:  controller.MetaTrue,
+				appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypeApply,
 			})
 			instance.Spec.ApplyMethod = "auto"
 			instance.Spec.ApplyRunTrigger = "auto"
@@ -249,7 +249,7 @@ var _ = Describe("Workspace controller", Ordered, func() {
 
 			Eventually(func() bool {
 				Expect(k8sClient.Get(ctx, namespacedName, instance)).Should(Succeed())
-				if instance.Annotations[controller.WorkspaceAnnotationRunNew] == controller.MetaTrue {
+				if instance.Annotations[appv1alpha2.WorkspaceAnnotationRunNew] == controller.MetaTrue {
 					return false
 				}
 				if instance.Status.Run == nil {
@@ -261,8 +261,8 @@ var _ = Describe("Workspace controller", Ordered, func() {
 
 		It("cat trigger a new plan run on creation", func() {
 			instance.SetAnnotations(map[string]string{
-				controller.WorkspaceAnnotationRunNew:  controller.MetaTrue,
-				controller.WorkspaceAnnotationRunType: controller.RunTypePlan,
+				appv1alpha2.WorkspaceAnnotationRunNew:  controller.MetaTrue,
+				appv1alpha2.WorkspaceAnnotationRunType: appv1alpha2.RunTypePlan,
 			})
 			instance.Spec.ApplyMethod = "auto"
 			instance.Spec.ApplyRunTrigger = "auto"